@@ -1,6 +1,8 @@
 package main
 
 import (
+	"os"
+
 	"github.com/project/library/config"
 	"github.com/project/library/internal/app"
 	log "github.com/sirupsen/logrus"
@@ -14,6 +16,13 @@ func main() {
 		log.Fatalf("can not get application config: %s", err)
 	}
 
+	if len(os.Args) > 1 && os.Args[1] == "import" {
+		if err = app.Import(cfg, os.Args[2:]); err != nil {
+			log.Fatalf("import failed: %s", err)
+		}
+		return
+	}
+
 	var logger *zap.Logger
 
 	logger, err = zap.NewProduction()
@@ -4,12 +4,22 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/viper"
 )
 
 const (
-	defaultLogValue = true
+	defaultLogValue     = true
+	defaultCoversDir    = "covers"
+	defaultCoverMaxSize = 5 << 20
+	defaultLoanPeriod   = 21
+	defaultPickupWindow = 3
+	defaultFinePerDay   = 10
+	defaultFineMax      = 1000
+	defaultRenewalMax   = 2
+	defaultRenewalDays  = 14
 )
 
 type (
@@ -29,6 +39,31 @@ type (
 			MaxConn  string `env:"POSTGRES_MAX_CONN"`
 		}
 
+		Covers struct {
+			Dir     string `env:"COVERS_DIR"`
+			MaxSize int64  `env:"COVER_MAX_SIZE"`
+		}
+
+		Loans struct {
+			PeriodDays       int64            `env:"LOAN_PERIOD_DAYS"`
+			PeriodDaysByType map[string]int64 `env:"LOAN_PERIOD_DAYS_BY_TYPE"`
+			PickupDays       int64            `env:"HOLD_PICKUP_DAYS"`
+		}
+
+		Fines struct {
+			PerDay     int64 `env:"FINE_PER_DAY"`
+			GraceDays  int64 `env:"FINE_GRACE_DAYS"`
+			MaxPerItem int64 `env:"FINE_MAX_PER_ITEM"`
+		}
+
+		Renewals struct {
+			Max              int64            `env:"RENEWAL_MAX"`
+			MaxByType        map[string]int64 `env:"RENEWAL_MAX_BY_TYPE"`
+			PeriodDays       int64            `env:"RENEWAL_PERIOD_DAYS"`
+			PeriodDaysByType map[string]int64 `env:"RENEWAL_PERIOD_DAYS_BY_TYPE"`
+			MaxFines         int64            `env:"RENEWAL_MAX_FINES"`
+		}
+
 		Log struct {
 			LogController   bool `env:"LOG_CONTROLLER_ENABLED"`
 			LogTransactor   bool `env:"LOG_TRANSACTOR_ENABLED"`
@@ -78,6 +113,58 @@ func NewConfig() (*Config, error) {
 		return nil, err
 	}
 
+	if cfg.Covers.Dir = os.Getenv("COVERS_DIR"); cfg.Covers.Dir == "" {
+		cfg.Covers.Dir = defaultCoversDir
+	}
+
+	if cfg.Covers.MaxSize, err = parseEnvInt64(v, "cover_max_size", "COVER_MAX_SIZE", defaultCoverMaxSize); err != nil {
+		return nil, err
+	}
+
+	if cfg.Loans.PeriodDays, err = parseEnvInt64(v, "loan_period_days", "LOAN_PERIOD_DAYS", defaultLoanPeriod); err != nil {
+		return nil, err
+	}
+
+	if cfg.Loans.PeriodDaysByType, err = parseEnvInt64Map("LOAN_PERIOD_DAYS_BY_TYPE", 1); err != nil {
+		return nil, err
+	}
+
+	if cfg.Loans.PickupDays, err = parseEnvInt64(v, "hold_pickup_days", "HOLD_PICKUP_DAYS", defaultPickupWindow); err != nil {
+		return nil, err
+	}
+
+	if cfg.Fines.PerDay, err = parseEnvInt64(v, "fine_per_day", "FINE_PER_DAY", defaultFinePerDay); err != nil {
+		return nil, err
+	}
+
+	if cfg.Fines.GraceDays, err = parseEnvInt64Min(v, "fine_grace_days", "FINE_GRACE_DAYS", 0, 0); err != nil {
+		return nil, err
+	}
+
+	if cfg.Fines.MaxPerItem, err = parseEnvInt64(v, "fine_max_per_item", "FINE_MAX_PER_ITEM", defaultFineMax); err != nil {
+		return nil, err
+	}
+
+	if cfg.Renewals.Max, err = parseEnvInt64Min(v, "renewal_max", "RENEWAL_MAX", defaultRenewalMax, 0); err != nil {
+		return nil, err
+	}
+
+	if cfg.Renewals.MaxByType, err = parseEnvInt64Map("RENEWAL_MAX_BY_TYPE", 0); err != nil {
+		return nil, err
+	}
+
+	if cfg.Renewals.PeriodDays, err = parseEnvInt64(v, "renewal_period_days", "RENEWAL_PERIOD_DAYS", defaultRenewalDays); err != nil {
+		return nil, err
+	}
+
+	if cfg.Renewals.PeriodDaysByType, err = parseEnvInt64Map("RENEWAL_PERIOD_DAYS_BY_TYPE", 1); err != nil {
+		return nil, err
+	}
+
+	if cfg.Renewals.MaxFines, err = parseEnvInt64Min(v, "renewal_max_fines", "RENEWAL_MAX_FINES", 0, 0); err != nil {
+		return nil, err
+	}
+
 	return cfg, nil
 }
 
@@ -94,3 +181,44 @@ func parseEnvBool(v *viper.Viper, key, envVar string, defaultValue ...bool) (boo
 	}
 	return v.GetBool(key), nil
 }
+
+func parseEnvInt64(v *viper.Viper, key, envVar string, defaultValue int64) (int64, error) {
+	return parseEnvInt64Min(v, key, envVar, defaultValue, 1)
+}
+
+func parseEnvInt64Min(v *viper.Viper, key, envVar string, defaultValue, minValue int64) (int64, error) {
+	if err := v.BindEnv(key, envVar); err != nil {
+		return defaultValue, err
+	}
+	v.SetDefault(key, defaultValue)
+
+	value := v.GetInt64(key)
+	if value < minValue {
+		return 0, fmt.Errorf("%s must be at least %d, got %q", envVar, minValue, v.GetString(key))
+	}
+	return value, nil
+}
+
+// parseEnvInt64Map parses a list of values not less than minValue like "CHILD=14,STAFF=42".
+func parseEnvInt64Map(envVar string, minValue int64) (map[string]int64, error) {
+	result := make(map[string]int64)
+
+	raw := strings.TrimSpace(os.Getenv(envVar))
+	if raw == "" {
+		return result, nil
+	}
+
+	for _, pair := range strings.Split(raw, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("%s must be a list of KEY=VALUE pairs, got %q", envVar, pair)
+		}
+
+		parsed, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil || parsed < minValue {
+			return nil, fmt.Errorf("%s value for %s must be at least %d, got %q", envVar, key, minValue, value)
+		}
+		result[strings.ToUpper(strings.TrimSpace(key))] = parsed
+	}
+	return result, nil
+}
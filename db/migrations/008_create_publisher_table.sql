@@ -0,0 +1,37 @@
+-- +goose Up
+CREATE TABLE publisher
+(
+    id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name       TEXT                           NOT NULL,
+    country    TEXT,
+    parent_id  UUID REFERENCES publisher (id) ON DELETE SET NULL,
+    created_at TIMESTAMP        DEFAULT now() NOT NULL,
+    updated_at TIMESTAMP        DEFAULT now() NOT NULL,
+    CHECK (parent_id <> id)
+);
+
+-- +goose StatementBegin
+CREATE
+OR REPLACE FUNCTION update_publisher_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.updated_at
+= now();
+RETURN NEW;
+END;
+$$
+LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE
+OR REPLACE TRIGGER trigger_update_publisher_timestamp
+    BEFORE
+UPDATE
+    ON publisher
+    FOR EACH ROW
+    EXECUTE FUNCTION update_publisher_timestamp();
+
+CREATE INDEX publisher_parent_id ON publisher (parent_id);
+
+-- +goose Down
+DROP TABLE publisher;
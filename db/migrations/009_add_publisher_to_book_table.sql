@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE book
+    ADD COLUMN publisher_id UUID REFERENCES publisher (id) ON DELETE SET NULL;
+
+CREATE INDEX book_publisher_id ON book (publisher_id);
+
+-- +goose Down
+DROP INDEX book_publisher_id;
+
+ALTER TABLE book
+    DROP COLUMN publisher_id;
@@ -0,0 +1,53 @@
+-- +goose Up
+CREATE TABLE work
+(
+    id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name       TEXT                           NOT NULL,
+    created_at TIMESTAMP        DEFAULT now() NOT NULL,
+    updated_at TIMESTAMP        DEFAULT now() NOT NULL
+);
+
+-- +goose StatementBegin
+CREATE
+OR REPLACE FUNCTION update_work_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.updated_at
+= now();
+RETURN NEW;
+END;
+$$
+LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE
+OR REPLACE TRIGGER trigger_update_work_timestamp
+    BEFORE
+UPDATE
+    ON work
+    FOR EACH ROW
+    EXECUTE FUNCTION update_work_timestamp();
+
+CREATE TABLE author_work
+(
+    author_id UUID NOT NULL REFERENCES author (id) ON DELETE CASCADE,
+    work_id   UUID NOT NULL REFERENCES work (id) ON DELETE CASCADE,
+    PRIMARY KEY (author_id, work_id)
+);
+
+CREATE INDEX author_work_work_id ON author_work (work_id);
+
+ALTER TABLE book
+    ADD COLUMN work_id UUID REFERENCES work (id) ON DELETE SET NULL;
+
+CREATE INDEX book_work_id ON book (work_id);
+
+-- +goose Down
+DROP INDEX book_work_id;
+
+ALTER TABLE book
+    DROP COLUMN work_id;
+
+DROP TABLE author_work;
+
+DROP TABLE work;
@@ -0,0 +1,41 @@
+-- +goose Up
+-- Every existing book becomes the only edition of its own work,
+-- the authors of the book become the authors of the work.
+CREATE TEMP TABLE book_work AS
+SELECT id AS book_id, uuid_generate_v4() AS work_id
+FROM book
+WHERE work_id IS NULL;
+
+INSERT INTO work (id, name, created_at, updated_at)
+SELECT bw.work_id, b.name, b.created_at, b.updated_at
+FROM book_work bw
+         INNER JOIN book b ON b.id = bw.book_id;
+
+INSERT INTO author_work (author_id, work_id)
+SELECT ab.author_id, bw.work_id
+FROM author_book ab
+         INNER JOIN book_work bw ON ab.book_id = bw.book_id;
+
+ALTER TABLE book DISABLE TRIGGER trigger_update_book_timestamp;
+
+UPDATE book b
+SET work_id = bw.work_id
+FROM book_work bw
+WHERE b.id = bw.book_id;
+
+ALTER TABLE book ENABLE TRIGGER trigger_update_book_timestamp;
+
+DROP TABLE book_work;
+
+-- +goose Down
+-- Works can not be told apart from the ones created by users after the migration,
+-- so all of them are removed.
+ALTER TABLE book DISABLE TRIGGER trigger_update_book_timestamp;
+
+UPDATE book
+SET work_id = NULL;
+
+ALTER TABLE book ENABLE TRIGGER trigger_update_book_timestamp;
+
+DELETE
+FROM work;
@@ -0,0 +1,27 @@
+-- +goose Up
+CREATE TYPE contributor_role as ENUM ('AUTHOR', 'EDITOR', 'TRANSLATOR', 'ILLUSTRATOR');
+
+ALTER TABLE author_book
+    ADD COLUMN role contributor_role NOT NULL DEFAULT 'AUTHOR';
+
+ALTER TABLE author_book
+    DROP CONSTRAINT author_book_pkey;
+
+ALTER TABLE author_book
+    ADD PRIMARY KEY (author_id, book_id, role);
+
+-- +goose Down
+DELETE
+FROM author_book
+WHERE role <> 'AUTHOR';
+
+ALTER TABLE author_book
+    DROP CONSTRAINT author_book_pkey;
+
+ALTER TABLE author_book
+    ADD PRIMARY KEY (author_id, book_id);
+
+ALTER TABLE author_book
+    DROP COLUMN role;
+
+DROP TYPE contributor_role;
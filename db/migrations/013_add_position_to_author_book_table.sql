@@ -0,0 +1,18 @@
+-- +goose Up
+ALTER TABLE author_book
+    ADD COLUMN position INT NOT NULL DEFAULT 0;
+
+UPDATE author_book ab
+SET position = ordered.position
+FROM (SELECT author_id,
+             book_id,
+             role,
+             row_number() OVER (PARTITION BY book_id ORDER BY role, author_id) - 1 AS position
+      FROM author_book) ordered
+WHERE ab.author_id = ordered.author_id
+  AND ab.book_id = ordered.book_id
+  AND ab.role = ordered.role;
+
+-- +goose Down
+ALTER TABLE author_book
+    DROP COLUMN position;
@@ -0,0 +1,33 @@
+-- +goose Up
+ALTER TABLE author
+    ADD COLUMN birth_date  DATE,
+    ADD COLUMN death_date  DATE,
+    ADD COLUMN nationality TEXT,
+    ADD COLUMN biography   TEXT,
+    ADD CONSTRAINT author_life_dates CHECK (death_date IS NULL OR birth_date IS NULL OR death_date >= birth_date);
+
+CREATE TABLE author_alias
+(
+    author_id UUID NOT NULL REFERENCES author (id) ON DELETE CASCADE,
+    name      TEXT NOT NULL,
+    position  INT  NOT NULL,
+    PRIMARY KEY (author_id, name)
+);
+
+CREATE INDEX author_lower_name ON author (lower(name));
+
+CREATE INDEX author_alias_lower_name ON author_alias (lower(name));
+
+-- +goose Down
+DROP INDEX author_alias_lower_name;
+
+DROP INDEX author_lower_name;
+
+DROP TABLE author_alias;
+
+ALTER TABLE author
+    DROP CONSTRAINT author_life_dates,
+    DROP COLUMN birth_date,
+    DROP COLUMN death_date,
+    DROP COLUMN nationality,
+    DROP COLUMN biography;
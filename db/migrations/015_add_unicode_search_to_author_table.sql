@@ -0,0 +1,39 @@
+-- +goose Up
+
+CREATE EXTENSION IF NOT EXISTS unaccent;
+
+-- +goose StatementBegin
+CREATE
+OR REPLACE FUNCTION fold_author_name(name TEXT) RETURNS TEXT AS
+$$
+SELECT lower(public.unaccent('public.unaccent', name));
+$$
+LANGUAGE sql IMMUTABLE PARALLEL SAFE STRICT;
+-- +goose StatementEnd
+
+CREATE COLLATION IF NOT EXISTS author_name_sort (provider = icu, locale = 'und-u-kn-true');
+
+DROP INDEX author_lower_name;
+
+DROP INDEX author_alias_lower_name;
+
+CREATE INDEX author_folded_name ON author (fold_author_name(name));
+
+CREATE INDEX author_alias_folded_name ON author_alias (fold_author_name(name));
+
+CREATE INDEX author_sort_name ON author (name COLLATE author_name_sort);
+
+-- +goose Down
+DROP INDEX author_sort_name;
+
+DROP INDEX author_alias_folded_name;
+
+DROP INDEX author_folded_name;
+
+CREATE INDEX author_alias_lower_name ON author_alias (lower(name));
+
+CREATE INDEX author_lower_name ON author (lower(name));
+
+DROP COLLATION author_name_sort;
+
+DROP FUNCTION fold_author_name;
@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TYPE cover_size AS ENUM ('ORIGINAL', 'SMALL', 'MEDIUM', 'LARGE');
+
+CREATE TABLE book_cover
+(
+    book_id      UUID       NOT NULL REFERENCES book (id) ON DELETE CASCADE,
+    size         cover_size NOT NULL,
+    hash         TEXT       NOT NULL,
+    content_type TEXT       NOT NULL,
+    width        INT        NOT NULL,
+    height       INT        NOT NULL,
+    created_at   TIMESTAMP DEFAULT now() NOT NULL,
+    PRIMARY KEY (book_id, size)
+);
+
+-- +goose Down
+DROP TABLE book_cover;
+
+DROP TYPE cover_size;
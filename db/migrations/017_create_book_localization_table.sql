@@ -0,0 +1,12 @@
+-- +goose Up
+CREATE TABLE book_localization
+(
+    book_id     UUID NOT NULL REFERENCES book (id) ON DELETE CASCADE,
+    language    TEXT NOT NULL,
+    title       TEXT NOT NULL,
+    description TEXT,
+    PRIMARY KEY (book_id, language)
+);
+
+-- +goose Down
+DROP TABLE book_localization;
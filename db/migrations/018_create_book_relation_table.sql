@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TYPE book_relation_type AS ENUM ('SEQUEL_OF', 'ADAPTATION_OF', 'TRANSLATION_OF', 'PART_OF', 'COMMENTARY_ON');
+
+-- relations of inverse types are stored as their inverses: book_id <type> related_book_id
+CREATE TABLE book_relation
+(
+    book_id         UUID               NOT NULL REFERENCES book (id) ON DELETE CASCADE,
+    related_book_id UUID               NOT NULL REFERENCES book (id) ON DELETE CASCADE,
+    type            book_relation_type NOT NULL,
+    PRIMARY KEY (book_id, related_book_id, type),
+    CHECK (book_id <> related_book_id)
+);
+
+CREATE INDEX book_relation_related_book_id ON book_relation (related_book_id);
+
+-- +goose Down
+DROP TABLE book_relation;
+
+DROP TYPE book_relation_type;
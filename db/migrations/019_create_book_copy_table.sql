@@ -0,0 +1,49 @@
+-- +goose Up
+CREATE TYPE copy_condition AS ENUM ('NEW', 'GOOD', 'FAIR', 'POOR', 'DAMAGED');
+
+CREATE TYPE copy_status AS ENUM ('AVAILABLE', 'ON_LOAN', 'LOST', 'WITHDRAWN');
+
+CREATE TABLE book_copy
+(
+    id               UUID PRIMARY KEY        DEFAULT uuid_generate_v4(),
+    book_id          UUID           NOT NULL REFERENCES book (id) ON DELETE CASCADE,
+    barcode          TEXT           NOT NULL UNIQUE,
+    location         TEXT,
+    acquisition_date DATE,
+    condition        copy_condition NOT NULL,
+    status           copy_status    NOT NULL DEFAULT 'AVAILABLE',
+    created_at       TIMESTAMP      NOT NULL DEFAULT now(),
+    updated_at       TIMESTAMP      NOT NULL DEFAULT now()
+);
+
+-- +goose StatementBegin
+CREATE
+OR REPLACE FUNCTION update_book_copy_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.updated_at
+= now();
+RETURN NEW;
+END;
+$$
+LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE
+OR REPLACE TRIGGER trigger_update_book_copy_timestamp
+    BEFORE
+UPDATE
+    ON book_copy
+    FOR EACH ROW
+    EXECUTE FUNCTION update_book_copy_timestamp();
+
+CREATE INDEX book_copy_book_id ON book_copy (book_id);
+
+-- +goose Down
+DROP TABLE book_copy;
+
+DROP FUNCTION update_book_copy_timestamp();
+
+DROP TYPE copy_status;
+
+DROP TYPE copy_condition;
@@ -0,0 +1,49 @@
+-- +goose Up
+CREATE TYPE membership_type AS ENUM ('ADULT', 'CHILD', 'STUDENT', 'SENIOR', 'STAFF');
+
+CREATE TYPE member_status AS ENUM ('ACTIVE', 'SUSPENDED');
+
+CREATE TABLE member
+(
+    id              UUID PRIMARY KEY         DEFAULT uuid_generate_v4(),
+    card_number     TEXT            NOT NULL UNIQUE,
+    name            TEXT            NOT NULL,
+    email           TEXT,
+    phone           TEXT,
+    address         TEXT,
+    membership_type membership_type NOT NULL,
+    expiry_date     DATE            NOT NULL,
+    status          member_status   NOT NULL DEFAULT 'ACTIVE',
+    created_at      TIMESTAMP       NOT NULL DEFAULT now(),
+    updated_at      TIMESTAMP       NOT NULL DEFAULT now()
+);
+
+-- +goose StatementBegin
+CREATE
+OR REPLACE FUNCTION update_member_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.updated_at
+= now();
+RETURN NEW;
+END;
+$$
+LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE
+OR REPLACE TRIGGER trigger_update_member_timestamp
+    BEFORE
+UPDATE
+    ON member
+    FOR EACH ROW
+    EXECUTE FUNCTION update_member_timestamp();
+
+-- +goose Down
+DROP TABLE member;
+
+DROP FUNCTION update_member_timestamp();
+
+DROP TYPE member_status;
+
+DROP TYPE membership_type;
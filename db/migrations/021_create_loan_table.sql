@@ -0,0 +1,20 @@
+-- +goose Up
+CREATE TABLE loan
+(
+    id             UUID PRIMARY KEY     DEFAULT uuid_generate_v4(),
+    copy_id        UUID        NOT NULL REFERENCES book_copy (id),
+    member_id      UUID        NOT NULL REFERENCES member (id),
+    checked_out_at TIMESTAMPTZ NOT NULL,
+    due_at         TIMESTAMPTZ NOT NULL,
+    returned_at    TIMESTAMPTZ,
+    CHECK (due_at > checked_out_at),
+    CHECK (returned_at >= checked_out_at)
+);
+
+-- a copy can be on one active loan only
+CREATE UNIQUE INDEX loan_active_copy_id ON loan (copy_id) WHERE returned_at IS NULL;
+
+CREATE INDEX loan_active_member_id ON loan (member_id) WHERE returned_at IS NULL;
+
+-- +goose Down
+DROP TABLE loan;
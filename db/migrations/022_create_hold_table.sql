@@ -0,0 +1,38 @@
+-- +goose Up
+ALTER TYPE copy_status ADD VALUE IF NOT EXISTS 'ON_HOLD';
+
+CREATE TYPE hold_status AS ENUM ('WAITING', 'READY_FOR_PICKUP', 'FULFILLED', 'CANCELLED', 'EXPIRED');
+
+CREATE TABLE hold
+(
+    id         UUID PRIMARY KEY     DEFAULT uuid_generate_v4(),
+    book_id    UUID        NOT NULL REFERENCES book (id) ON DELETE CASCADE,
+    member_id  UUID        NOT NULL REFERENCES member (id),
+    copy_id    UUID REFERENCES book_copy (id),
+    status     hold_status NOT NULL DEFAULT 'WAITING',
+    placed_at  TIMESTAMPTZ NOT NULL,
+    ready_at   TIMESTAMPTZ,
+    expires_at TIMESTAMPTZ,
+    closed_at  TIMESTAMPTZ,
+    CHECK (status <> 'READY_FOR_PICKUP' OR (copy_id IS NOT NULL AND expires_at IS NOT NULL))
+);
+
+-- a member can be in the queue of the book once
+CREATE UNIQUE INDEX hold_active_book_member ON hold (book_id, member_id) WHERE status IN ('WAITING', 'READY_FOR_PICKUP');
+
+-- a copy can be kept for one hold only
+CREATE UNIQUE INDEX hold_ready_copy_id ON hold (copy_id) WHERE status = 'READY_FOR_PICKUP';
+
+CREATE INDEX hold_waiting_book_id ON hold (book_id, placed_at, id) WHERE status = 'WAITING';
+
+CREATE INDEX hold_ready_expires_at ON hold (expires_at) WHERE status = 'READY_FOR_PICKUP';
+
+-- +goose Down
+DROP TABLE hold;
+
+DROP TYPE hold_status;
+
+-- values can not be removed from enum, the kept copies are returned to the shelf
+UPDATE book_copy
+SET status = 'AVAILABLE'
+WHERE status = 'ON_HOLD';
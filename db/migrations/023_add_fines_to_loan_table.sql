@@ -0,0 +1,38 @@
+-- +goose Up
+ALTER TABLE loan
+    ADD COLUMN overdue_at       TIMESTAMPTZ,
+    ADD COLUMN fine             BIGINT NOT NULL DEFAULT 0 CHECK (fine >= 0),
+    ADD COLUMN fine_paid        BIGINT NOT NULL DEFAULT 0 CHECK (fine_paid >= 0),
+    ADD COLUMN fines_accrued_at TIMESTAMPTZ;
+
+CREATE INDEX loan_active_due_at ON loan (due_at) WHERE returned_at IS NULL;
+
+CREATE INDEX loan_member_id ON loan (member_id);
+
+CREATE TYPE fine_payment_type AS ENUM ('PAID', 'WAIVED');
+
+CREATE TABLE fine_payment
+(
+    id      UUID PRIMARY KEY           DEFAULT uuid_generate_v4(),
+    loan_id UUID              NOT NULL REFERENCES loan (id) ON DELETE CASCADE,
+    type    fine_payment_type NOT NULL,
+    amount  BIGINT            NOT NULL CHECK (amount > 0),
+    paid_at TIMESTAMPTZ       NOT NULL
+);
+
+CREATE INDEX fine_payment_loan_id ON fine_payment (loan_id);
+
+-- +goose Down
+DROP TABLE fine_payment;
+
+DROP TYPE fine_payment_type;
+
+DROP INDEX loan_member_id;
+
+DROP INDEX loan_active_due_at;
+
+ALTER TABLE loan
+    DROP COLUMN overdue_at,
+    DROP COLUMN fine,
+    DROP COLUMN fine_paid,
+    DROP COLUMN fines_accrued_at;
@@ -0,0 +1,79 @@
+-- +goose Up
+ALTER TYPE copy_status ADD VALUE IF NOT EXISTS 'IN_TRANSIT';
+
+CREATE TABLE branch
+(
+    id         UUID PRIMARY KEY   DEFAULT uuid_generate_v4(),
+    name       TEXT      NOT NULL UNIQUE,
+    address    TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+-- +goose StatementBegin
+CREATE
+OR REPLACE FUNCTION update_branch_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.updated_at
+= now();
+RETURN NEW;
+END;
+$$
+LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE
+OR REPLACE TRIGGER trigger_update_branch_timestamp
+    BEFORE
+UPDATE
+    ON branch
+    FOR EACH ROW
+    EXECUTE FUNCTION update_branch_timestamp();
+
+-- copies added before branches do not belong to any branch
+ALTER TABLE book_copy
+    ADD COLUMN home_branch_id    UUID REFERENCES branch (id),
+    ADD COLUMN current_branch_id UUID REFERENCES branch (id);
+
+CREATE INDEX book_copy_current_branch_id ON book_copy (current_branch_id, book_id);
+
+CREATE TYPE transfer_status AS ENUM ('REQUESTED', 'IN_TRANSIT', 'RECEIVED', 'CANCELLED');
+
+CREATE TABLE transfer
+(
+    id             UUID PRIMARY KEY         DEFAULT uuid_generate_v4(),
+    copy_id        UUID            NOT NULL REFERENCES book_copy (id) ON DELETE CASCADE,
+    from_branch_id UUID REFERENCES branch (id),
+    to_branch_id   UUID            NOT NULL REFERENCES branch (id),
+    status         transfer_status NOT NULL DEFAULT 'REQUESTED',
+    requested_at   TIMESTAMPTZ     NOT NULL,
+    shipped_at     TIMESTAMPTZ,
+    received_at    TIMESTAMPTZ,
+    CHECK (from_branch_id <> to_branch_id)
+);
+
+-- a copy can be in one open transfer only
+CREATE UNIQUE INDEX transfer_open_copy_id ON transfer (copy_id) WHERE status IN ('REQUESTED', 'IN_TRANSIT');
+
+CREATE INDEX transfer_from_branch_id ON transfer (from_branch_id);
+
+CREATE INDEX transfer_to_branch_id ON transfer (to_branch_id);
+
+-- +goose Down
+DROP TABLE transfer;
+
+DROP TYPE transfer_status;
+
+ALTER TABLE book_copy
+    DROP COLUMN home_branch_id,
+    DROP COLUMN current_branch_id;
+
+DROP TABLE branch;
+
+DROP FUNCTION update_branch_timestamp();
+
+-- values can not be removed from enum, the copies in transit are returned to the shelf
+UPDATE book_copy
+SET status = 'AVAILABLE'
+WHERE status = 'IN_TRANSIT';
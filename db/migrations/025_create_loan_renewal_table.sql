@@ -0,0 +1,21 @@
+-- +goose Up
+ALTER TABLE loan
+    ADD COLUMN renewals INT NOT NULL DEFAULT 0 CHECK (renewals >= 0);
+
+CREATE TABLE loan_renewal
+(
+    id              UUID PRIMARY KEY     DEFAULT uuid_generate_v4(),
+    loan_id         UUID        NOT NULL REFERENCES loan (id) ON DELETE CASCADE,
+    renewed_at      TIMESTAMPTZ NOT NULL,
+    previous_due_at TIMESTAMPTZ NOT NULL,
+    due_at          TIMESTAMPTZ NOT NULL,
+    CHECK (due_at > previous_due_at)
+);
+
+CREATE INDEX loan_renewal_loan_id ON loan_renewal (loan_id);
+
+-- +goose Down
+DROP TABLE loan_renewal;
+
+ALTER TABLE loan
+    DROP COLUMN renewals;
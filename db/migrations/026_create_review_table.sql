@@ -0,0 +1,51 @@
+-- +goose Up
+CREATE TABLE review
+(
+    id         UUID PRIMARY KEY   DEFAULT uuid_generate_v4(),
+    book_id    UUID      NOT NULL REFERENCES book (id) ON DELETE CASCADE,
+    member_id  UUID      NOT NULL REFERENCES member (id),
+    rating     SMALLINT  NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    text       TEXT      NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now(),
+    -- a member can review the book once
+    CONSTRAINT review_book_member_unique UNIQUE (book_id, member_id)
+);
+
+CREATE INDEX review_book_id_created_at ON review (book_id, created_at);
+
+-- +goose StatementBegin
+CREATE
+OR REPLACE FUNCTION update_review_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.updated_at
+= now();
+RETURN NEW;
+END;
+$$
+LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE
+OR REPLACE TRIGGER trigger_update_review_timestamp
+    BEFORE
+UPDATE
+    ON review
+    FOR EACH ROW
+    EXECUTE FUNCTION update_review_timestamp();
+
+-- aggregate of reviews, it is updated in the same transaction with reviews
+CREATE TABLE book_rating
+(
+    book_id      UUID PRIMARY KEY REFERENCES book (id) ON DELETE CASCADE,
+    review_count INT    NOT NULL DEFAULT 0 CHECK (review_count >= 0),
+    rating_total BIGINT NOT NULL DEFAULT 0 CHECK (rating_total >= 0)
+);
+
+-- +goose Down
+DROP TABLE book_rating;
+
+DROP TABLE review;
+
+DROP FUNCTION update_review_timestamp();
@@ -0,0 +1,60 @@
+-- +goose Up
+CREATE TYPE reading_list_kind AS ENUM ('TO_READ', 'READING', 'READ', 'CUSTOM');
+
+CREATE TABLE reading_list
+(
+    id         UUID PRIMARY KEY           DEFAULT uuid_generate_v4(),
+    owner_id   TEXT              NOT NULL,
+    name       TEXT              NOT NULL,
+    kind       reading_list_kind NOT NULL,
+    public     BOOLEAN           NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP         NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP         NOT NULL DEFAULT now(),
+    CONSTRAINT reading_list_owner_name_unique UNIQUE (owner_id, name)
+);
+
+-- a user has one list of each kind except custom ones
+CREATE UNIQUE INDEX reading_list_owner_kind ON reading_list (owner_id, kind) WHERE kind <> 'CUSTOM';
+
+-- +goose StatementBegin
+CREATE
+OR REPLACE FUNCTION update_reading_list_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.updated_at
+= now();
+RETURN NEW;
+END;
+$$
+LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE
+OR REPLACE TRIGGER trigger_update_reading_list_timestamp
+    BEFORE
+UPDATE
+    ON reading_list
+    FOR EACH ROW
+    EXECUTE FUNCTION update_reading_list_timestamp();
+
+CREATE TABLE reading_list_book
+(
+    list_id  UUID        NOT NULL REFERENCES reading_list (id) ON DELETE CASCADE,
+    book_id  UUID        NOT NULL REFERENCES book (id) ON DELETE CASCADE,
+    position INT         NOT NULL CHECK (position > 0),
+    added_at TIMESTAMP   NOT NULL DEFAULT now(),
+    PRIMARY KEY (list_id, book_id)
+);
+
+CREATE INDEX reading_list_book_list_position ON reading_list_book (list_id, position);
+
+CREATE INDEX reading_list_book_book_id ON reading_list_book (book_id);
+
+-- +goose Down
+DROP TABLE reading_list_book;
+
+DROP TABLE reading_list;
+
+DROP FUNCTION update_reading_list_timestamp();
+
+DROP TYPE reading_list_kind;
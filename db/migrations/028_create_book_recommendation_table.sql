@@ -0,0 +1,19 @@
+-- +goose Up
+-- precomputed recommendations, the table is rebuilt periodically
+CREATE TABLE book_recommendation
+(
+    book_id             UUID   NOT NULL REFERENCES book (id) ON DELETE CASCADE,
+    recommended_book_id UUID   NOT NULL REFERENCES book (id) ON DELETE CASCADE,
+    shared_authors      INT    NOT NULL DEFAULT 0,
+    relations           INT    NOT NULL DEFAULT 0,
+    reading_lists       INT    NOT NULL DEFAULT 0,
+    co_borrowers        INT    NOT NULL DEFAULT 0,
+    score               BIGINT NOT NULL,
+    PRIMARY KEY (book_id, recommended_book_id),
+    CHECK (book_id <> recommended_book_id)
+);
+
+CREATE INDEX book_recommendation_book_id_score ON book_recommendation (book_id, score DESC, recommended_book_id);
+
+-- +goose Down
+DROP TABLE book_recommendation;
@@ -0,0 +1,40 @@
+-- +goose Up
+-- statistics are served from materialized views refreshed periodically,
+-- unique indexes allow to refresh them concurrently without blocking readers
+CREATE MATERIALIZED VIEW library_stats AS
+SELECT 1                                                                  AS id,
+       (SELECT count(*) FROM book)                                        AS book_count,
+       (SELECT count(*) FROM author)                                      AS author_count,
+       (SELECT count(*)
+        FROM book b
+        WHERE NOT EXISTS (SELECT 1 FROM author_book ab WHERE ab.book_id = b.id AND ab.role = 'AUTHOR'))
+                                                                          AS books_without_authors,
+       COALESCE((SELECT count(*) FROM author_book WHERE role = 'AUTHOR')::float8 /
+                NULLIF((SELECT count(*) FROM book), 0), 0)                AS average_authors_per_book,
+       now()                                                              AS refreshed_at;
+
+CREATE UNIQUE INDEX library_stats_id ON library_stats (id);
+
+CREATE MATERIALIZED VIEW library_stats_books_per_day AS
+SELECT date_trunc('day', created_at) AS day, count(*) AS book_count
+FROM book
+GROUP BY date_trunc('day', created_at);
+
+CREATE UNIQUE INDEX library_stats_books_per_day_day ON library_stats_books_per_day (day);
+
+CREATE MATERIALIZED VIEW library_stats_author_books AS
+SELECT a.id AS author_id, a.name, count(*) AS book_count
+FROM author a
+         JOIN author_book ab ON ab.author_id = a.id AND ab.role = 'AUTHOR'
+GROUP BY a.id, a.name;
+
+CREATE UNIQUE INDEX library_stats_author_books_author_id ON library_stats_author_books (author_id);
+
+CREATE INDEX library_stats_author_books_book_count ON library_stats_author_books (book_count DESC, author_id);
+
+-- +goose Down
+DROP MATERIALIZED VIEW library_stats_author_books;
+
+DROP MATERIALIZED VIEW library_stats_books_per_day;
+
+DROP MATERIALIZED VIEW library_stats;
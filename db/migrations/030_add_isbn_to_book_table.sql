@@ -0,0 +1,12 @@
+-- +goose Up
+-- isbn is ISBN-13 without separators
+ALTER TABLE book
+    ADD COLUMN isbn TEXT CHECK (isbn ~ '^[0-9]{13}$');
+
+CREATE UNIQUE INDEX book_isbn ON book (isbn) WHERE isbn IS NOT NULL;
+
+-- +goose Down
+DROP INDEX book_isbn;
+
+ALTER TABLE book
+    DROP COLUMN isbn;
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE book
+    ADD COLUMN publication_year SMALLINT CHECK (publication_year BETWEEN 1 AND 9999);
+
+-- +goose Down
+ALTER TABLE book
+    DROP COLUMN publication_year;
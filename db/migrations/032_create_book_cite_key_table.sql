@@ -0,0 +1,10 @@
+-- +goose Up
+-- cite keys are kept from the first citation of the book, so they don't change with the book
+CREATE TABLE book_cite_key
+(
+    book_id  UUID PRIMARY KEY REFERENCES book (id) ON DELETE CASCADE,
+    cite_key TEXT NOT NULL
+);
+
+-- +goose Down
+DROP TABLE book_cite_key;
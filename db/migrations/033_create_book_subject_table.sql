@@ -0,0 +1,19 @@
+-- +goose Up
+-- subjects are kept in lower case, so the same subject in other case is not added twice
+CREATE TABLE book_subject
+(
+    book_id UUID NOT NULL REFERENCES book (id) ON DELETE CASCADE,
+    subject TEXT NOT NULL,
+    PRIMARY KEY (book_id, subject)
+);
+
+CREATE INDEX book_subject_subject ON book_subject (subject, book_id);
+
+ALTER TABLE book_recommendation
+    ADD COLUMN shared_subjects INT NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE book_recommendation
+    DROP COLUMN shared_subjects;
+
+DROP TABLE book_subject;
@@ -0,0 +1,172 @@
+-- +goose Up
+-- Authors belong to the work, author_book keeps only contributors of the particular edition.
+-- Books added after 011 become the only editions of their own works, authors of all editions of a work
+-- become authors of the work.
+ALTER TABLE book DISABLE TRIGGER trigger_update_book_timestamp;
+
+CREATE TEMP TABLE book_work AS
+SELECT id AS book_id, uuid_generate_v4() AS work_id
+FROM book
+WHERE work_id IS NULL;
+
+INSERT INTO work (id, name, created_at, updated_at)
+SELECT bw.work_id, b.name, b.created_at, b.updated_at
+FROM book_work bw
+         INNER JOIN book b ON b.id = bw.book_id;
+
+UPDATE book b
+SET work_id = bw.work_id
+FROM book_work bw
+WHERE b.id = bw.book_id;
+
+DROP TABLE book_work;
+
+ALTER TABLE book ENABLE TRIGGER trigger_update_book_timestamp;
+
+ALTER TABLE author_work
+    ADD COLUMN position INT NOT NULL DEFAULT 0;
+
+INSERT INTO author_work (author_id, work_id)
+SELECT DISTINCT ab.author_id, b.work_id
+FROM author_book ab
+         INNER JOIN book b ON b.id = ab.book_id
+WHERE ab.role = 'AUTHOR'
+ON CONFLICT DO NOTHING;
+
+-- authors keep their order in the editions, authors of the work which are not authors of any edition are the last
+UPDATE author_work aw
+SET position = ordered.position
+FROM (SELECT aw.author_id,
+             aw.work_id,
+             row_number() OVER (PARTITION BY aw.work_id ORDER BY min(ab.position) NULLS LAST, aw.author_id) - 1 AS position
+      FROM author_work aw
+               LEFT JOIN book b ON b.work_id = aw.work_id
+               LEFT JOIN author_book ab ON ab.book_id = b.id AND ab.author_id = aw.author_id AND ab.role = 'AUTHOR'
+      GROUP BY aw.author_id, aw.work_id) ordered
+WHERE aw.author_id = ordered.author_id
+  AND aw.work_id = ordered.work_id;
+
+DELETE
+FROM author_book
+WHERE role = 'AUTHOR';
+
+UPDATE author_book ab
+SET position = ordered.position
+FROM (SELECT author_id, book_id, role, row_number() OVER (PARTITION BY book_id ORDER BY position, role, author_id) - 1 AS position
+      FROM author_book) ordered
+WHERE ab.author_id = ordered.author_id
+  AND ab.book_id = ordered.book_id
+  AND ab.role = ordered.role;
+
+ALTER TABLE author_book
+    ADD CONSTRAINT author_book_not_author CHECK (role <> 'AUTHOR');
+
+-- every book is an edition of a work, so the work can not be deleted while it has editions
+ALTER TABLE book
+    DROP CONSTRAINT book_work_id_fkey;
+
+ALTER TABLE book
+    ALTER COLUMN work_id SET NOT NULL;
+
+ALTER TABLE book
+    ADD CONSTRAINT book_work_id_fkey FOREIGN KEY (work_id) REFERENCES work (id);
+
+-- contributors of the book are authors of its work followed by contributors of the edition
+CREATE VIEW book_contributor AS
+SELECT b.id AS book_id, aw.author_id, 'AUTHOR'::contributor_role AS role, aw.position
+FROM book b
+         INNER JOIN author_work aw ON aw.work_id = b.work_id
+UNION ALL
+SELECT ab.book_id,
+       ab.author_id,
+       ab.role,
+       (SELECT count(*) FROM book b INNER JOIN author_work aw ON aw.work_id = b.work_id WHERE b.id = ab.book_id)::int
+           + ab.position
+FROM author_book ab;
+
+DROP MATERIALIZED VIEW library_stats_author_books;
+
+DROP MATERIALIZED VIEW library_stats;
+
+CREATE MATERIALIZED VIEW library_stats AS
+SELECT 1                                                                  AS id,
+       (SELECT count(*) FROM book)                                        AS book_count,
+       (SELECT count(*) FROM author)                                      AS author_count,
+       (SELECT count(*)
+        FROM book b
+        WHERE NOT EXISTS (SELECT 1 FROM author_work aw WHERE aw.work_id = b.work_id))
+                                                                          AS books_without_authors,
+       COALESCE((SELECT count(*) FROM book b INNER JOIN author_work aw ON aw.work_id = b.work_id)::float8 /
+                NULLIF((SELECT count(*) FROM book), 0), 0)                AS average_authors_per_book,
+       now()                                                              AS refreshed_at;
+
+CREATE UNIQUE INDEX library_stats_id ON library_stats (id);
+
+CREATE MATERIALIZED VIEW library_stats_author_books AS
+SELECT a.id AS author_id, a.name, count(*) AS book_count
+FROM author a
+         JOIN author_work aw ON aw.author_id = a.id
+         JOIN book b ON b.work_id = aw.work_id
+GROUP BY a.id, a.name;
+
+CREATE UNIQUE INDEX library_stats_author_books_author_id ON library_stats_author_books (author_id);
+
+CREATE INDEX library_stats_author_books_book_count ON library_stats_author_books (book_count DESC, author_id);
+
+-- +goose Down
+-- Works can not be told apart from the ones created by users, so the books keep their works.
+DROP MATERIALIZED VIEW library_stats_author_books;
+
+DROP MATERIALIZED VIEW library_stats;
+
+DROP VIEW book_contributor;
+
+ALTER TABLE book
+    DROP CONSTRAINT book_work_id_fkey;
+
+ALTER TABLE book
+    ALTER COLUMN work_id DROP NOT NULL;
+
+ALTER TABLE book
+    ADD CONSTRAINT book_work_id_fkey FOREIGN KEY (work_id) REFERENCES work (id) ON DELETE SET NULL;
+
+ALTER TABLE author_book
+    DROP CONSTRAINT author_book_not_author;
+
+UPDATE author_book ab
+SET position = ab.position + (SELECT count(*)
+                              FROM book b
+                                       INNER JOIN author_work aw ON aw.work_id = b.work_id
+                              WHERE b.id = ab.book_id);
+
+INSERT INTO author_book (author_id, book_id, role, position)
+SELECT aw.author_id, b.id, 'AUTHOR', aw.position
+FROM book b
+         INNER JOIN author_work aw ON aw.work_id = b.work_id;
+
+ALTER TABLE author_work
+    DROP COLUMN position;
+
+CREATE MATERIALIZED VIEW library_stats AS
+SELECT 1                                                                  AS id,
+       (SELECT count(*) FROM book)                                        AS book_count,
+       (SELECT count(*) FROM author)                                      AS author_count,
+       (SELECT count(*)
+        FROM book b
+        WHERE NOT EXISTS (SELECT 1 FROM author_book ab WHERE ab.book_id = b.id AND ab.role = 'AUTHOR'))
+                                                                          AS books_without_authors,
+       COALESCE((SELECT count(*) FROM author_book WHERE role = 'AUTHOR')::float8 /
+                NULLIF((SELECT count(*) FROM book), 0), 0)                AS average_authors_per_book,
+       now()                                                              AS refreshed_at;
+
+CREATE UNIQUE INDEX library_stats_id ON library_stats (id);
+
+CREATE MATERIALIZED VIEW library_stats_author_books AS
+SELECT a.id AS author_id, a.name, count(*) AS book_count
+FROM author a
+         JOIN author_book ab ON ab.author_id = a.id AND ab.role = 'AUTHOR'
+GROUP BY a.id, a.name;
+
+CREATE UNIQUE INDEX library_stats_author_books_author_id ON library_stats_author_books (author_id);
+
+CREATE INDEX library_stats_author_books_book_count ON library_stats_author_books (book_count DESC, author_id);
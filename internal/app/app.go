@@ -2,10 +2,12 @@ package app
 
 import (
 	"context"
+	"fmt"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"slices"
 	"syscall"
 	"time"
 
@@ -16,6 +18,7 @@ import (
 	gateway "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	generated "github.com/project/library/generated/api/library"
 	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
 	"github.com/project/library/internal/usecase/library"
 	"github.com/project/library/internal/usecase/repository"
 	"go.uber.org/zap"
@@ -25,7 +28,11 @@ import (
 )
 
 const (
-	shutDownSeconds = 3
+	shutDownSeconds              = 3
+	holdsExpiryPeriod            = time.Minute
+	finesAccrualPeriod           = time.Hour
+	recommendationsRefreshPeriod = time.Hour
+	statsRefreshPeriod           = 10 * time.Minute
 )
 
 func Run(logger *zap.Logger, cfg *config.Config) {
@@ -47,6 +54,13 @@ func Run(logger *zap.Logger, cfg *config.Config) {
 		logRepo = nil
 	}
 	repo := repository.New(logRepo, dbPool)
+	coverStorage := repository.NewFileStorage(cfg.Covers.Dir)
+
+	loanPolicy, err := newLoanPolicy(cfg)
+	if err != nil {
+		logger.Error("invalid loan policy", zap.Error(err))
+		return
+	}
 
 	var logUseCase *zap.Logger
 	if cfg.Log.LogUseCase {
@@ -54,7 +68,27 @@ func Run(logger *zap.Logger, cfg *config.Config) {
 	} else {
 		logUseCase = nil
 	}
-	useCases := library.New(logUseCase, repo, repo)
+	useCases := library.New(logUseCase, library.Repositories{
+		Author:         repo,
+		Books:          repo,
+		Publisher:      repo,
+		Work:           repo,
+		Relation:       repo,
+		Copy:           repo,
+		Member:         repo,
+		Loan:           repo,
+		Hold:           repo,
+		Branch:         repo,
+		Review:         repo,
+		ReadingList:    repo,
+		Recommendation: repo,
+		Stats:          repo,
+		Cover:          repo,
+		FileStorage:    coverStorage,
+	}, library.Options{
+		MaxCoverSize: cfg.Covers.MaxSize,
+		LoanPolicy:   loanPolicy,
+	})
 
 	var logController *zap.Logger
 	if cfg.Log.LogController {
@@ -62,15 +96,110 @@ func Run(logger *zap.Logger, cfg *config.Config) {
 	} else {
 		logController = nil
 	}
-	ctrl := controller.New(logController, useCases, useCases)
+	ctrl := controller.New(logController, controller.UseCases{
+		Books:          useCases,
+		Author:         useCases,
+		Publisher:      useCases,
+		Work:           useCases,
+		Cover:          useCases,
+		Relation:       useCases,
+		Copy:           useCases,
+		Member:         useCases,
+		Loan:           useCases,
+		Hold:           useCases,
+		Branch:         useCases,
+		Review:         useCases,
+		ReadingList:    useCases,
+		Recommendation: useCases,
+		Stats:          useCases,
+	})
 
 	go runRest(ctx, cfg, logger)
 	go runGrpc(cfg, logger, ctrl)
+	go runJob(ctx, holdsExpiryPeriod, useCases.ExpireHolds)
+	go runJob(ctx, finesAccrualPeriod, useCases.AccrueFines)
+	go runJob(ctx, recommendationsRefreshPeriod, useCases.RefreshRecommendations)
+	go runJob(ctx, statsRefreshPeriod, useCases.RefreshLibraryStats)
 
 	<-ctx.Done()
 	time.Sleep(time.Second * shutDownSeconds)
 }
 
+func newLoanPolicy(cfg *config.Config) (entity.LoanPolicy, error) {
+	const day = 24 * time.Hour
+
+	periodByType, err := byMembershipType("LOAN_PERIOD_DAYS_BY_TYPE", cfg.Loans.PeriodDaysByType)
+	if err != nil {
+		return entity.LoanPolicy{}, err
+	}
+
+	maxRenewalsByType, err := byMembershipType("RENEWAL_MAX_BY_TYPE", cfg.Renewals.MaxByType)
+	if err != nil {
+		return entity.LoanPolicy{}, err
+	}
+
+	renewalPeriodByType, err := byMembershipType("RENEWAL_PERIOD_DAYS_BY_TYPE", cfg.Renewals.PeriodDaysByType)
+	if err != nil {
+		return entity.LoanPolicy{}, err
+	}
+
+	policy := entity.LoanPolicy{
+		Period:       time.Duration(cfg.Loans.PeriodDays) * day,
+		PeriodByType: make(map[entity.MembershipType]time.Duration, len(periodByType)),
+		PickupWindow: time.Duration(cfg.Loans.PickupDays) * day,
+		Fines: entity.FinePolicy{
+			PerDay:     cfg.Fines.PerDay,
+			GraceDays:  cfg.Fines.GraceDays,
+			MaxPerItem: cfg.Fines.MaxPerItem,
+		},
+		Renewals: entity.RenewalPolicy{
+			MaxRenewals:       cfg.Renewals.Max,
+			MaxRenewalsByType: maxRenewalsByType,
+			Period:            time.Duration(cfg.Renewals.PeriodDays) * day,
+			PeriodByType:      make(map[entity.MembershipType]time.Duration, len(renewalPeriodByType)),
+			MaxFines:          cfg.Renewals.MaxFines,
+		},
+	}
+
+	for membershipType, days := range periodByType {
+		policy.PeriodByType[membershipType] = time.Duration(days) * day
+	}
+	for membershipType, days := range renewalPeriodByType {
+		policy.Renewals.PeriodByType[membershipType] = time.Duration(days) * day
+	}
+	return policy, nil
+}
+
+// byMembershipType checks that keys of the configured values are known membership types.
+func byMembershipType(envVar string, values map[string]int64) (map[entity.MembershipType]int64, error) {
+	result := make(map[entity.MembershipType]int64, len(values))
+	for name, value := range values {
+		membershipType := entity.MembershipType(name)
+		if !slices.Contains(entity.MembershipTypes, membershipType) {
+			return nil, fmt.Errorf("unknown membership type %q in %s", name, envVar)
+		}
+		result[membershipType] = value
+	}
+	return result, nil
+}
+
+// runJob runs the job on start and then every period until ctx is done,
+// errors are logged by the use case and the next attempt is made on the next tick.
+func runJob(ctx context.Context, period time.Duration, job func(ctx context.Context) error) {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	for {
+		_ = job(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
 func runRest(ctx context.Context, cfg *config.Config, logger *zap.Logger) {
 	mux := gateway.NewServeMux()
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
@@ -83,6 +212,45 @@ func runRest(ctx context.Context, cfg *config.Config, logger *zap.Logger) {
 		os.Exit(-1)
 	}
 
+	conn, err := grpc.NewClient(address, opts...)
+	if err != nil {
+		logger.Error("can not create grpc client", zap.Error(err))
+		os.Exit(-1)
+	}
+	defer conn.Close()
+
+	err = mux.HandlePath(http.MethodGet, coverPath, downloadCoverHandler(generated.NewLibraryClient(conn), logger))
+	if err != nil {
+		logger.Error("can not register cover handler", zap.Error(err))
+		os.Exit(-1)
+	}
+
+	err = mux.HandlePath(http.MethodGet, exportPath, exportCatalogHandler(generated.NewLibraryClient(conn), logger))
+	if err != nil {
+		logger.Error("can not register export handler", zap.Error(err))
+		os.Exit(-1)
+	}
+
+	for _, path := range []string{bookMarcPath, catalogMarcPath} {
+		err = mux.HandlePath(http.MethodGet, path, exportMarcHandler(generated.NewLibraryClient(conn), logger))
+		if err != nil {
+			logger.Error("can not register MARC handler", zap.Error(err), zap.String("path", path))
+			os.Exit(-1)
+		}
+	}
+
+	err = mux.HandlePath(http.MethodGet, bookCitationPath, bookCitationHandler(generated.NewLibraryClient(conn), logger))
+	if err != nil {
+		logger.Error("can not register citation handler", zap.Error(err))
+		os.Exit(-1)
+	}
+
+	err = mux.HandlePath(http.MethodGet, citationsPath, citationsHandler(generated.NewLibraryClient(conn), logger))
+	if err != nil {
+		logger.Error("can not register citations handler", zap.Error(err))
+		os.Exit(-1)
+	}
+
 	gatewayPort := ":" + cfg.GRPC.GatewayPort
 	logger.Info("gateway listening at port", zap.String("port", gatewayPort))
 
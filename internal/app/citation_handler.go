@@ -0,0 +1,84 @@
+package app
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	gateway "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	generated "github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+)
+
+const (
+	bookCitationPath = "/v1/library/book/{book_id}/citation"
+	citationsPath    = "/v1/library/citations"
+)
+
+// parseCitationFormat parses format query parameter like "bibtex" or "csl-json", BibTeX by default.
+func parseCitationFormat(w http.ResponseWriter, r *http.Request) (generated.CitationFormat, bool) {
+	value := r.URL.Query().Get("format")
+	if value == "" {
+		return generated.CitationFormat_CITATION_FORMAT_BIBTEX, true
+	}
+
+	parsed, ok := generated.CitationFormat_value["CITATION_FORMAT_"+strings.ToUpper(strings.ReplaceAll(value, "-", "_"))]
+	if !ok {
+		http.Error(w, "unknown citation format: "+value, http.StatusBadRequest)
+	}
+	return generated.CitationFormat(parsed), ok
+}
+
+func writeCitation(w http.ResponseWriter, contentType, citation string, logger *zap.Logger) {
+	w.Header().Set("Content-Type", contentType)
+	if _, err := io.WriteString(w, citation); err != nil {
+		logger.Error("can not send citation", zap.Error(err))
+	}
+}
+
+// bookCitationHandler serves the citation of the book as is with the content type of its format.
+func bookCitationHandler(client generated.LibraryClient, logger *zap.Logger) gateway.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		format, ok := parseCitationFormat(w, r)
+		if !ok {
+			return
+		}
+
+		response, err := client.GetBookCitation(r.Context(), &generated.GetBookCitationRequest{
+			BookId: pathParams["book_id"],
+			Format: format,
+		})
+		if err != nil {
+			writeStatusError(w, err)
+			return
+		}
+
+		writeCitation(w, response.GetContentType(), response.GetCitation(), logger)
+	}
+}
+
+// citationsHandler serves citations of the books given by repeated or comma separated book_id query parameters.
+func citationsHandler(client generated.LibraryClient, logger *zap.Logger) gateway.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		format, ok := parseCitationFormat(w, r)
+		if !ok {
+			return
+		}
+
+		var ids []string
+		for _, value := range r.URL.Query()["book_id"] {
+			ids = append(ids, strings.Split(value, ",")...)
+		}
+
+		response, err := client.GetBookCitations(r.Context(), &generated.GetBookCitationsRequest{
+			BookIds: ids,
+			Format:  format,
+		})
+		if err != nil {
+			writeStatusError(w, err)
+			return
+		}
+
+		writeCitation(w, response.GetContentType(), response.GetCitation(), logger)
+	}
+}
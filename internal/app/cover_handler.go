@@ -0,0 +1,65 @@
+package app
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	gateway "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	generated "github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/status"
+)
+
+const coverPath = "/v1/library/book/{book_id}/cover"
+
+// downloadCoverHandler serves the book cover as a raw image with its content type,
+// generated gateway handler can not be used, because it writes delimiters between streamed messages.
+func downloadCoverHandler(client generated.LibraryClient, logger *zap.Logger) gateway.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		size := generated.CoverSize_COVER_SIZE_ORIGINAL
+		if value := r.URL.Query().Get("size"); value != "" {
+			parsed, ok := generated.CoverSize_value["COVER_SIZE_"+strings.ToUpper(value)]
+			if !ok {
+				http.Error(w, "unknown cover size: "+value, http.StatusBadRequest)
+				return
+			}
+			size = generated.CoverSize(parsed)
+		}
+
+		stream, err := client.DownloadBookCover(r.Context(), &generated.DownloadBookCoverRequest{
+			BookId: pathParams["book_id"],
+			Size:   size,
+		})
+		if err != nil {
+			writeStatusError(w, err)
+			return
+		}
+
+		// errors of the request are returned with the first message
+		chunk, err := stream.Recv()
+		if err != nil {
+			writeStatusError(w, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", chunk.GetContentType())
+		for ; err == nil; chunk, err = stream.Recv() {
+			if _, err = w.Write(chunk.GetChunk()); err != nil {
+				break
+			}
+		}
+
+		// the status is already sent, so the broken image is reported by aborting the response
+		if !errors.Is(err, io.EOF) {
+			logger.Error("can not send cover", zap.Error(err))
+			panic(http.ErrAbortHandler)
+		}
+	}
+}
+
+func writeStatusError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	http.Error(w, st.Message(), gateway.HTTPStatusFromCode(st.Code()))
+}
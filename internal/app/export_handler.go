@@ -0,0 +1,77 @@
+package app
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	gateway "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	generated "github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const exportPath = "/v1/library/catalog/export"
+
+// exportCatalogHandler serves the exported catalog as a file with the content type of its format,
+// the range of updated_at is given in RFC 3339 by updated_from and updated_to query parameters.
+func exportCatalogHandler(client generated.LibraryClient, logger *zap.Logger) gateway.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		query := r.URL.Query()
+		req := &generated.ExportCatalogRequest{}
+
+		extension := "csv"
+		if value := query.Get("format"); value != "" {
+			parsed, ok := generated.ExportFormat_value["EXPORT_FORMAT_"+strings.ToUpper(value)]
+			if !ok {
+				http.Error(w, "unknown export format: "+value, http.StatusBadRequest)
+				return
+			}
+			req.Format = generated.ExportFormat(parsed)
+			extension = strings.ToLower(value)
+		}
+
+		for param, field := range map[string]**timestamppb.Timestamp{
+			"updated_from": &req.UpdatedFrom,
+			"updated_to":   &req.UpdatedTo,
+		} {
+			if value := query.Get(param); value != "" {
+				parsed, err := time.Parse(time.RFC3339, value)
+				if err != nil {
+					http.Error(w, "invalid "+param+": "+value, http.StatusBadRequest)
+					return
+				}
+				*field = timestamppb.New(parsed)
+			}
+		}
+
+		stream, err := client.ExportCatalog(r.Context(), req)
+		if err != nil {
+			writeStatusError(w, err)
+			return
+		}
+
+		// errors of the request are returned with the first message
+		chunk, err := stream.Recv()
+		if err != nil {
+			writeStatusError(w, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", chunk.GetContentType())
+		w.Header().Set("Content-Disposition", `attachment; filename="catalog.`+extension+`"`)
+		for ; err == nil; chunk, err = stream.Recv() {
+			if _, err = w.Write(chunk.GetChunk()); err != nil {
+				break
+			}
+		}
+
+		// the status is already sent, so the broken file is reported by aborting the response
+		if !errors.Is(err, io.EOF) {
+			logger.Error("can not send catalog", zap.Error(err))
+			panic(http.ErrAbortHandler)
+		}
+	}
+}
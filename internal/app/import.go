@@ -0,0 +1,89 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/project/library/config"
+	generated "github.com/project/library/generated/api/library"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const importChunkSize = 64 * 1024
+
+var errImportFailed = errors.New("some rows were not imported")
+
+// Import streams the CSV catalog from the file or stdin to ImportCatalog of the running server
+// and prints rows which were not imported with the summary of the import.
+func Import(cfg *config.Config, args []string) error {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	address := flags.String("addr", "localhost:"+cfg.GRPC.Port, "address of the grpc server")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: library import [-addr host:port] [file|-]")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	input := os.Stdin
+	if path := flags.Arg(0); path != "" && path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		input = file
+	}
+
+	conn, err := grpc.NewClient(*address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	stream, err := generated.NewLibraryClient(conn).ImportCatalog(context.Background())
+	if err != nil {
+		return err
+	}
+
+	chunk := make([]byte, importChunkSize)
+	for {
+		n, readErr := input.Read(chunk)
+		if n > 0 {
+			if err = stream.Send(&generated.ImportCatalogRequest{Chunk: chunk[:n]}); err != nil {
+				// the reason is returned by CloseAndRecv
+				break
+			}
+		}
+		if errors.Is(readErr, io.EOF) {
+			break
+		}
+		if readErr != nil {
+			return readErr
+		}
+	}
+
+	response, err := stream.CloseAndRecv()
+	if err != nil {
+		return err
+	}
+
+	for _, result := range response.GetResults() {
+		if result.GetError() != "" {
+			fmt.Fprintf(os.Stderr, "line %d: %s\n", result.GetLine(), result.GetError())
+		}
+	}
+	fmt.Printf("imported: %d, failed: %d, authors created: %d\n",
+		response.GetImported(), response.GetFailed(), response.GetAuthorsCreated())
+
+	if response.GetFailed() > 0 {
+		return errImportFailed
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package app
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	gateway "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	generated "github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+)
+
+const (
+	bookMarcPath    = "/v1/library/book/{book_id}/marc"
+	catalogMarcPath = "/v1/library/catalog/marc"
+)
+
+var marcExtensions = map[generated.MarcFormat]string{
+	generated.MarcFormat_MARC_FORMAT_MARC21:  "mrc",
+	generated.MarcFormat_MARC_FORMAT_MARCXML: "xml",
+}
+
+// exportMarcHandler serves MARC records of the book if book_id is in the path or of the whole catalog
+// as a file with the content type of the format.
+func exportMarcHandler(client generated.LibraryClient, logger *zap.Logger) gateway.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		req := &generated.ExportMarcRequest{BookId: pathParams["book_id"]}
+		if value := r.URL.Query().Get("format"); value != "" {
+			parsed, ok := generated.MarcFormat_value["MARC_FORMAT_"+strings.ToUpper(value)]
+			if !ok {
+				http.Error(w, "unknown MARC format: "+value, http.StatusBadRequest)
+				return
+			}
+			req.Format = generated.MarcFormat(parsed)
+		}
+
+		stream, err := client.ExportMarc(r.Context(), req)
+		if err != nil {
+			writeStatusError(w, err)
+			return
+		}
+
+		// errors of the request are returned with the first message
+		chunk, err := stream.Recv()
+		if err != nil {
+			writeStatusError(w, err)
+			return
+		}
+
+		name := "catalog"
+		if req.GetBookId() != "" {
+			name = req.GetBookId()
+		}
+		w.Header().Set("Content-Type", chunk.GetContentType())
+		w.Header().Set("Content-Disposition", `attachment; filename="`+name+"."+marcExtensions[req.GetFormat()]+`"`)
+		for ; err == nil; chunk, err = stream.Recv() {
+			if _, err = w.Write(chunk.GetChunk()); err != nil {
+				break
+			}
+		}
+
+		// the status is already sent, so the broken file is reported by aborting the response
+		if !errors.Is(err, io.EOF) {
+			logger.Error("can not send MARC records", zap.Error(err))
+			panic(http.ErrAbortHandler)
+		}
+	}
+}
@@ -17,7 +17,11 @@ func (i *implementation) AddBook(ctx context.Context, req *library.AddBookReques
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	book, err := i.booksUseCase.AddBook(ctx, req.GetName(), req.GetAuthorIds())
+	if req.GetWorkId() != "" && hasAuthors(req.GetAuthorIds(), req.GetContributors()) {
+		return nil, status.Error(codes.InvalidArgument, "authors of an edition are the authors of its work")
+	}
+
+	book, err := i.booksUseCase.AddBook(ctx, req.GetName(), req.GetAuthorIds(), req.GetContributors(), req.GetPublisherId(), req.GetWorkId())
 
 	if err != nil {
 		return nil, i.convertErr(err)
@@ -25,3 +29,15 @@ func (i *implementation) AddBook(ctx context.Context, req *library.AddBookReques
 
 	return book, nil
 }
+
+func hasAuthors(authorIDs []string, contributors []*library.Contributor) bool {
+	if len(authorIDs) > 0 {
+		return true
+	}
+	for _, c := range contributors {
+		if c.GetRole() == library.ContributorRole_CONTRIBUTOR_ROLE_AUTHOR {
+			return true
+		}
+	}
+	return false
+}
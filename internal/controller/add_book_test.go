@@ -26,16 +26,76 @@ func TestAddBook(t *testing.T) {
 				AuthorIds: []string{uuid.NewString()}},
 			codeResponse: codes.OK},
 
+		{name: "Good book with publisher",
+			request: &library.AddBookRequest{
+				AuthorIds:   []string{uuid.NewString()},
+				PublisherId: uuid.NewString()},
+			codeResponse: codes.OK},
+
 		{name: "Book with invalid author id",
 			request: &library.AddBookRequest{
 				AuthorIds: []string{"123"}},
 			codeResponse: codes.InvalidArgument},
 
+		{name: "Good book with contributors",
+			request: &library.AddBookRequest{
+				AuthorIds: []string{uuid.NewString()},
+				Contributors: []*library.Contributor{
+					{AuthorId: uuid.NewString(), Role: library.ContributorRole_CONTRIBUTOR_ROLE_TRANSLATOR},
+				}},
+			codeResponse: codes.OK},
+
+		{name: "Book with invalid contributor id",
+			request: &library.AddBookRequest{
+				Contributors: []*library.Contributor{
+					{AuthorId: "123", Role: library.ContributorRole_CONTRIBUTOR_ROLE_EDITOR},
+				}},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Book with unspecified contributor role",
+			request: &library.AddBookRequest{
+				Contributors: []*library.Contributor{
+					{AuthorId: uuid.NewString()},
+				}},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Book with invalid publisher id",
+			request: &library.AddBookRequest{
+				PublisherId: "123"},
+			codeResponse: codes.InvalidArgument},
+
 		{name: "Book with unknown author id",
 			request: &library.AddBookRequest{
 				AuthorIds: []string{uuid.NewString()}},
 			codeResponse: codes.NotFound},
 
+		{name: "Good edition of a work",
+			request: &library.AddBookRequest{
+				WorkId: uuid.NewString(),
+				Contributors: []*library.Contributor{
+					{AuthorId: uuid.NewString(), Role: library.ContributorRole_CONTRIBUTOR_ROLE_TRANSLATOR},
+				}},
+			codeResponse: codes.OK},
+
+		{name: "Edition of a work with author ids",
+			request: &library.AddBookRequest{
+				WorkId:    uuid.NewString(),
+				AuthorIds: []string{uuid.NewString()}},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Edition of a work with author contributors",
+			request: &library.AddBookRequest{
+				WorkId: uuid.NewString(),
+				Contributors: []*library.Contributor{
+					{AuthorId: uuid.NewString(), Role: library.ContributorRole_CONTRIBUTOR_ROLE_AUTHOR},
+				}},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Book with invalid work id",
+			request: &library.AddBookRequest{
+				WorkId: "123"},
+			codeResponse: codes.InvalidArgument},
+
 		{name: "Book with internal error",
 			request: &library.AddBookRequest{
 				AuthorIds: []string{uuid.NewString()},
@@ -52,9 +112,19 @@ func TestAddBook(t *testing.T) {
 			req := test.request
 			rName := req.GetName()
 			authorIDs := req.GetAuthorIds()
+			contributors := req.GetContributors()
+			publisherID := req.GetPublisherId()
+			workID := req.GetWorkId()
 
 			if code != codes.InvalidArgument {
-				mockBooksUseCase.EXPECT().AddBook(ctx, rName, authorIDs).DoAndReturn(func(ctx context.Context, name string, IDs []string) (*library.AddBookResponse, error) {
+				mockBooksUseCase.EXPECT().AddBook(ctx, rName, authorIDs, contributors, publisherID, workID).DoAndReturn(func(
+					ctx context.Context,
+					name string,
+					IDs []string,
+					contributors []*library.Contributor,
+					pID string,
+					wID string,
+				) (*library.AddBookResponse, error) {
 					e := convertBookCodeToError(code)
 					if code != codes.OK {
 						return nil, e
@@ -62,9 +132,12 @@ func TestAddBook(t *testing.T) {
 
 					return &library.AddBookResponse{
 						Book: &library.Book{
-							Id:       uuid.NewString(),
-							Name:     name,
-							AuthorId: IDs,
+							Id:          uuid.NewString(),
+							Name:        name,
+							AuthorId:     IDs,
+							Contributors: contributors,
+							PublisherId:  pID,
+							WorkId:       wID,
 						},
 					}, e
 				})
@@ -84,6 +157,8 @@ func TestAddBook(t *testing.T) {
 			require.NoError(t, err)
 			require.Equal(t, book.GetName(), rName)
 			require.Equal(t, book.GetAuthorId(), authorIDs)
+			require.Equal(t, book.GetPublisherId(), publisherID)
+			require.Equal(t, book.GetWorkId(), workID)
 		})
 	}
 }
@@ -0,0 +1,34 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/pkg/logger"
+
+	"go.uber.org/zap"
+
+	"github.com/project/library/generated/api/library"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) AddCopy(ctx context.Context, req *library.AddCopyRequest) (*library.AddCopyResponse, error) {
+	if err := req.ValidateAll(); logger.CheckError(err, i.logger, "Got invalid request", zap.Any("request", req), zap.Error(err)) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	response, err := i.copyUseCase.AddCopy(ctx, &library.Copy{
+		BookId:          req.GetBookId(),
+		Barcode:         req.GetBarcode(),
+		Location:        req.GetLocation(),
+		AcquisitionDate: req.GetAcquisitionDate(),
+		Condition:       req.GetCondition(),
+		HomeBranchId:    req.GetHomeBranchId(),
+	})
+
+	if err != nil {
+		return nil, i.convertErr(err)
+	}
+
+	return response, nil
+}
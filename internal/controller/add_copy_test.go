@@ -0,0 +1,134 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestAddCopy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.AddCopyRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid add copy",
+			request: &library.AddCopyRequest{
+				BookId:          uuid.NewString(),
+				Barcode:         "LIB-0001",
+				Location:        "A-12-3",
+				AcquisitionDate: timestamppb.Now(),
+				Condition:       library.CopyCondition_COPY_CONDITION_NEW},
+			codeResponse: codes.OK},
+
+		{name: "Valid add copy without location and date",
+			request: &library.AddCopyRequest{
+				BookId:    uuid.NewString(),
+				Barcode:   "0001",
+				Condition: library.CopyCondition_COPY_CONDITION_GOOD},
+			codeResponse: codes.OK},
+
+		{name: "Invalid book id",
+			request: &library.AddCopyRequest{
+				BookId:    "123",
+				Barcode:   "LIB-0001",
+				Condition: library.CopyCondition_COPY_CONDITION_GOOD},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Empty barcode",
+			request: &library.AddCopyRequest{
+				BookId:    uuid.NewString(),
+				Condition: library.CopyCondition_COPY_CONDITION_GOOD},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid barcode",
+			request: &library.AddCopyRequest{
+				BookId:    uuid.NewString(),
+				Barcode:   "LIB 0001",
+				Condition: library.CopyCondition_COPY_CONDITION_GOOD},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unspecified condition",
+			request: &library.AddCopyRequest{
+				BookId:  uuid.NewString(),
+				Barcode: "LIB-0001"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Existing barcode",
+			request: &library.AddCopyRequest{
+				BookId:    uuid.NewString(),
+				Barcode:   "LIB-0001",
+				Condition: library.CopyCondition_COPY_CONDITION_GOOD},
+			codeResponse: codes.AlreadyExists,
+			useCaseErr:   entity.ErrCopyAlreadyExists},
+
+		{name: "Unknown book",
+			request: &library.AddCopyRequest{
+				BookId:    uuid.NewString(),
+				Barcode:   "LIB-0001",
+				Condition: library.CopyCondition_COPY_CONDITION_GOOD},
+			codeResponse: codes.NotFound,
+			useCaseErr:   entity.ErrBookNotFound},
+
+		{name: "Internal error",
+			request: &library.AddCopyRequest{
+				BookId:    uuid.NewString(),
+				Barcode:   "LIB-0001",
+				Condition: library.CopyCondition_COPY_CONDITION_GOOD},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockCopyUseCase, s := InitCopyTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			id := uuid.NewString()
+			if code != codes.InvalidArgument {
+				mockCopyUseCase.EXPECT().AddCopy(ctx, &library.Copy{
+					BookId:          req.GetBookId(),
+					Barcode:         req.GetBarcode(),
+					Location:        req.GetLocation(),
+					AcquisitionDate: req.GetAcquisitionDate(),
+					Condition:       req.GetCondition(),
+				}).DoAndReturn(func(_ context.Context, c *library.Copy) (*library.AddCopyResponse, error) {
+					if test.useCaseErr != nil {
+						return nil, test.useCaseErr
+					}
+					return &library.AddCopyResponse{
+						Copy: &library.Copy{
+							Id:        id,
+							BookId:    c.GetBookId(),
+							Barcode:   c.GetBarcode(),
+							Condition: c.GetCondition(),
+							Status:    library.CopyStatus_COPY_STATUS_AVAILABLE,
+						},
+					}, nil
+				})
+			}
+
+			response, err := s.AddCopy(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, id, response.GetCopy().GetId())
+			require.Equal(t, req.GetBarcode(), response.GetCopy().GetBarcode())
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCancelHold(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.CancelHoldRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid cancel",
+			request:      &library.CancelHoldRequest{Id: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Invalid id",
+			request:      &library.CancelHoldRequest{Id: "123"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown hold",
+			request:      &library.CancelHoldRequest{Id: uuid.NewString()},
+			codeResponse: codes.NotFound,
+			useCaseErr:   entity.ErrHoldNotFound},
+
+		{name: "Internal error",
+			request:      &library.CancelHoldRequest{Id: uuid.NewString()},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockHoldUseCase, s := InitHoldTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockHoldUseCase.EXPECT().CancelHold(ctx, req.GetId()).DoAndReturn(
+					func(_ context.Context, id string) (*library.CancelHoldResponse, error) {
+						if test.useCaseErr != nil {
+							return nil, test.useCaseErr
+						}
+						return &library.CancelHoldResponse{
+							Hold: &library.Hold{Id: id, Status: library.HoldStatus_HOLD_STATUS_CANCELLED},
+						}, nil
+					})
+			}
+
+			response, err := s.CancelHold(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, req.GetId(), response.GetHold().GetId())
+		})
+	}
+}
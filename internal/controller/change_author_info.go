@@ -2,7 +2,9 @@ package controller
 
 import (
 	"context"
+	"slices"
 
+	"github.com/project/library/internal/entity"
 	"github.com/project/library/pkg/logger"
 
 	"go.uber.org/zap"
@@ -17,7 +19,33 @@ func (i *implementation) ChangeAuthorInfo(ctx context.Context, req *library.Chan
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	err := i.authorUseCase.ChangeAuthorInfo(ctx, req.GetId(), req.GetName())
+	fields := req.GetUpdateMask().GetPaths()
+	if len(fields) == 0 {
+		fields = []string{entity.AuthorFieldName}
+	}
+
+	for _, field := range fields {
+		if !slices.Contains(entity.AuthorFields, field) {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown field in update mask: %q", field)
+		}
+	}
+
+	if slices.Contains(fields, entity.AuthorFieldName) && req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "author's name can not be empty")
+	}
+
+	if req.GetBirthDate() != nil && req.GetDeathDate() != nil && req.GetDeathDate().AsTime().Before(req.GetBirthDate().AsTime()) {
+		return nil, status.Error(codes.InvalidArgument, "death date can not be before birth date")
+	}
+
+	err := i.authorUseCase.ChangeAuthorInfo(ctx, req.GetId(), &library.Author{
+		Name:        req.GetName(),
+		BirthDate:   req.GetBirthDate(),
+		DeathDate:   req.GetDeathDate(),
+		Nationality: req.GetNationality(),
+		Biography:   req.GetBiography(),
+		Aliases:     req.GetAliases(),
+	}, fields)
 
 	if err != nil {
 		return nil, i.convertErr(err)
@@ -3,23 +3,31 @@ package controller
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestChangeAuthorInfo(t *testing.T) {
 	t.Parallel()
 
 	const validName = "Test Testovich"
+	birthDate := timestamppb.New(time.Date(1828, time.September, 9, 0, 0, 0, 0, time.UTC))
+	deathDate := timestamppb.New(time.Date(1910, time.November, 20, 0, 0, 0, 0, time.UTC))
 
 	tests := []struct {
 		name         string
 		request      *library.ChangeAuthorInfoRequest
 		codeResponse codes.Code
+		useCaseErr   error
 	}{
 		{name: "Valid change",
 			request: &library.ChangeAuthorInfoRequest{
@@ -45,6 +53,60 @@ func TestChangeAuthorInfo(t *testing.T) {
 				Name: validName},
 			codeResponse: codes.InvalidArgument},
 
+		{name: "Valid change of profile",
+			request: &library.ChangeAuthorInfoRequest{
+				Id:          uuid.NewString(),
+				BirthDate:   birthDate,
+				DeathDate:   deathDate,
+				Nationality: "RU",
+				Biography:   "Russian writer",
+				Aliases:     []string{"Lev Tolstoy", "Leo Tolstoy"},
+				UpdateMask: &fieldmaskpb.FieldMask{
+					Paths: []string{"birth_date", "death_date", "nationality", "biography", "aliases"}}},
+			codeResponse: codes.OK},
+
+		{name: "Empty name in update mask",
+			request: &library.ChangeAuthorInfoRequest{
+				Id:         uuid.NewString(),
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name", "biography"}}},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown field in update mask",
+			request: &library.ChangeAuthorInfoRequest{
+				Id:         uuid.NewString(),
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"id"}}},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Death before birth",
+			request: &library.ChangeAuthorInfoRequest{
+				Id:         uuid.NewString(),
+				BirthDate:  deathDate,
+				DeathDate:  birthDate,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"birth_date", "death_date"}}},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Death before stored birth",
+			request: &library.ChangeAuthorInfoRequest{
+				Id:         uuid.NewString(),
+				DeathDate:  birthDate,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"death_date"}}},
+			codeResponse: codes.InvalidArgument,
+			useCaseErr:   entity.ErrInvalidLifeDates},
+
+		{name: "Invalid nationality",
+			request: &library.ChangeAuthorInfoRequest{
+				Id:          uuid.NewString(),
+				Nationality: "Russian",
+				UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"nationality"}}},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Duplicated aliases",
+			request: &library.ChangeAuthorInfoRequest{
+				Id:         uuid.NewString(),
+				Aliases:    []string{"Leo Tolstoy", "Leo Tolstoy"},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"aliases"}}},
+			codeResponse: codes.InvalidArgument},
+
 		{name: "Unknown author",
 			request: &library.ChangeAuthorInfoRequest{
 				Id:   uuid.NewString(),
@@ -66,8 +128,17 @@ func TestChangeAuthorInfo(t *testing.T) {
 			ctx := context.Background()
 			code := test.codeResponse
 			req := test.request
-			if code != codes.InvalidArgument {
-				mockAuthorUseCase.EXPECT().ChangeAuthorInfo(ctx, req.GetId(), req.GetName()).DoAndReturn(func(ctx context.Context, id, name string) error {
+			if code != codes.InvalidArgument || test.useCaseErr != nil {
+				fields := req.GetUpdateMask().GetPaths()
+				if len(fields) == 0 {
+					fields = []string{"name"}
+				}
+				mockAuthorUseCase.EXPECT().ChangeAuthorInfo(ctx, req.GetId(), gomock.Any(), fields).DoAndReturn(func(ctx context.Context, id string, newInfo *library.Author, fields []string) error {
+					require.Equal(t, req.GetName(), newInfo.GetName())
+					require.Equal(t, req.GetAliases(), newInfo.GetAliases())
+					if test.useCaseErr != nil {
+						return test.useCaseErr
+					}
 					return convertAuthorCodeToError(code)
 				})
 			}
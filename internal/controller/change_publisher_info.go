@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/pkg/logger"
+
+	"go.uber.org/zap"
+
+	"github.com/project/library/generated/api/library"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) ChangePublisherInfo(ctx context.Context, req *library.ChangePublisherInfoRequest) (*library.ChangePublisherInfoResponse, error) {
+	if err := req.ValidateAll(); logger.CheckError(err, i.logger, "Got invalid request", zap.Any("request", req), zap.Error(err)) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.GetParentId() == req.GetId() {
+		return nil, status.Error(codes.InvalidArgument, "publisher can not be an imprint of itself")
+	}
+
+	err := i.publisherUseCase.ChangePublisherInfo(ctx, req.GetId(), req.GetName(), req.GetCountry(), req.GetParentId())
+
+	if err != nil {
+		return nil, i.convertErr(err)
+	}
+
+	return &library.ChangePublisherInfoResponse{}, nil
+}
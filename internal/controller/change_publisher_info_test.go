@@ -0,0 +1,93 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestChangePublisherInfo(t *testing.T) {
+	t.Parallel()
+
+	const validName = "Vintage Books"
+	selfID := uuid.NewString()
+
+	tests := []struct {
+		name         string
+		request      *library.ChangePublisherInfoRequest
+		codeResponse codes.Code
+	}{
+		{name: "Valid change",
+			request: &library.ChangePublisherInfoRequest{
+				Id:       uuid.NewString(),
+				Name:     validName,
+				Country:  "US",
+				ParentId: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Empty publisher's name",
+			request: &library.ChangePublisherInfoRequest{
+				Id:   uuid.NewString(),
+				Name: ""},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Incorrect id",
+			request: &library.ChangePublisherInfoRequest{
+				Id:   "123",
+				Name: validName},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Publisher is an imprint of itself",
+			request: &library.ChangePublisherInfoRequest{
+				Id:       selfID,
+				Name:     validName,
+				ParentId: selfID},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown publisher",
+			request: &library.ChangePublisherInfoRequest{
+				Id:   uuid.NewString(),
+				Name: validName},
+			codeResponse: codes.NotFound},
+
+		{name: "Internal error",
+			request: &library.ChangePublisherInfoRequest{
+				Id:   uuid.NewString(),
+				Name: validName},
+			codeResponse: codes.Internal},
+		{name: "Imprints make a cycle",
+			request: &library.ChangePublisherInfoRequest{
+				Id:       uuid.NewString(),
+				Name:     validName,
+				ParentId: uuid.NewString()},
+			codeResponse: codes.FailedPrecondition},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockPublisherUseCase, s := InitPublisherTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockPublisherUseCase.EXPECT().ChangePublisherInfo(ctx, req.GetId(), req.GetName(), req.GetCountry(), req.GetParentId()).
+					Return(convertPublisherCodeToError(code))
+			}
+
+			response, err := s.ChangePublisherInfo(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.NotNil(t, response)
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestChangeWorkInfo(t *testing.T) {
+	t.Parallel()
+
+	const validWorkName = "War and Peace"
+	tests := []struct {
+		name         string
+		request      *library.ChangeWorkInfoRequest
+		codeResponse codes.Code
+	}{
+		{name: "Good change",
+			request: &library.ChangeWorkInfoRequest{
+				Id:        uuid.NewString(),
+				Name:      validWorkName,
+				AuthorIds: []string{uuid.NewString(), uuid.NewString()}},
+			codeResponse: codes.OK},
+
+		{name: "Change removing authors",
+			request: &library.ChangeWorkInfoRequest{
+				Id:   uuid.NewString(),
+				Name: validWorkName},
+			codeResponse: codes.OK},
+
+		{name: "Empty work's name",
+			request: &library.ChangeWorkInfoRequest{
+				Id: uuid.NewString()},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid work id",
+			request: &library.ChangeWorkInfoRequest{
+				Id:   "123",
+				Name: validWorkName},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid author id",
+			request: &library.ChangeWorkInfoRequest{
+				Id:        uuid.NewString(),
+				Name:      validWorkName,
+				AuthorIds: []string{"123"}},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown work",
+			request: &library.ChangeWorkInfoRequest{
+				Id:   uuid.NewString(),
+				Name: validWorkName},
+			codeResponse: codes.NotFound},
+
+		{name: "Internal error",
+			request: &library.ChangeWorkInfoRequest{
+				Id:   uuid.NewString(),
+				Name: validWorkName},
+			codeResponse: codes.Internal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockWorkUseCase, s := InitWorkTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+
+			if code != codes.InvalidArgument {
+				mockWorkUseCase.EXPECT().ChangeWorkInfo(ctx, req.GetId(), req.GetName(), req.GetAuthorIds()).
+					Return(convertWorkCodeToError(code))
+			}
+
+			response, err := s.ChangeWorkInfo(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.NotNil(t, response)
+		})
+	}
+}
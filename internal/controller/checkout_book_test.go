@@ -0,0 +1,92 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCheckoutBook(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.CheckoutBookRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid checkout",
+			request:      &library.CheckoutBookRequest{MemberId: uuid.NewString(), CopyId: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Invalid member id",
+			request:      &library.CheckoutBookRequest{MemberId: "123", CopyId: uuid.NewString()},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid copy id",
+			request:      &library.CheckoutBookRequest{MemberId: uuid.NewString(), CopyId: "123"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown member",
+			request:      &library.CheckoutBookRequest{MemberId: uuid.NewString(), CopyId: uuid.NewString()},
+			codeResponse: codes.NotFound,
+			useCaseErr:   entity.ErrMemberNotFound},
+
+		{name: "Unknown copy",
+			request:      &library.CheckoutBookRequest{MemberId: uuid.NewString(), CopyId: uuid.NewString()},
+			codeResponse: codes.NotFound,
+			useCaseErr:   entity.ErrCopyNotFound},
+
+		{name: "Copy not available",
+			request:      &library.CheckoutBookRequest{MemberId: uuid.NewString(), CopyId: uuid.NewString()},
+			codeResponse: codes.FailedPrecondition,
+			useCaseErr:   entity.ErrCopyNotAvailable},
+
+		{name: "Member cannot borrow",
+			request:      &library.CheckoutBookRequest{MemberId: uuid.NewString(), CopyId: uuid.NewString()},
+			codeResponse: codes.FailedPrecondition,
+			useCaseErr:   entity.ErrMemberCannotBorrow},
+
+		{name: "Internal error",
+			request:      &library.CheckoutBookRequest{MemberId: uuid.NewString(), CopyId: uuid.NewString()},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockLoanUseCase, s := InitLoanTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockLoanUseCase.EXPECT().CheckoutBook(ctx, req.GetMemberId(), req.GetCopyId()).DoAndReturn(
+					func(_ context.Context, idMember, idCopy string) (*library.CheckoutBookResponse, error) {
+						if test.useCaseErr != nil {
+							return nil, test.useCaseErr
+						}
+						return &library.CheckoutBookResponse{
+							Loan: &library.Loan{Id: uuid.NewString(), MemberId: idMember, CopyId: idCopy},
+						}, nil
+					})
+			}
+
+			response, err := s.CheckoutBook(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, req.GetMemberId(), response.GetLoan().GetMemberId())
+			require.Equal(t, req.GetCopyId(), response.GetLoan().GetCopyId())
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateBranch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.CreateBranchRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid branch",
+			request:      &library.CreateBranchRequest{Name: "Central", Address: "Main st. 1"},
+			codeResponse: codes.OK},
+
+		{name: "Empty name",
+			request:      &library.CreateBranchRequest{Address: "Main st. 1"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Too long address",
+			request:      &library.CreateBranchRequest{Name: "Central", Address: strings.Repeat("a", 1025)},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Branch already exists",
+			request:      &library.CreateBranchRequest{Name: "Central"},
+			codeResponse: codes.AlreadyExists,
+			useCaseErr:   entity.ErrBranchAlreadyExists},
+
+		{name: "Internal error",
+			request:      &library.CreateBranchRequest{Name: "Central"},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockBranchUseCase, s := InitBranchTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockBranchUseCase.EXPECT().CreateBranch(ctx, req.GetName(), req.GetAddress()).DoAndReturn(
+					func(_ context.Context, name, address string) (*library.CreateBranchResponse, error) {
+						if test.useCaseErr != nil {
+							return nil, test.useCaseErr
+						}
+						return &library.CreateBranchResponse{
+							Branch: &library.Branch{Id: uuid.NewString(), Name: name, Address: address},
+						}, nil
+					})
+			}
+
+			response, err := s.CreateBranch(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, req.GetName(), response.GetBranch().GetName())
+		})
+	}
+}
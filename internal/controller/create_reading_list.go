@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/pkg/logger"
+
+	"go.uber.org/zap"
+
+	"github.com/project/library/generated/api/library"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) CreateReadingList(ctx context.Context, req *library.CreateReadingListRequest) (*library.CreateReadingListResponse, error) {
+	if err := req.ValidateAll(); logger.CheckError(err, i.logger, "Got invalid request", zap.Any("request", req), zap.Error(err)) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.GetKind() == library.ReadingListKind_READING_LIST_KIND_CUSTOM && req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "custom reading list must have a name")
+	}
+
+	response, err := i.readingListUseCase.CreateReadingList(ctx, req.GetOwnerId(), req.GetName(), req.GetKind(), req.GetPublic())
+
+	if err != nil {
+		return nil, i.convertErr(err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,89 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateReadingList(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.CreateReadingListRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid to read list",
+			request:      &library.CreateReadingListRequest{OwnerId: "reader", Kind: library.ReadingListKind_READING_LIST_KIND_TO_READ},
+			codeResponse: codes.OK},
+
+		{name: "Valid custom list",
+			request:      &library.CreateReadingListRequest{OwnerId: "reader", Name: "Favourites", Kind: library.ReadingListKind_READING_LIST_KIND_CUSTOM, Public: true},
+			codeResponse: codes.OK},
+
+		{name: "Custom list without name",
+			request:      &library.CreateReadingListRequest{OwnerId: "reader", Kind: library.ReadingListKind_READING_LIST_KIND_CUSTOM},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unspecified kind",
+			request:      &library.CreateReadingListRequest{OwnerId: "reader", Name: "Favourites"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Empty owner",
+			request:      &library.CreateReadingListRequest{Kind: library.ReadingListKind_READING_LIST_KIND_READ},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Too long owner",
+			request:      &library.CreateReadingListRequest{OwnerId: strings.Repeat("a", 129), Kind: library.ReadingListKind_READING_LIST_KIND_READ},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "List already exists",
+			request:      &library.CreateReadingListRequest{OwnerId: "reader", Kind: library.ReadingListKind_READING_LIST_KIND_READ},
+			codeResponse: codes.AlreadyExists,
+			useCaseErr:   entity.ErrReadingListAlreadyExists},
+
+		{name: "Internal error",
+			request:      &library.CreateReadingListRequest{OwnerId: "reader", Kind: library.ReadingListKind_READING_LIST_KIND_READ},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockReadingListUseCase, s := InitReadingListTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockReadingListUseCase.EXPECT().CreateReadingList(ctx, req.GetOwnerId(), req.GetName(), req.GetKind(), req.GetPublic()).DoAndReturn(
+					func(_ context.Context, idOwner, name string, kind library.ReadingListKind, public bool) (*library.CreateReadingListResponse, error) {
+						if test.useCaseErr != nil {
+							return nil, test.useCaseErr
+						}
+						return &library.CreateReadingListResponse{
+							ReadingList: &library.ReadingList{Id: uuid.NewString(), OwnerId: idOwner, Name: name, Kind: kind, Public: public},
+						}, nil
+					})
+			}
+
+			response, err := s.CreateReadingList(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, req.GetKind(), response.GetReadingList().GetKind())
+		})
+	}
+}
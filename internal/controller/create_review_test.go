@@ -0,0 +1,95 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateReview(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.CreateReviewRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid review",
+			request:      &library.CreateReviewRequest{BookId: uuid.NewString(), MemberId: uuid.NewString(), Rating: 5, Text: "Great"},
+			codeResponse: codes.OK},
+
+		{name: "Valid review without text",
+			request:      &library.CreateReviewRequest{BookId: uuid.NewString(), MemberId: uuid.NewString(), Rating: 1},
+			codeResponse: codes.OK},
+
+		{name: "Zero rating",
+			request:      &library.CreateReviewRequest{BookId: uuid.NewString(), MemberId: uuid.NewString()},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Too big rating",
+			request:      &library.CreateReviewRequest{BookId: uuid.NewString(), MemberId: uuid.NewString(), Rating: 6},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Too long text",
+			request: &library.CreateReviewRequest{BookId: uuid.NewString(), MemberId: uuid.NewString(), Rating: 3,
+				Text: strings.Repeat("a", 10001)},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid book id",
+			request:      &library.CreateReviewRequest{BookId: "123", MemberId: uuid.NewString(), Rating: 3},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown member",
+			request:      &library.CreateReviewRequest{BookId: uuid.NewString(), MemberId: uuid.NewString(), Rating: 3},
+			codeResponse: codes.NotFound,
+			useCaseErr:   entity.ErrMemberNotFound},
+
+		{name: "Review already exists",
+			request:      &library.CreateReviewRequest{BookId: uuid.NewString(), MemberId: uuid.NewString(), Rating: 3},
+			codeResponse: codes.AlreadyExists,
+			useCaseErr:   entity.ErrReviewAlreadyExists},
+
+		{name: "Internal error",
+			request:      &library.CreateReviewRequest{BookId: uuid.NewString(), MemberId: uuid.NewString(), Rating: 3},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockReviewUseCase, s := InitReviewTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockReviewUseCase.EXPECT().CreateReview(ctx, req.GetBookId(), req.GetMemberId(), req.GetRating(), req.GetText()).DoAndReturn(
+					func(_ context.Context, idBook, idMember string, rating uint32, text string) (*library.CreateReviewResponse, error) {
+						if test.useCaseErr != nil {
+							return nil, test.useCaseErr
+						}
+						return &library.CreateReviewResponse{
+							Review: &library.Review{Id: uuid.NewString(), BookId: idBook, MemberId: idMember, Rating: rating, Text: text},
+						}, nil
+					})
+			}
+
+			response, err := s.CreateReview(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, req.GetRating(), response.GetReview().GetRating())
+		})
+	}
+}
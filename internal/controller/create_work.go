@@ -0,0 +1,27 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/pkg/logger"
+
+	"go.uber.org/zap"
+
+	"github.com/project/library/generated/api/library"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) CreateWork(ctx context.Context, req *library.CreateWorkRequest) (*library.CreateWorkResponse, error) {
+	if err := req.ValidateAll(); logger.CheckError(err, i.logger, "Got invalid request", zap.Any("request", req), zap.Error(err)) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	work, err := i.workUseCase.CreateWork(ctx, req.GetName(), req.GetAuthorIds())
+
+	if err != nil {
+		return nil, i.convertErr(err)
+	}
+
+	return work, nil
+}
@@ -0,0 +1,95 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateWork(t *testing.T) {
+	t.Parallel()
+
+	const validWorkName = "War and Peace"
+	tests := []struct {
+		name         string
+		request      *library.CreateWorkRequest
+		codeResponse codes.Code
+	}{
+		{name: "Good work",
+			request: &library.CreateWorkRequest{
+				Name:      validWorkName,
+				AuthorIds: []string{uuid.NewString()}},
+			codeResponse: codes.OK},
+
+		{name: "Empty work's name",
+			request: &library.CreateWorkRequest{
+				Name: ""},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Work with invalid author id",
+			request: &library.CreateWorkRequest{
+				Name:      validWorkName,
+				AuthorIds: []string{"123"}},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Work with unknown author id",
+			request: &library.CreateWorkRequest{
+				Name:      validWorkName,
+				AuthorIds: []string{uuid.NewString()}},
+			codeResponse: codes.NotFound},
+
+		{name: "Work with internal error",
+			request: &library.CreateWorkRequest{
+				Name: validWorkName},
+			codeResponse: codes.Internal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockWorkUseCase, s := InitWorkTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+
+			if code != codes.InvalidArgument {
+				mockWorkUseCase.EXPECT().CreateWork(ctx, req.GetName(), req.GetAuthorIds()).DoAndReturn(func(ctx context.Context, name string, IDs []string) (*library.CreateWorkResponse, error) {
+					if code != codes.OK {
+						return nil, convertAuthorCodeToError(code)
+					}
+
+					return &library.CreateWorkResponse{
+						Work: &library.Work{
+							Id:       uuid.NewString(),
+							Name:     name,
+							AuthorId: IDs,
+						},
+					}, nil
+				})
+			}
+
+			response, err := s.CreateWork(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			work := response.GetWork()
+			err = validation.ValidateStructWithContext(
+				ctx,
+				work,
+				validation.Field(&work.Id, is.UUID))
+			require.NoError(t, err)
+			require.Equal(t, req.GetName(), work.GetName())
+			require.Equal(t, req.GetAuthorIds(), work.GetAuthorId())
+		})
+	}
+}
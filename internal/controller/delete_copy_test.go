@@ -0,0 +1,69 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDeleteCopy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.DeleteCopyRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid delete copy",
+			request:      &library.DeleteCopyRequest{Id: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Invalid id",
+			request:      &library.DeleteCopyRequest{Id: "123"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown copy",
+			request:      &library.DeleteCopyRequest{Id: uuid.NewString()},
+			codeResponse: codes.NotFound,
+			useCaseErr:   entity.ErrCopyNotFound},
+
+		{name: "Copy with loans",
+			request:      &library.DeleteCopyRequest{Id: uuid.NewString()},
+			codeResponse: codes.FailedPrecondition,
+			useCaseErr:   entity.ErrCopyHasLoans},
+
+		{name: "Internal error",
+			request:      &library.DeleteCopyRequest{Id: uuid.NewString()},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockCopyUseCase, s := InitCopyTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockCopyUseCase.EXPECT().DeleteCopy(ctx, req.GetId()).Return(test.useCaseErr)
+			}
+
+			response, err := s.DeleteCopy(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.NotNil(t, response)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDeleteReview(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.DeleteReviewRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid delete review",
+			request:      &library.DeleteReviewRequest{Id: uuid.NewString(), MemberId: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Invalid id",
+			request:      &library.DeleteReviewRequest{Id: "123", MemberId: uuid.NewString()},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid member id",
+			request:      &library.DeleteReviewRequest{Id: uuid.NewString(), MemberId: "456"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Review of another member",
+			request:      &library.DeleteReviewRequest{Id: uuid.NewString(), MemberId: uuid.NewString()},
+			codeResponse: codes.NotFound,
+			useCaseErr:   entity.ErrReviewNotFound},
+
+		{name: "Internal error",
+			request:      &library.DeleteReviewRequest{Id: uuid.NewString(), MemberId: uuid.NewString()},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockReviewUseCase, s := InitReviewTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockReviewUseCase.EXPECT().DeleteReview(ctx, req.GetId(), req.GetMemberId()).Return(test.useCaseErr)
+			}
+
+			response, err := s.DeleteReview(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.NotNil(t, response)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDetachEdition(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.DetachEditionRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid detach",
+			request: &library.DetachEditionRequest{
+				WorkId: uuid.NewString(),
+				BookId: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Invalid work id",
+			request: &library.DetachEditionRequest{
+				WorkId: "123",
+				BookId: uuid.NewString()},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid book id",
+			request: &library.DetachEditionRequest{
+				WorkId: uuid.NewString(),
+				BookId: "123"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown work",
+			request: &library.DetachEditionRequest{
+				WorkId: uuid.NewString(),
+				BookId: uuid.NewString()},
+			codeResponse: codes.NotFound,
+			useCaseErr:   entity.ErrWorkNotFound},
+
+		{name: "Unknown book",
+			request: &library.DetachEditionRequest{
+				WorkId: uuid.NewString(),
+				BookId: uuid.NewString()},
+			codeResponse: codes.NotFound,
+			useCaseErr:   entity.ErrBookNotFound},
+
+		{name: "Internal error",
+			request: &library.DetachEditionRequest{
+				WorkId: uuid.NewString(),
+				BookId: uuid.NewString()},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockWorkUseCase, s := InitWorkTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockWorkUseCase.EXPECT().DetachEdition(ctx, req.GetWorkId(), req.GetBookId()).Return(test.useCaseErr)
+			}
+
+			response, err := s.DetachEdition(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.NotNil(t, response)
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package controller
+
+import (
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) DownloadBookCover(req *library.DownloadBookCoverRequest, server library.Library_DownloadBookCoverServer) error {
+	if err := req.ValidateAll(); logger.CheckError(err, i.logger, "Got invalid request", zap.Any("request", req), zap.Error(err)) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	chunks, err := i.coverUseCase.DownloadBookCover(server.Context(), req.GetBookId(), req.GetSize())
+
+	if err != nil {
+		return i.convertErr(err)
+	}
+
+	for chunk := range chunks {
+		err = server.Send(chunk)
+		if logger.CheckError(err, i.logger, "Sending error", zap.Error(err)) {
+			return status.Error(codes.DataLoss, "Sending error")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDownloadBookCover(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.DownloadBookCoverRequest
+		codeResponse codes.Code
+	}{
+		{name: "Valid downloading cover",
+			request: &library.DownloadBookCoverRequest{
+				BookId: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Valid downloading thumbnail",
+			request: &library.DownloadBookCoverRequest{
+				BookId: uuid.NewString(),
+				Size:   library.CoverSize_COVER_SIZE_SMALL},
+			codeResponse: codes.OK},
+
+		{name: "Invalid id",
+			request: &library.DownloadBookCoverRequest{
+				BookId: "123"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid size",
+			request: &library.DownloadBookCoverRequest{
+				BookId: uuid.NewString(),
+				Size:   100},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Book without cover",
+			request: &library.DownloadBookCoverRequest{
+				BookId: uuid.NewString()},
+			codeResponse: codes.NotFound},
+
+		{name: "Internal error",
+			request: &library.DownloadBookCoverRequest{
+				BookId: uuid.NewString()},
+			codeResponse: codes.Internal},
+
+		{name: "Error during sending data",
+			request: &library.DownloadBookCoverRequest{
+				BookId: uuid.NewString()},
+			codeResponse: codes.DataLoss},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl, mockCoverUseCase, s := InitCoverTest(t)
+			mockServer := mocks.NewMockLibrary_DownloadBookCoverServer(ctrl)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			chunk := &library.DownloadBookCoverResponse{ContentType: "image/png", Chunk: []byte("image")}
+
+			if code != codes.InvalidArgument {
+				mockServer.EXPECT().Context().Return(context.Background())
+				mockCoverUseCase.EXPECT().DownloadBookCover(ctx, req.GetBookId(), req.GetSize()).DoAndReturn(func(ctx context.Context, id string, size library.CoverSize) (<-chan *library.DownloadBookCoverResponse, error) {
+					if code == codes.Internal || code == codes.NotFound {
+						return nil, convertCoverCodeToError(code)
+					}
+					chunks := make(chan *library.DownloadBookCoverResponse, 1)
+					chunks <- chunk
+					close(chunks)
+					return chunks, nil
+				})
+				if code == codes.OK || code == codes.DataLoss {
+					mockServer.EXPECT().Send(gomock.Eq(chunk)).DoAndReturn(func(*library.DownloadBookCoverResponse) error {
+						if code != codes.DataLoss {
+							return nil
+						}
+						return errInternal
+					})
+				}
+			}
+
+			err := s.DownloadBookCover(req, mockServer)
+			require.Equal(t, status.Code(err), code)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package controller
+
+import (
+	"time"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) ExportCatalog(req *library.ExportCatalogRequest, server library.Library_ExportCatalogServer) error {
+	if err := req.ValidateAll(); logger.CheckError(err, i.logger, "Got invalid request", zap.Any("request", req), zap.Error(err)) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var updatedFrom, updatedTo time.Time
+	if req.GetUpdatedFrom() != nil {
+		updatedFrom = req.GetUpdatedFrom().AsTime()
+	}
+	if req.GetUpdatedTo() != nil {
+		updatedTo = req.GetUpdatedTo().AsTime()
+	}
+
+	if !updatedFrom.IsZero() && !updatedTo.IsZero() && !updatedFrom.Before(updatedTo) {
+		return status.Error(codes.InvalidArgument, "updated_from must be before updated_to")
+	}
+
+	chunks, streamErr, err := i.booksUseCase.ExportCatalog(server.Context(), req.GetFormat(), updatedFrom, updatedTo)
+
+	if err != nil {
+		return i.convertErr(err)
+	}
+
+	for chunk := range chunks {
+		err = server.Send(chunk)
+		if logger.CheckError(err, i.logger, "Sending error", zap.Error(err)) {
+			return status.Error(codes.DataLoss, "Sending error")
+		}
+	}
+	if err = streamErr(); err != nil {
+		return i.convertErr(err)
+	}
+	return nil
+}
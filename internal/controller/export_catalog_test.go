@@ -0,0 +1,104 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestExportCatalog(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	tests := []struct {
+		name         string
+		request      *library.ExportCatalogRequest
+		codeResponse codes.Code
+		streamErr    error
+	}{
+		{name: "Valid export",
+			request:      &library.ExportCatalogRequest{},
+			codeResponse: codes.OK},
+
+		{name: "Valid incremental export",
+			request: &library.ExportCatalogRequest{
+				Format:      library.ExportFormat_EXPORT_FORMAT_NDJSON,
+				UpdatedFrom: timestamppb.New(now.Add(-24 * time.Hour)),
+				UpdatedTo:   timestamppb.New(now)},
+			codeResponse: codes.OK},
+
+		{name: "Invalid format",
+			request: &library.ExportCatalogRequest{
+				Format: 100},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid range",
+			request: &library.ExportCatalogRequest{
+				UpdatedFrom: timestamppb.New(now),
+				UpdatedTo:   timestamppb.New(now)},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Internal error",
+			request:      &library.ExportCatalogRequest{},
+			codeResponse: codes.Internal},
+
+		{name: "Broken stream",
+			request:      &library.ExportCatalogRequest{},
+			codeResponse: codes.Internal,
+			streamErr:    errInternal},
+
+		{name: "Error during sending data",
+			request:      &library.ExportCatalogRequest{},
+			codeResponse: codes.DataLoss},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl, mockBooksUseCase, s := InitBooksTest(t)
+			mockServer := mocks.NewMockLibrary_ExportCatalogServer(ctrl)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			chunk := &library.ExportCatalogResponse{ContentType: "text/csv; charset=utf-8", Chunk: []byte("id,title\n")}
+
+			var updatedFrom, updatedTo time.Time
+			if req.GetUpdatedFrom() != nil {
+				updatedFrom, updatedTo = req.GetUpdatedFrom().AsTime(), req.GetUpdatedTo().AsTime()
+			}
+
+			if code != codes.InvalidArgument {
+				mockServer.EXPECT().Context().Return(ctx)
+				mockBooksUseCase.EXPECT().ExportCatalog(ctx, req.GetFormat(), updatedFrom, updatedTo).DoAndReturn(func(context.Context, library.ExportFormat, time.Time, time.Time) (<-chan *library.ExportCatalogResponse, func() error, error) {
+					if code == codes.Internal && test.streamErr == nil {
+						return nil, nil, errInternal
+					}
+					chunks := make(chan *library.ExportCatalogResponse, 1)
+					chunks <- chunk
+					close(chunks)
+					return chunks, func() error { return test.streamErr }, nil
+				})
+				if code == codes.OK || code == codes.DataLoss || test.streamErr != nil {
+					mockServer.EXPECT().Send(gomock.Eq(chunk)).DoAndReturn(func(*library.ExportCatalogResponse) error {
+						if code != codes.DataLoss {
+							return nil
+						}
+						return errInternal
+					})
+				}
+			}
+
+			err := s.ExportCatalog(req, mockServer)
+			require.Equal(t, status.Code(err), code)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller/mocks"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestExportMarc(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.ExportMarcRequest
+		codeResponse codes.Code
+	}{
+		{name: "Valid export of book",
+			request: &library.ExportMarcRequest{
+				BookId: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Valid export of catalog",
+			request: &library.ExportMarcRequest{
+				Format: library.MarcFormat_MARC_FORMAT_MARCXML},
+			codeResponse: codes.OK},
+
+		{name: "Invalid id",
+			request: &library.ExportMarcRequest{
+				BookId: "123"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid format",
+			request: &library.ExportMarcRequest{
+				Format: 100},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown book",
+			request: &library.ExportMarcRequest{
+				BookId: uuid.NewString()},
+			codeResponse: codes.NotFound},
+
+		{name: "Internal error",
+			request:      &library.ExportMarcRequest{},
+			codeResponse: codes.Internal},
+
+		{name: "Error during sending data",
+			request:      &library.ExportMarcRequest{},
+			codeResponse: codes.DataLoss},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl, mockBooksUseCase, s := InitBooksTest(t)
+			mockServer := mocks.NewMockLibrary_ExportMarcServer(ctrl)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			chunk := &library.ExportMarcResponse{ContentType: "application/marc", Chunk: []byte("record")}
+
+			if code != codes.InvalidArgument {
+				mockServer.EXPECT().Context().Return(ctx)
+				mockBooksUseCase.EXPECT().ExportMarc(ctx, req.GetFormat(), req.GetBookId()).DoAndReturn(func(context.Context, library.MarcFormat, string) (<-chan *library.ExportMarcResponse, func() error, error) {
+					switch code {
+					case codes.NotFound:
+						return nil, nil, entity.ErrBookNotFound
+					case codes.Internal:
+						return nil, nil, errInternal
+					}
+					chunks := make(chan *library.ExportMarcResponse, 1)
+					chunks <- chunk
+					close(chunks)
+					return chunks, noStreamErr, nil
+				})
+				if code == codes.OK || code == codes.DataLoss {
+					mockServer.EXPECT().Send(gomock.Eq(chunk)).DoAndReturn(func(*library.ExportMarcResponse) error {
+						if code != codes.DataLoss {
+							return nil
+						}
+						return errInternal
+					})
+				}
+			}
+
+			err := s.ExportMarc(req, mockServer)
+			require.Equal(t, status.Code(err), code)
+		})
+	}
+}
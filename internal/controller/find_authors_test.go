@@ -0,0 +1,78 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFindAuthors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.FindAuthorsRequest
+		codeResponse codes.Code
+	}{
+		{
+			name: "Valid finding by alias",
+			request: &library.FindAuthorsRequest{
+				Name: "Leo Tolstoy"},
+			codeResponse: codes.OK},
+
+		{name: "Empty name",
+			request: &library.FindAuthorsRequest{
+				Name: ""},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Too long name",
+			request: &library.FindAuthorsRequest{
+				Name: tooLongName},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Internal error",
+			request: &library.FindAuthorsRequest{
+				Name: "Leo Tolstoy"},
+			codeResponse: codes.Internal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockAuthorUseCase, s := InitAuthorTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockAuthorUseCase.EXPECT().FindAuthors(ctx, req.GetName()).DoAndReturn(func(ctx context.Context, name string) (*library.FindAuthorsResponse, error) {
+					e := convertAuthorCodeToError(code)
+					if code != codes.OK {
+						return nil, e
+					}
+					return &library.FindAuthorsResponse{
+						Authors: []*library.Author{{
+							Id:      uuid.NewString(),
+							Name:    "Lev Tolstoy",
+							Aliases: []string{name},
+						}},
+					}, e
+				})
+			}
+
+			response, err := s.FindAuthors(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Len(t, response.GetAuthors(), 1)
+			require.Contains(t, response.GetAuthors()[0].GetAliases(), req.GetName())
+		})
+	}
+}
@@ -13,7 +13,13 @@ func (i *implementation) GetAuthorBooks(req *library.GetAuthorBooksRequest, serv
 		return status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	books, err := i.booksUseCase.GetAuthorBooks(server.Context(), req.GetAuthorId())
+	ctx := server.Context()
+	languages, err := acceptLanguage(ctx, req.GetAcceptLanguage())
+	if logger.CheckError(err, i.logger, "Got invalid accept language", zap.String("accept language", req.GetAcceptLanguage()), zap.Error(err)) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	books, streamErr, err := i.booksUseCase.GetAuthorBooks(ctx, req.GetAuthorId(), req.GetRole(), languages, req.GetBranchId())
 
 	if err != nil {
 		return i.convertErr(err)
@@ -25,5 +31,8 @@ func (i *implementation) GetAuthorBooks(req *library.GetAuthorBooksRequest, serv
 			return status.Error(codes.DataLoss, "Sending error")
 		}
 	}
+	if err = streamErr(); err != nil {
+		return i.convertErr(err)
+	}
 	return nil
 }
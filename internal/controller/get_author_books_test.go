@@ -26,6 +26,30 @@ func TestGetAuthorBooks(t *testing.T) {
 				AuthorId: uuid.NewString()},
 			codeResponse: codes.OK},
 
+		{name: "Valid getting translated books",
+			request: &library.GetAuthorBooksRequest{
+				AuthorId: uuid.NewString(),
+				Role:     library.ContributorRole_CONTRIBUTOR_ROLE_TRANSLATOR},
+			codeResponse: codes.OK},
+
+		{name: "Valid getting localized books",
+			request: &library.GetAuthorBooksRequest{
+				AuthorId:       uuid.NewString(),
+				AcceptLanguage: "ru-RU, en;q=0.5"},
+			codeResponse: codes.OK},
+
+		{name: "Invalid languages",
+			request: &library.GetAuthorBooksRequest{
+				AuthorId:       uuid.NewString(),
+				AcceptLanguage: "ru;q=high"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid role",
+			request: &library.GetAuthorBooksRequest{
+				AuthorId: uuid.NewString(),
+				Role:     library.ContributorRole(42)},
+			codeResponse: codes.InvalidArgument},
+
 		{name: "Invalid id",
 			request: &library.GetAuthorBooksRequest{
 				AuthorId: "123"},
@@ -52,17 +76,17 @@ func TestGetAuthorBooks(t *testing.T) {
 			code := test.codeResponse
 			req := test.request
 
+			mockServer.EXPECT().Context().Return(ctx).AnyTimes()
 			if code != codes.InvalidArgument {
-				mockServer.EXPECT().Context().Return(context.Background())
-				mockBooksUseCase.EXPECT().GetAuthorBooks(ctx, req.GetAuthorId()).DoAndReturn(func(ctx context.Context, Id string) (<-chan *library.Book, error) {
+				mockBooksUseCase.EXPECT().GetAuthorBooks(ctx, req.GetAuthorId(), req.GetRole(), req.GetAcceptLanguage(), req.GetBranchId()).DoAndReturn(func(ctx context.Context, Id string, role library.ContributorRole, languages, idBranch string) (<-chan *library.Book, func() error, error) {
 					e := convertBookCodeToError(code)
 					if code == codes.Internal {
-						return nil, e
+						return nil, nil, e
 					}
 					books := make(chan *library.Book, 1)
 					books <- &library.Book{}
 					close(books)
-					return books, e
+					return books, noStreamErr, e
 				})
 				if code != codes.Internal {
 					mockServer.EXPECT().Send(gomock.Eq(&library.Book{})).DoAndReturn(func(book *library.Book) error {
@@ -0,0 +1,74 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetAuthorCollaborators(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.GetAuthorCollaboratorsRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid direct collaborators",
+			request:      &library.GetAuthorCollaboratorsRequest{Id: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Valid collaborators with depth",
+			request:      &library.GetAuthorCollaboratorsRequest{Id: uuid.NewString(), MaxDepth: 4},
+			codeResponse: codes.OK},
+
+		{name: "Too big depth",
+			request:      &library.GetAuthorCollaboratorsRequest{Id: uuid.NewString(), MaxDepth: 5},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid id",
+			request:      &library.GetAuthorCollaboratorsRequest{Id: "123"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Internal error",
+			request:      &library.GetAuthorCollaboratorsRequest{Id: uuid.NewString()},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockAuthorUseCase, s := InitAuthorTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockAuthorUseCase.EXPECT().GetAuthorCollaborators(ctx, req.GetId(), req.GetMaxDepth()).DoAndReturn(
+					func(context.Context, string, uint32) (*library.GetAuthorCollaboratorsResponse, error) {
+						if test.useCaseErr != nil {
+							return nil, test.useCaseErr
+						}
+						return &library.GetAuthorCollaboratorsResponse{
+							Collaborators: []*library.Collaborator{{AuthorId: uuid.NewString(), Depth: 1, SharedBooks: 2}},
+						}, nil
+					})
+			}
+
+			response, err := s.GetAuthorCollaborators(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Len(t, response.GetCollaborators(), 1)
+		})
+	}
+}
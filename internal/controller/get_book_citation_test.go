@@ -0,0 +1,81 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetBookCitation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.GetBookCitationRequest
+		codeResponse codes.Code
+	}{
+		{name: "Valid getting citation",
+			request: &library.GetBookCitationRequest{
+				BookId: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Valid getting citation in RIS",
+			request: &library.GetBookCitationRequest{
+				BookId: uuid.NewString(),
+				Format: library.CitationFormat_CITATION_FORMAT_RIS},
+			codeResponse: codes.OK},
+
+		{name: "Invalid id",
+			request: &library.GetBookCitationRequest{
+				BookId: "123"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid format",
+			request: &library.GetBookCitationRequest{
+				BookId: uuid.NewString(),
+				Format: 100},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown book",
+			request: &library.GetBookCitationRequest{
+				BookId: uuid.NewString()},
+			codeResponse: codes.NotFound},
+
+		{name: "Internal error",
+			request: &library.GetBookCitationRequest{
+				BookId: uuid.NewString()},
+			codeResponse: codes.Internal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockBooksUseCase, s := InitBooksTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			response := &library.GetBookCitationResponse{CiteKey: "key", Citation: "@book{key,\n}\n"}
+
+			if code != codes.InvalidArgument {
+				mockBooksUseCase.EXPECT().GetBookCitation(ctx, req.GetBookId(), req.GetFormat()).DoAndReturn(func(context.Context, string, library.CitationFormat) (*library.GetBookCitationResponse, error) {
+					if code != codes.OK {
+						return nil, convertBookCodeToError(code)
+					}
+					return response, nil
+				})
+			}
+
+			citation, err := s.GetBookCitation(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if code == codes.OK {
+				require.Equal(t, response, citation)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetBookCitations(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.NewString()
+	tests := []struct {
+		name         string
+		request      *library.GetBookCitationsRequest
+		codeResponse codes.Code
+	}{
+		{name: "Valid getting citations",
+			request: &library.GetBookCitationsRequest{
+				BookIds: []string{uuid.NewString(), uuid.NewString()},
+				Format:  library.CitationFormat_CITATION_FORMAT_CSL_JSON},
+			codeResponse: codes.OK},
+
+		{name: "No ids",
+			request:      &library.GetBookCitationsRequest{},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid id",
+			request: &library.GetBookCitationsRequest{
+				BookIds: []string{uuid.NewString(), "123"}},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Repeated id",
+			request: &library.GetBookCitationsRequest{
+				BookIds: []string{id, id}},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown book",
+			request: &library.GetBookCitationsRequest{
+				BookIds: []string{uuid.NewString()}},
+			codeResponse: codes.NotFound},
+
+		{name: "Internal error",
+			request: &library.GetBookCitationsRequest{
+				BookIds: []string{uuid.NewString()}},
+			codeResponse: codes.Internal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockBooksUseCase, s := InitBooksTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			response := &library.GetBookCitationsResponse{CiteKeys: []string{"key1", "key2"}, Citation: "[]\n"}
+
+			if code != codes.InvalidArgument {
+				mockBooksUseCase.EXPECT().GetBookCitations(ctx, req.GetBookIds(), req.GetFormat()).DoAndReturn(func(context.Context, []string, library.CitationFormat) (*library.GetBookCitationsResponse, error) {
+					if code != codes.OK {
+						return nil, convertBookCodeToError(code)
+					}
+					return response, nil
+				})
+			}
+
+			citations, err := s.GetBookCitations(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if code == codes.OK {
+				require.Equal(t, response, citations)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetBookCopies(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.GetBookCopiesRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid get book copies",
+			request:      &library.GetBookCopiesRequest{BookId: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Valid get book copies in branch",
+			request:      &library.GetBookCopiesRequest{BookId: uuid.NewString(), BranchId: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Invalid book id",
+			request:      &library.GetBookCopiesRequest{BookId: "123"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid branch id",
+			request:      &library.GetBookCopiesRequest{BookId: uuid.NewString(), BranchId: "123"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Internal error",
+			request:      &library.GetBookCopiesRequest{BookId: uuid.NewString()},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockCopyUseCase, s := InitCopyTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockCopyUseCase.EXPECT().GetBookCopies(ctx, req.GetBookId(), req.GetBranchId()).
+					DoAndReturn(func(_ context.Context, idBook, idBranch string) (*library.GetBookCopiesResponse, error) {
+						if test.useCaseErr != nil {
+							return nil, test.useCaseErr
+						}
+						return &library.GetBookCopiesResponse{
+							Copies: []*library.Copy{
+								{Id: uuid.NewString(), BookId: idBook, Barcode: "1", CurrentBranchId: idBranch},
+								{Id: uuid.NewString(), BookId: idBook, Barcode: "2", CurrentBranchId: idBranch},
+							},
+						}, nil
+					})
+			}
+
+			response, err := s.GetBookCopies(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Len(t, response.GetCopies(), 2)
+		})
+	}
+}
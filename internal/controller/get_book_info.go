@@ -17,7 +17,12 @@ func (i *implementation) GetBookInfo(ctx context.Context, req *library.GetBookIn
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	book, err := i.booksUseCase.GetBookInfo(ctx, req.GetId())
+	languages, err := acceptLanguage(ctx, req.GetAcceptLanguage())
+	if logger.CheckError(err, i.logger, "Got invalid accept language", zap.String("accept language", req.GetAcceptLanguage()), zap.Error(err)) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	book, err := i.booksUseCase.GetBookInfo(ctx, req.GetId(), languages, req.GetBranchId())
 
 	if err != nil {
 		return nil, i.convertErr(err)
@@ -8,6 +8,7 @@ import (
 	"github.com/project/library/generated/api/library"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -17,6 +18,8 @@ func TestGetBookInfo(t *testing.T) {
 	tests := []struct {
 		name         string
 		request      *library.GetBookInfoRequest
+		header       string
+		languages    string
 		codeResponse codes.Code
 	}{
 		{name: "Valid getting info",
@@ -24,6 +27,27 @@ func TestGetBookInfo(t *testing.T) {
 				Id: uuid.NewString()},
 			codeResponse: codes.OK},
 
+		{name: "Valid getting info with languages in request",
+			request: &library.GetBookInfoRequest{
+				Id:             uuid.NewString(),
+				AcceptLanguage: "fr-CH, fr;q=0.9"},
+			header:       "de",
+			languages:    "fr-CH, fr;q=0.9",
+			codeResponse: codes.OK},
+
+		{name: "Valid getting info with languages in header",
+			request: &library.GetBookInfoRequest{
+				Id: uuid.NewString()},
+			header:       "de",
+			languages:    "de",
+			codeResponse: codes.OK},
+
+		{name: "Invalid languages",
+			request: &library.GetBookInfoRequest{
+				Id:             uuid.NewString(),
+				AcceptLanguage: "fr;q=high"},
+			codeResponse: codes.InvalidArgument},
+
 		{name: "Invalid id",
 			request: &library.GetBookInfoRequest{
 				Id: "123"},
@@ -46,11 +70,14 @@ func TestGetBookInfo(t *testing.T) {
 
 			_, mockBooksUseCase, s := InitBooksTest(t)
 			ctx := context.Background()
+			if test.header != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("grpcgateway-accept-language", test.header))
+			}
 			code := test.codeResponse
 			req := test.request
 
 			if code != codes.InvalidArgument {
-				mockBooksUseCase.EXPECT().GetBookInfo(ctx, req.GetId()).DoAndReturn(func(ctx context.Context, Id string) (*library.GetBookInfoResponse, error) {
+				mockBooksUseCase.EXPECT().GetBookInfo(ctx, req.GetId(), test.languages, req.GetBranchId()).DoAndReturn(func(ctx context.Context, Id, languages, idBranch string) (*library.GetBookInfoResponse, error) {
 					e := convertBookCodeToError(code)
 					if code != codes.OK {
 						return nil, e
@@ -0,0 +1,71 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetBranch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.GetBranchRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid get branch",
+			request:      &library.GetBranchRequest{Id: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Invalid id",
+			request:      &library.GetBranchRequest{Id: "123"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown branch",
+			request:      &library.GetBranchRequest{Id: uuid.NewString()},
+			codeResponse: codes.NotFound,
+			useCaseErr:   entity.ErrBranchNotFound},
+
+		{name: "Internal error",
+			request:      &library.GetBranchRequest{Id: uuid.NewString()},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockBranchUseCase, s := InitBranchTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockBranchUseCase.EXPECT().GetBranch(ctx, req.GetId()).DoAndReturn(func(_ context.Context, id string) (*library.GetBranchResponse, error) {
+					if test.useCaseErr != nil {
+						return nil, test.useCaseErr
+					}
+					return &library.GetBranchResponse{
+						Branch: &library.Branch{Id: id, Name: "Central"},
+					}, nil
+				})
+			}
+
+			response, err := s.GetBranch(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, req.GetId(), response.GetBranch().GetId())
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/pkg/logger"
+
+	"go.uber.org/zap"
+
+	"github.com/project/library/generated/api/library"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) GetCollaborationPath(
+	ctx context.Context,
+	req *library.GetCollaborationPathRequest,
+) (*library.GetCollaborationPathResponse, error) {
+	if err := req.ValidateAll(); logger.CheckError(err, i.logger, "Got invalid request", zap.Any("request", req), zap.Error(err)) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	response, err := i.authorUseCase.GetCollaborationPath(ctx, req.GetFromAuthorId(), req.GetToAuthorId(), req.GetMaxDepth())
+
+	if err != nil {
+		return nil, i.convertErr(err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,84 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetCollaborationPath(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.GetCollaborationPathRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid path",
+			request:      &library.GetCollaborationPathRequest{FromAuthorId: uuid.NewString(), ToAuthorId: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Valid path with depth",
+			request:      &library.GetCollaborationPathRequest{FromAuthorId: uuid.NewString(), ToAuthorId: uuid.NewString(), MaxDepth: 6},
+			codeResponse: codes.OK},
+
+		{name: "Too big depth",
+			request:      &library.GetCollaborationPathRequest{FromAuthorId: uuid.NewString(), ToAuthorId: uuid.NewString(), MaxDepth: 7},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid author id",
+			request:      &library.GetCollaborationPathRequest{FromAuthorId: uuid.NewString(), ToAuthorId: "123"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Authors are not connected",
+			request:      &library.GetCollaborationPathRequest{FromAuthorId: uuid.NewString(), ToAuthorId: uuid.NewString()},
+			codeResponse: codes.NotFound,
+			useCaseErr:   entity.ErrCollaborationPathNotFound},
+
+		{name: "Internal error",
+			request:      &library.GetCollaborationPathRequest{FromAuthorId: uuid.NewString(), ToAuthorId: uuid.NewString()},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockAuthorUseCase, s := InitAuthorTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockAuthorUseCase.EXPECT().GetCollaborationPath(ctx, req.GetFromAuthorId(), req.GetToAuthorId(), req.GetMaxDepth()).DoAndReturn(
+					func(_ context.Context, idFrom, idTo string, _ uint32) (*library.GetCollaborationPathResponse, error) {
+						if test.useCaseErr != nil {
+							return nil, test.useCaseErr
+						}
+						return &library.GetCollaborationPathResponse{
+							Distance: 1,
+							Steps: []*library.CollaborationStep{
+								{AuthorId: idFrom},
+								{AuthorId: idTo, BookId: uuid.NewString()},
+							},
+						}, nil
+					})
+			}
+
+			response, err := s.GetCollaborationPath(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Len(t, response.GetSteps(), 2)
+		})
+	}
+}
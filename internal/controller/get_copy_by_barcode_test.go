@@ -0,0 +1,74 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetCopyByBarcode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.GetCopyByBarcodeRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid get copy by barcode",
+			request:      &library.GetCopyByBarcodeRequest{Barcode: "LIB-0001"},
+			codeResponse: codes.OK},
+
+		{name: "Empty barcode",
+			request:      &library.GetCopyByBarcodeRequest{},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Too long barcode",
+			request:      &library.GetCopyByBarcodeRequest{Barcode: strings.Repeat("1", 65)},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown barcode",
+			request:      &library.GetCopyByBarcodeRequest{Barcode: "LIB-0002"},
+			codeResponse: codes.NotFound,
+			useCaseErr:   entity.ErrCopyNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockCopyUseCase, s := InitCopyTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			id := uuid.NewString()
+			if code != codes.InvalidArgument {
+				mockCopyUseCase.EXPECT().GetCopyByBarcode(ctx, req.GetBarcode()).
+					DoAndReturn(func(_ context.Context, barcode string) (*library.GetCopyByBarcodeResponse, error) {
+						if test.useCaseErr != nil {
+							return nil, test.useCaseErr
+						}
+						return &library.GetCopyByBarcodeResponse{
+							Copy: &library.Copy{Id: id, Barcode: barcode},
+						}, nil
+					})
+			}
+
+			response, err := s.GetCopyByBarcode(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, id, response.GetCopy().GetId())
+			require.Equal(t, req.GetBarcode(), response.GetCopy().GetBarcode())
+		})
+	}
+}
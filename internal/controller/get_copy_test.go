@@ -0,0 +1,71 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetCopy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.GetCopyRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid get copy",
+			request:      &library.GetCopyRequest{Id: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Invalid id",
+			request:      &library.GetCopyRequest{Id: "123"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown copy",
+			request:      &library.GetCopyRequest{Id: uuid.NewString()},
+			codeResponse: codes.NotFound,
+			useCaseErr:   entity.ErrCopyNotFound},
+
+		{name: "Internal error",
+			request:      &library.GetCopyRequest{Id: uuid.NewString()},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockCopyUseCase, s := InitCopyTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockCopyUseCase.EXPECT().GetCopy(ctx, req.GetId()).DoAndReturn(func(_ context.Context, id string) (*library.GetCopyResponse, error) {
+					if test.useCaseErr != nil {
+						return nil, test.useCaseErr
+					}
+					return &library.GetCopyResponse{
+						Copy: &library.Copy{Id: id, Barcode: "LIB-0001"},
+					}, nil
+				})
+			}
+
+			response, err := s.GetCopy(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, req.GetId(), response.GetCopy().GetId())
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/pkg/logger"
+
+	"go.uber.org/zap"
+
+	"github.com/project/library/generated/api/library"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxStatsBuckets bounds the number of buckets of books added, so a long range of days can not be requested.
+const maxStatsBuckets = 1000
+
+// countStatsBuckets estimates from above the number of buckets of the interval over [from; to).
+func countStatsBuckets(from, to time.Time, interval library.StatsInterval) int64 {
+	switch interval {
+	case library.StatsInterval_STATS_INTERVAL_MONTH:
+		return int64(to.Year()-from.Year())*12 + int64(to.Month()-from.Month()) + 1
+	case library.StatsInterval_STATS_INTERVAL_WEEK:
+		return int64(to.Sub(from)/(7*24*time.Hour)) + 2
+	default:
+		return int64(to.Sub(from)/(24*time.Hour)) + 2
+	}
+}
+
+func (i *implementation) GetLibraryStats(ctx context.Context, req *library.GetLibraryStatsRequest) (*library.GetLibraryStatsResponse, error) {
+	if err := req.ValidateAll(); logger.CheckError(err, i.logger, "Got invalid request", zap.Any("request", req), zap.Error(err)) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var from, to time.Time
+	if req.GetFrom() != nil {
+		from = req.GetFrom().AsTime()
+	}
+	if req.GetTo() != nil {
+		to = req.GetTo().AsTime()
+	}
+
+	if !from.IsZero() && !to.IsZero() && !from.Before(to) {
+		return nil, status.Error(codes.InvalidArgument, "from must be before to")
+	}
+
+	if !from.IsZero() {
+		end := to
+		if end.IsZero() {
+			end = time.Now()
+		}
+		if countStatsBuckets(from, end, req.GetInterval()) > maxStatsBuckets {
+			return nil, status.Errorf(codes.InvalidArgument, "range must contain at most %d buckets", maxStatsBuckets)
+		}
+	}
+
+	response, err := i.statsUseCase.GetLibraryStats(ctx, from, to, req.GetInterval(), req.GetTopAuthors())
+
+	if err != nil {
+		return nil, i.convertErr(err)
+	}
+
+	return response, nil
+}
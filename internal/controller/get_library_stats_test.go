@@ -0,0 +1,98 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGetLibraryStats(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		request      *library.GetLibraryStatsRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid stats with defaults",
+			request:      &library.GetLibraryStatsRequest{},
+			codeResponse: codes.OK},
+
+		{name: "Valid stats over range",
+			request: &library.GetLibraryStatsRequest{From: timestamppb.New(from), To: timestamppb.New(to),
+				Interval: library.StatsInterval_STATS_INTERVAL_WEEK, TopAuthors: 100},
+			codeResponse: codes.OK},
+
+		{name: "From after to",
+			request:      &library.GetLibraryStatsRequest{From: timestamppb.New(to), To: timestamppb.New(from)},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Too many days",
+			request:      &library.GetLibraryStatsRequest{From: timestamppb.New(from.AddDate(-3, 0, 0)), To: timestamppb.New(to)},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Many years by months",
+			request: &library.GetLibraryStatsRequest{From: timestamppb.New(from.AddDate(-50, 0, 0)), To: timestamppb.New(to),
+				Interval: library.StatsInterval_STATS_INTERVAL_MONTH},
+			codeResponse: codes.OK},
+
+		{name: "Too many days till now",
+			request:      &library.GetLibraryStatsRequest{From: timestamppb.New(from.AddDate(-3, 0, 0))},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Too many top authors",
+			request:      &library.GetLibraryStatsRequest{TopAuthors: 101},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown interval",
+			request:      &library.GetLibraryStatsRequest{Interval: 10},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Internal error",
+			request:      &library.GetLibraryStatsRequest{},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockStatsUseCase, s := InitStatsTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				var reqFrom, reqTo time.Time
+				if req.GetFrom() != nil {
+					reqFrom, reqTo = req.GetFrom().AsTime(), req.GetTo().AsTime()
+				}
+				mockStatsUseCase.EXPECT().GetLibraryStats(ctx, reqFrom, reqTo, req.GetInterval(), req.GetTopAuthors()).
+					DoAndReturn(func(context.Context, time.Time, time.Time, library.StatsInterval, uint32) (*library.GetLibraryStatsResponse, error) {
+						if test.useCaseErr != nil {
+							return nil, test.useCaseErr
+						}
+						return &library.GetLibraryStatsResponse{BookCount: 3, AuthorCount: 2}, nil
+					})
+			}
+
+			response, err := s.GetLibraryStats(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, uint64(3), response.GetBookCount())
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetMemberBalance(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.GetMemberBalanceRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid get balance",
+			request:      &library.GetMemberBalanceRequest{MemberId: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Invalid member id",
+			request:      &library.GetMemberBalanceRequest{MemberId: "123"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown member",
+			request:      &library.GetMemberBalanceRequest{MemberId: uuid.NewString()},
+			codeResponse: codes.NotFound,
+			useCaseErr:   entity.ErrMemberNotFound},
+
+		{name: "Internal error",
+			request:      &library.GetMemberBalanceRequest{MemberId: uuid.NewString()},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockLoanUseCase, s := InitLoanTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockLoanUseCase.EXPECT().GetMemberBalance(ctx, req.GetMemberId()).DoAndReturn(
+					func(_ context.Context, idMember string) (*library.GetMemberBalanceResponse, error) {
+						if test.useCaseErr != nil {
+							return nil, test.useCaseErr
+						}
+						return &library.GetMemberBalanceResponse{
+							OutstandingFines: 120,
+							OverdueLoans:     []*library.Loan{{Id: uuid.NewString(), MemberId: idMember, Fine: 40}},
+						}, nil
+					})
+			}
+
+			response, err := s.GetMemberBalance(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, int64(120), response.GetOutstandingFines())
+			require.Equal(t, req.GetMemberId(), response.GetOverdueLoans()[0].GetMemberId())
+		})
+	}
+}
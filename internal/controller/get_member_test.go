@@ -0,0 +1,71 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetMember(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.GetMemberRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid get member",
+			request:      &library.GetMemberRequest{Id: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Invalid id",
+			request:      &library.GetMemberRequest{Id: "123"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown member",
+			request:      &library.GetMemberRequest{Id: uuid.NewString()},
+			codeResponse: codes.NotFound,
+			useCaseErr:   entity.ErrMemberNotFound},
+
+		{name: "Internal error",
+			request:      &library.GetMemberRequest{Id: uuid.NewString()},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockMemberUseCase, s := InitMemberTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockMemberUseCase.EXPECT().GetMember(ctx, req.GetId()).DoAndReturn(func(_ context.Context, id string) (*library.GetMemberResponse, error) {
+					if test.useCaseErr != nil {
+						return nil, test.useCaseErr
+					}
+					return &library.GetMemberResponse{
+						Member: &library.Member{Id: id, Name: "Ivan Petrov"},
+					}, nil
+				})
+			}
+
+			response, err := s.GetMember(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, req.GetId(), response.GetMember().GetId())
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package controller
+
+import (
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) GetPublisherBooks(req *library.GetPublisherBooksRequest, server library.Library_GetPublisherBooksServer) error {
+	if err := req.ValidateAll(); logger.CheckError(err, i.logger, "Got invalid request", zap.Any("request", req), zap.Error(err)) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	books, streamErr, err := i.booksUseCase.GetPublisherBooks(server.Context(), req.GetPublisherId(), req.GetBranchId())
+
+	if err != nil {
+		return i.convertErr(err)
+	}
+
+	for bk := range books {
+		err = server.Send(bk)
+		if logger.CheckError(err, i.logger, "Sending error", zap.Error(err)) {
+			return status.Error(codes.DataLoss, "Sending error")
+		}
+	}
+	if err = streamErr(); err != nil {
+		return i.convertErr(err)
+	}
+	return nil
+}
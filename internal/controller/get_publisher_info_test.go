@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetPublisherInfo(t *testing.T) {
+	t.Parallel()
+
+	const name = "Vintage Books"
+	tests := []struct {
+		name         string
+		request      *library.GetPublisherInfoRequest
+		codeResponse codes.Code
+	}{
+		{
+			name: "Valid getting info",
+			request: &library.GetPublisherInfoRequest{
+				Id: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Invalid id",
+			request: &library.GetPublisherInfoRequest{
+				Id: "123"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown publisher",
+			request: &library.GetPublisherInfoRequest{
+				Id: uuid.NewString()},
+			codeResponse: codes.NotFound},
+
+		{name: "Internal error",
+			request: &library.GetPublisherInfoRequest{
+				Id: uuid.NewString()},
+			codeResponse: codes.Internal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockPublisherUseCase, s := InitPublisherTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockPublisherUseCase.EXPECT().GetPublisherInfo(ctx, req.GetId()).DoAndReturn(func(ctx context.Context, id string) (*library.GetPublisherInfoResponse, error) {
+					e := convertPublisherCodeToError(code)
+					if code != codes.OK {
+						return nil, e
+					}
+					return &library.GetPublisherInfoResponse{
+						Id:   id,
+						Name: name,
+					}, e
+				})
+			}
+
+			response, err := s.GetPublisherInfo(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, response.GetId(), req.GetId())
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller/mocks"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetReadingListBooks(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.GetReadingListBooksRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid getting books",
+			request:      &library.GetReadingListBooksRequest{Id: uuid.NewString(), ViewerId: "reader"},
+			codeResponse: codes.OK},
+
+		{name: "Invalid id",
+			request:      &library.GetReadingListBooksRequest{Id: "123"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Private list of other user",
+			request:      &library.GetReadingListBooksRequest{Id: uuid.NewString(), ViewerId: "other"},
+			codeResponse: codes.NotFound,
+			useCaseErr:   entity.ErrReadingListNotFound},
+
+		{name: "Internal error",
+			request:      &library.GetReadingListBooksRequest{Id: uuid.NewString()},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+
+		{name: "Error during sending data",
+			request:      &library.GetReadingListBooksRequest{Id: uuid.NewString()},
+			codeResponse: codes.DataLoss},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl, mockReadingListUseCase, s := InitReadingListTest(t)
+			mockServer := mocks.NewMockLibrary_GetReadingListBooksServer(ctrl)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+
+			if code != codes.InvalidArgument {
+				mockServer.EXPECT().Context().Return(context.Background())
+				mockReadingListUseCase.EXPECT().GetReadingListBooks(ctx, req.GetId(), req.GetViewerId()).
+					DoAndReturn(func(context.Context, string, string) (<-chan *library.Book, func() error, error) {
+						if test.useCaseErr != nil {
+							return nil, nil, test.useCaseErr
+						}
+						books := make(chan *library.Book, 1)
+						books <- &library.Book{}
+						close(books)
+						return books, noStreamErr, nil
+					})
+				if test.useCaseErr == nil {
+					mockServer.EXPECT().Send(gomock.Eq(&library.Book{})).DoAndReturn(func(book *library.Book) error {
+						if code != codes.DataLoss {
+							return nil
+						}
+						return errInternal
+					})
+				}
+			}
+
+			err := s.GetReadingListBooks(req, mockServer)
+			require.Equal(t, status.Code(err), code)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetRelatedBooks(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.GetRelatedBooksRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid getting related books",
+			request: &library.GetRelatedBooksRequest{
+				BookId: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Valid getting related books with depth",
+			request: &library.GetRelatedBooksRequest{
+				BookId: uuid.NewString(),
+				Depth:  10},
+			codeResponse: codes.OK},
+
+		{name: "Invalid id",
+			request: &library.GetRelatedBooksRequest{
+				BookId: "123"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Too deep",
+			request: &library.GetRelatedBooksRequest{
+				BookId: uuid.NewString(),
+				Depth:  11},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown book",
+			request: &library.GetRelatedBooksRequest{
+				BookId: uuid.NewString()},
+			codeResponse: codes.NotFound,
+			useCaseErr:   entity.ErrBookNotFound},
+
+		{name: "Internal error",
+			request: &library.GetRelatedBooksRequest{
+				BookId: uuid.NewString()},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockRelationUseCase, s := InitRelationTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			relatedID := uuid.NewString()
+			if code != codes.InvalidArgument {
+				mockRelationUseCase.EXPECT().GetRelatedBooks(ctx, req.GetBookId(), int(req.GetDepth())).
+					DoAndReturn(func(ctx context.Context, id string, depth int) (*library.GetRelatedBooksResponse, error) {
+						if test.useCaseErr != nil {
+							return nil, test.useCaseErr
+						}
+						return &library.GetRelatedBooksResponse{
+							Books: []*library.RelatedBook{{
+								Book:      &library.Book{Id: relatedID},
+								Type:      library.BookRelationType_BOOK_RELATION_TYPE_TRANSLATED_AS,
+								ViaBookId: id,
+								Depth:     1,
+							}},
+						}, nil
+					})
+			}
+
+			response, err := s.GetRelatedBooks(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Len(t, response.GetBooks(), 1)
+			require.Equal(t, relatedID, response.GetBooks()[0].GetBook().GetId())
+			require.Equal(t, req.GetBookId(), response.GetBooks()[0].GetViaBookId())
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetWorkEditions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.GetWorkEditionsRequest
+		codeResponse codes.Code
+	}{
+		{name: "Valid getting book",
+			request: &library.GetWorkEditionsRequest{
+				WorkId: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Invalid id",
+			request: &library.GetWorkEditionsRequest{
+				WorkId: "123"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Internal error",
+			request: &library.GetWorkEditionsRequest{
+				WorkId: uuid.NewString()},
+			codeResponse: codes.Internal},
+
+		{name: "Error during sending data",
+			request: &library.GetWorkEditionsRequest{
+				WorkId: uuid.NewString()},
+			codeResponse: codes.DataLoss},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl, mockBooksUseCase, s := InitBooksTest(t)
+			mockServer := mocks.NewMockLibrary_GetWorkEditionsServer(ctrl)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+
+			if code != codes.InvalidArgument {
+				mockServer.EXPECT().Context().Return(context.Background())
+				mockBooksUseCase.EXPECT().GetWorkEditions(ctx, req.GetWorkId(), req.GetBranchId()).DoAndReturn(func(ctx context.Context, Id, idBranch string) (<-chan *library.Book, func() error, error) {
+					e := convertBookCodeToError(code)
+					if code == codes.Internal {
+						return nil, nil, e
+					}
+					books := make(chan *library.Book, 1)
+					books <- &library.Book{}
+					close(books)
+					return books, noStreamErr, e
+				})
+				if code != codes.Internal {
+					mockServer.EXPECT().Send(gomock.Eq(&library.Book{})).DoAndReturn(func(book *library.Book) error {
+						if code != codes.DataLoss {
+							return nil
+						}
+						return errInternal
+					})
+				}
+			}
+
+			err := s.GetWorkEditions(req, mockServer)
+			require.Equal(t, status.Code(err), code)
+		})
+	}
+}
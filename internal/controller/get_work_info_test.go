@@ -0,0 +1,76 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetWorkInfo(t *testing.T) {
+	t.Parallel()
+
+	const name = "War and Peace"
+	tests := []struct {
+		name         string
+		request      *library.GetWorkInfoRequest
+		codeResponse codes.Code
+	}{
+		{name: "Valid getting info",
+			request: &library.GetWorkInfoRequest{
+				Id: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Invalid id",
+			request: &library.GetWorkInfoRequest{
+				Id: "123"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown work",
+			request: &library.GetWorkInfoRequest{
+				Id: uuid.NewString()},
+			codeResponse: codes.NotFound},
+
+		{name: "Internal error",
+			request: &library.GetWorkInfoRequest{
+				Id: uuid.NewString()},
+			codeResponse: codes.Internal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockWorkUseCase, s := InitWorkTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockWorkUseCase.EXPECT().GetWorkInfo(ctx, req.GetId()).DoAndReturn(func(ctx context.Context, id string) (*library.GetWorkInfoResponse, error) {
+					e := convertWorkCodeToError(code)
+					if code != codes.OK {
+						return nil, e
+					}
+					return &library.GetWorkInfoResponse{
+						Work: &library.Work{
+							Id:   id,
+							Name: name,
+						},
+					}, e
+				})
+			}
+
+			response, err := s.GetWorkInfo(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, req.GetId(), response.GetWork().GetId())
+		})
+	}
+}
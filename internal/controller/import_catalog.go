@@ -0,0 +1,41 @@
+package controller
+
+import (
+	"fmt"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+)
+
+// importReader reads the CSV catalog from chunks of import stream.
+type importReader struct {
+	stream library.Library_ImportCatalogServer
+	chunk  []byte
+}
+
+func (r *importReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+
+		if err = req.ValidateAll(); err != nil {
+			return 0, fmt.Errorf("%s: %w", err.Error(), entity.ErrInvalidImport)
+		}
+		r.chunk = req.GetChunk()
+	}
+
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	return n, nil
+}
+
+func (i *implementation) ImportCatalog(stream library.Library_ImportCatalogServer) error {
+	response, err := i.booksUseCase.ImportCatalog(stream.Context(), &importReader{stream: stream})
+	if err != nil {
+		return i.convertErr(err)
+	}
+
+	return stream.SendAndClose(response)
+}
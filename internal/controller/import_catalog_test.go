@@ -0,0 +1,91 @@
+package controller
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestImportCatalog(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		requests     []*library.ImportCatalogRequest
+		codeResponse codes.Code
+	}{
+		{name: "Valid import",
+			requests: []*library.ImportCatalogRequest{
+				{Chunk: []byte("title,author")},
+				{Chunk: []byte("s\nBook,Author\n")}},
+			codeResponse: codes.OK},
+
+		{name: "Empty import",
+			requests:     []*library.ImportCatalogRequest{},
+			codeResponse: codes.OK},
+
+		{name: "Too large chunk",
+			requests: []*library.ImportCatalogRequest{
+				{Chunk: make([]byte, 1<<20+1)}},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Internal error",
+			requests: []*library.ImportCatalogRequest{
+				{Chunk: []byte("Book,Author\n")}},
+			codeResponse: codes.Internal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl, mockBooksUseCase, s := InitBooksTest(t)
+			mockServer := mocks.NewMockLibrary_ImportCatalogServer(ctrl)
+			ctx := context.Background()
+			code := test.codeResponse
+			requests := test.requests
+			response := &library.ImportCatalogResponse{Imported: 1}
+
+			expected := []byte{}
+			for _, req := range requests {
+				expected = append(expected, req.GetChunk()...)
+			}
+
+			mockServer.EXPECT().Recv().DoAndReturn(func() (*library.ImportCatalogRequest, error) {
+				if len(requests) == 0 {
+					return nil, io.EOF
+				}
+				req := requests[0]
+				requests = requests[1:]
+				return req, nil
+			}).AnyTimes()
+
+			mockServer.EXPECT().Context().Return(ctx)
+			mockBooksUseCase.EXPECT().ImportCatalog(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, catalog io.Reader) (*library.ImportCatalogResponse, error) {
+				data, err := io.ReadAll(catalog)
+				if err != nil {
+					return nil, err
+				}
+				require.Equal(t, expected, data)
+
+				if code != codes.OK {
+					return nil, errInternal
+				}
+				return response, nil
+			})
+			if code == codes.OK {
+				mockServer.EXPECT().SendAndClose(response).Return(nil)
+			}
+
+			err := s.ImportCatalog(mockServer)
+			require.Equal(t, status.Code(err), code)
+		})
+	}
+}
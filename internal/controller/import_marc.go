@@ -0,0 +1,63 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// marcRecordsReader reads MARC records from chunks of import stream.
+type marcRecordsReader struct {
+	stream library.Library_ImportMarcServer
+	chunk  []byte
+}
+
+func (r *marcRecordsReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+
+		if err = req.ValidateAll(); err != nil {
+			return 0, fmt.Errorf("%s: %w", err.Error(), entity.ErrInvalidImport)
+		}
+		r.chunk = req.GetChunk()
+	}
+
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	return n, nil
+}
+
+func (i *implementation) ImportMarc(stream library.Library_ImportMarcServer) error {
+	req, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return status.Error(codes.InvalidArgument, "empty import")
+	}
+	if logger.CheckError(err, i.logger, "Receiving error", zap.Error(err)) {
+		return err
+	}
+
+	if err = req.ValidateAll(); logger.CheckError(err, i.logger, "Got invalid request", zap.String("format", req.GetFormat().String()), zap.Error(err)) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	response, err := i.booksUseCase.ImportMarc(stream.Context(), req.GetFormat(), &marcRecordsReader{
+		stream: stream,
+		chunk:  req.GetChunk(),
+	})
+
+	if err != nil {
+		return i.convertErr(err)
+	}
+
+	return stream.SendAndClose(response)
+}
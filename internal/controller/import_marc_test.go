@@ -0,0 +1,94 @@
+package controller
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestImportMarc(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		requests     []*library.ImportMarcRequest
+		codeResponse codes.Code
+	}{
+		{name: "Valid import",
+			requests: []*library.ImportMarcRequest{
+				{Format: library.MarcFormat_MARC_FORMAT_MARCXML, Chunk: []byte("<collec")},
+				{Chunk: []byte("tion/>")}},
+			codeResponse: codes.OK},
+
+		{name: "Empty import",
+			requests:     []*library.ImportMarcRequest{},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid format",
+			requests: []*library.ImportMarcRequest{
+				{Format: 100, Chunk: []byte("<collection/>")}},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Too large chunk",
+			requests: []*library.ImportMarcRequest{
+				{Format: library.MarcFormat_MARC_FORMAT_MARCXML},
+				{Chunk: make([]byte, 1<<20+1)}},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Internal error",
+			requests: []*library.ImportMarcRequest{
+				{Format: library.MarcFormat_MARC_FORMAT_MARCXML, Chunk: []byte("<collection/>")}},
+			codeResponse: codes.Internal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl, mockBooksUseCase, s := InitBooksTest(t)
+			mockServer := mocks.NewMockLibrary_ImportMarcServer(ctrl)
+			ctx := context.Background()
+			code := test.codeResponse
+			requests := test.requests
+			response := &library.ImportMarcResponse{}
+
+			mockServer.EXPECT().Recv().DoAndReturn(func() (*library.ImportMarcRequest, error) {
+				if len(requests) == 0 {
+					return nil, io.EOF
+				}
+				req := requests[0]
+				requests = requests[1:]
+				return req, nil
+			}).AnyTimes()
+
+			if code != codes.InvalidArgument || test.name == "Too large chunk" {
+				mockServer.EXPECT().Context().Return(ctx)
+				mockBooksUseCase.EXPECT().ImportMarc(ctx, library.MarcFormat_MARC_FORMAT_MARCXML, gomock.Any()).DoAndReturn(func(ctx context.Context, format library.MarcFormat, records io.Reader) (*library.ImportMarcResponse, error) {
+					data, err := io.ReadAll(records)
+					if err != nil {
+						return nil, err
+					}
+					require.Equal(t, []byte("<collection/>"), data)
+
+					if code != codes.OK {
+						return nil, errInternal
+					}
+					return response, nil
+				})
+			}
+			if code == codes.OK {
+				mockServer.EXPECT().SendAndClose(response).Return(nil)
+			}
+
+			err := s.ImportMarc(mockServer)
+			require.Equal(t, status.Code(err), code)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/pkg/logger"
+
+	"go.uber.org/zap"
+
+	"github.com/project/library/generated/api/library"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) LinkBooks(ctx context.Context, req *library.LinkBooksRequest) (*library.LinkBooksResponse, error) {
+	if err := req.ValidateAll(); logger.CheckError(err, i.logger, "Got invalid request", zap.Any("request", req), zap.Error(err)) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.GetBookId() == req.GetRelatedBookId() {
+		return nil, status.Error(codes.InvalidArgument, "book can not be related to itself")
+	}
+
+	err := i.relationUseCase.LinkBooks(ctx, req.GetBookId(), req.GetRelatedBookId(), req.GetType())
+
+	if err != nil {
+		return nil, i.convertErr(err)
+	}
+
+	return &library.LinkBooksResponse{}, nil
+}
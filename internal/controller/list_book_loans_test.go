@@ -0,0 +1,67 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListBookLoans(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.ListBookLoansRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid list book loans",
+			request:      &library.ListBookLoansRequest{BookId: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Invalid book id",
+			request:      &library.ListBookLoansRequest{BookId: "123"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Internal error",
+			request:      &library.ListBookLoansRequest{BookId: uuid.NewString()},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockLoanUseCase, s := InitLoanTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockLoanUseCase.EXPECT().ListBookLoans(ctx, req.GetBookId()).DoAndReturn(
+					func(_ context.Context, id string) (*library.ListBookLoansResponse, error) {
+						if test.useCaseErr != nil {
+							return nil, test.useCaseErr
+						}
+						return &library.ListBookLoansResponse{
+							Loans: []*library.Loan{{Id: uuid.NewString(), BookId: id}},
+						}, nil
+					})
+			}
+
+			response, err := s.ListBookLoans(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Len(t, response.GetLoans(), 1)
+			require.Equal(t, req.GetBookId(), response.GetLoans()[0].GetBookId())
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListBookReviews(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.ListBookReviewsRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid list reviews",
+			request:      &library.ListBookReviewsRequest{BookId: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Invalid book id",
+			request:      &library.ListBookReviewsRequest{BookId: "123"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Internal error",
+			request:      &library.ListBookReviewsRequest{BookId: uuid.NewString()},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockReviewUseCase, s := InitReviewTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockReviewUseCase.EXPECT().ListBookReviews(ctx, req.GetBookId()).DoAndReturn(
+					func(_ context.Context, idBook string) (*library.ListBookReviewsResponse, error) {
+						if test.useCaseErr != nil {
+							return nil, test.useCaseErr
+						}
+						return &library.ListBookReviewsResponse{
+							Reviews: []*library.Review{{Id: uuid.NewString(), BookId: idBook, Rating: 5}},
+							Rating:  &library.BookRating{Average: 5, Count: 1},
+						}, nil
+					})
+			}
+
+			response, err := s.ListBookReviews(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Len(t, response.GetReviews(), 1)
+			require.Equal(t, uint32(1), response.GetRating().GetCount())
+		})
+	}
+}
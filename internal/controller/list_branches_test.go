@@ -0,0 +1,54 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListBranches(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid list branches",
+			codeResponse: codes.OK},
+
+		{name: "Internal error",
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockBranchUseCase, s := InitBranchTest(t)
+			ctx := context.Background()
+			mockBranchUseCase.EXPECT().ListBranches(ctx).DoAndReturn(func(context.Context) (*library.ListBranchesResponse, error) {
+				if test.useCaseErr != nil {
+					return nil, test.useCaseErr
+				}
+				return &library.ListBranchesResponse{
+					Branches: []*library.Branch{{Id: uuid.NewString()}, {Id: uuid.NewString()}},
+				}, nil
+			})
+
+			response, err := s.ListBranches(ctx, &library.ListBranchesRequest{})
+			require.Equal(t, status.Code(err), test.codeResponse)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Len(t, response.GetBranches(), 2)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/pkg/logger"
+
+	"go.uber.org/zap"
+
+	"github.com/project/library/generated/api/library"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) ListHolds(ctx context.Context, req *library.ListHoldsRequest) (*library.ListHoldsResponse, error) {
+	if err := req.ValidateAll(); logger.CheckError(err, i.logger, "Got invalid request", zap.Any("request", req), zap.Error(err)) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.GetMemberId() == "" && req.GetBookId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "member_id or book_id must be set")
+	}
+
+	response, err := i.holdUseCase.ListHolds(ctx, req.GetMemberId(), req.GetBookId())
+
+	if err != nil {
+		return nil, i.convertErr(err)
+	}
+
+	return response, nil
+}
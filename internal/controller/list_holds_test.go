@@ -0,0 +1,82 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListHolds(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.ListHoldsRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid list member's holds",
+			request:      &library.ListHoldsRequest{MemberId: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Valid list book's holds",
+			request:      &library.ListHoldsRequest{BookId: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Valid list member's holds on book",
+			request:      &library.ListHoldsRequest{MemberId: uuid.NewString(), BookId: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Without member and book",
+			request:      &library.ListHoldsRequest{},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid member id",
+			request:      &library.ListHoldsRequest{MemberId: "123"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid book id",
+			request:      &library.ListHoldsRequest{BookId: "123"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Internal error",
+			request:      &library.ListHoldsRequest{BookId: uuid.NewString()},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockHoldUseCase, s := InitHoldTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockHoldUseCase.EXPECT().ListHolds(ctx, req.GetMemberId(), req.GetBookId()).DoAndReturn(
+					func(_ context.Context, idMember, idBook string) (*library.ListHoldsResponse, error) {
+						if test.useCaseErr != nil {
+							return nil, test.useCaseErr
+						}
+						return &library.ListHoldsResponse{
+							Holds: []*library.Hold{{Id: uuid.NewString(), MemberId: idMember, BookId: idBook}},
+						}, nil
+					})
+			}
+
+			response, err := s.ListHolds(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Len(t, response.GetHolds(), 1)
+		})
+	}
+}
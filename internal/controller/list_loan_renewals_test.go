@@ -0,0 +1,67 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListLoanRenewals(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.ListLoanRenewalsRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid list renewals",
+			request:      &library.ListLoanRenewalsRequest{Id: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Invalid id",
+			request:      &library.ListLoanRenewalsRequest{Id: "123"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Internal error",
+			request:      &library.ListLoanRenewalsRequest{Id: uuid.NewString()},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockLoanUseCase, s := InitLoanTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockLoanUseCase.EXPECT().ListLoanRenewals(ctx, req.GetId()).DoAndReturn(
+					func(_ context.Context, id string) (*library.ListLoanRenewalsResponse, error) {
+						if test.useCaseErr != nil {
+							return nil, test.useCaseErr
+						}
+						return &library.ListLoanRenewalsResponse{
+							Renewals: []*library.LoanRenewal{{Id: uuid.NewString(), LoanId: id}},
+						}, nil
+					})
+			}
+
+			response, err := s.ListLoanRenewals(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Len(t, response.GetRenewals(), 1)
+			require.Equal(t, req.GetId(), response.GetRenewals()[0].GetLoanId())
+		})
+	}
+}
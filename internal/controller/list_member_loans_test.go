@@ -0,0 +1,67 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListMemberLoans(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.ListMemberLoansRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid list member loans",
+			request:      &library.ListMemberLoansRequest{MemberId: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Invalid member id",
+			request:      &library.ListMemberLoansRequest{MemberId: "123"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Internal error",
+			request:      &library.ListMemberLoansRequest{MemberId: uuid.NewString()},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockLoanUseCase, s := InitLoanTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockLoanUseCase.EXPECT().ListMemberLoans(ctx, req.GetMemberId()).DoAndReturn(
+					func(_ context.Context, id string) (*library.ListMemberLoansResponse, error) {
+						if test.useCaseErr != nil {
+							return nil, test.useCaseErr
+						}
+						return &library.ListMemberLoansResponse{
+							Loans: []*library.Loan{{Id: uuid.NewString(), MemberId: id}},
+						}, nil
+					})
+			}
+
+			response, err := s.ListMemberLoans(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Len(t, response.GetLoans(), 1)
+			require.Equal(t, req.GetMemberId(), response.GetLoans()[0].GetMemberId())
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListMembers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.ListMembersRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid list members",
+			request:      &library.ListMembersRequest{},
+			codeResponse: codes.OK},
+
+		{name: "Valid list members with filters",
+			request: &library.ListMembersRequest{
+				Status:         library.MemberStatus_MEMBER_STATUS_ACTIVE,
+				MembershipType: library.MembershipType_MEMBERSHIP_TYPE_CHILD,
+				PageSize:       10,
+				PageToken:      "C-0001"},
+			codeResponse: codes.OK},
+
+		{name: "Too big page",
+			request:      &library.ListMembersRequest{PageSize: 101},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown status",
+			request:      &library.ListMembersRequest{Status: 100},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Internal error",
+			request:      &library.ListMembersRequest{},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockMemberUseCase, s := InitMemberTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockMemberUseCase.EXPECT().ListMembers(ctx, req.GetStatus(), req.GetMembershipType(), int(req.GetPageSize()), req.GetPageToken()).
+					DoAndReturn(func(context.Context, library.MemberStatus, library.MembershipType, int, string) (*library.ListMembersResponse, error) {
+						if test.useCaseErr != nil {
+							return nil, test.useCaseErr
+						}
+						return &library.ListMembersResponse{
+							Members:       []*library.Member{{Id: uuid.NewString(), CardNumber: "C-0002"}},
+							NextPageToken: "C-0002",
+						}, nil
+					})
+			}
+
+			response, err := s.ListMembers(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Len(t, response.GetMembers(), 1)
+			require.Equal(t, "C-0002", response.GetNextPageToken())
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListReadingLists(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.ListReadingListsRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid own lists",
+			request:      &library.ListReadingListsRequest{OwnerId: "reader", ViewerId: "reader"},
+			codeResponse: codes.OK},
+
+		{name: "Valid lists of other user",
+			request:      &library.ListReadingListsRequest{OwnerId: "reader", ViewerId: "other"},
+			codeResponse: codes.OK},
+
+		{name: "Empty owner",
+			request:      &library.ListReadingListsRequest{ViewerId: "reader"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Internal error",
+			request:      &library.ListReadingListsRequest{OwnerId: "reader"},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockReadingListUseCase, s := InitReadingListTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockReadingListUseCase.EXPECT().ListReadingLists(ctx, req.GetOwnerId(), req.GetViewerId()).DoAndReturn(
+					func(_ context.Context, idOwner, _ string) (*library.ListReadingListsResponse, error) {
+						if test.useCaseErr != nil {
+							return nil, test.useCaseErr
+						}
+						return &library.ListReadingListsResponse{
+							ReadingLists: []*library.ReadingList{{Id: uuid.NewString(), OwnerId: idOwner}},
+						}, nil
+					})
+			}
+
+			response, err := s.ListReadingLists(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Len(t, response.GetReadingLists(), 1)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListTransfers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.ListTransfersRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid list transfers",
+			request:      &library.ListTransfersRequest{},
+			codeResponse: codes.OK},
+
+		{name: "Valid list transfers with filters",
+			request: &library.ListTransfersRequest{
+				BranchId: uuid.NewString(),
+				Status:   library.TransferStatus_TRANSFER_STATUS_IN_TRANSIT},
+			codeResponse: codes.OK},
+
+		{name: "Invalid branch id",
+			request:      &library.ListTransfersRequest{BranchId: "123"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown status",
+			request:      &library.ListTransfersRequest{Status: 100},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Internal error",
+			request:      &library.ListTransfersRequest{},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockBranchUseCase, s := InitBranchTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockBranchUseCase.EXPECT().ListTransfers(ctx, req.GetBranchId(), req.GetStatus()).
+					DoAndReturn(func(context.Context, string, library.TransferStatus) (*library.ListTransfersResponse, error) {
+						if test.useCaseErr != nil {
+							return nil, test.useCaseErr
+						}
+						return &library.ListTransfersResponse{
+							Transfers: []*library.Transfer{{Id: uuid.NewString()}},
+						}, nil
+					})
+			}
+
+			response, err := s.ListTransfers(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Len(t, response.GetTransfers(), 1)
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPayFine(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.PayFineRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid payment",
+			request: &library.PayFineRequest{
+				LoanId: uuid.NewString(),
+				Amount: 50,
+				Type:   library.FinePaymentType_FINE_PAYMENT_TYPE_PAID},
+			codeResponse: codes.OK},
+
+		{name: "Valid waiver",
+			request: &library.PayFineRequest{
+				LoanId: uuid.NewString(),
+				Amount: 50,
+				Type:   library.FinePaymentType_FINE_PAYMENT_TYPE_WAIVED},
+			codeResponse: codes.OK},
+
+		{name: "Invalid id",
+			request: &library.PayFineRequest{
+				LoanId: "123",
+				Amount: 50,
+				Type:   library.FinePaymentType_FINE_PAYMENT_TYPE_PAID},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Zero amount",
+			request: &library.PayFineRequest{
+				LoanId: uuid.NewString(),
+				Type:   library.FinePaymentType_FINE_PAYMENT_TYPE_PAID},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unspecified type",
+			request: &library.PayFineRequest{
+				LoanId: uuid.NewString(),
+				Amount: 50},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown loan",
+			request: &library.PayFineRequest{
+				LoanId: uuid.NewString(),
+				Amount: 50,
+				Type:   library.FinePaymentType_FINE_PAYMENT_TYPE_PAID},
+			codeResponse: codes.NotFound,
+			useCaseErr:   entity.ErrLoanNotFound},
+
+		{name: "Amount exceeds fine",
+			request: &library.PayFineRequest{
+				LoanId: uuid.NewString(),
+				Amount: 5000,
+				Type:   library.FinePaymentType_FINE_PAYMENT_TYPE_PAID},
+			codeResponse: codes.FailedPrecondition,
+			useCaseErr:   entity.ErrFineOverpaid},
+
+		{name: "Internal error",
+			request: &library.PayFineRequest{
+				LoanId: uuid.NewString(),
+				Amount: 50,
+				Type:   library.FinePaymentType_FINE_PAYMENT_TYPE_PAID},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockLoanUseCase, s := InitLoanTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockLoanUseCase.EXPECT().PayFine(ctx, req.GetLoanId(), req.GetAmount(), req.GetType()).DoAndReturn(
+					func(_ context.Context, id string, amount int64, _ library.FinePaymentType) (*library.PayFineResponse, error) {
+						if test.useCaseErr != nil {
+							return nil, test.useCaseErr
+						}
+						return &library.PayFineResponse{
+							Loan: &library.Loan{Id: id, Fine: 100, FinePaid: amount},
+						}, nil
+					})
+			}
+
+			response, err := s.PayFine(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, req.GetLoanId(), response.GetLoan().GetId())
+			require.Equal(t, req.GetAmount(), response.GetLoan().GetFinePaid())
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPlaceHold(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.PlaceHoldRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid hold",
+			request:      &library.PlaceHoldRequest{MemberId: uuid.NewString(), BookId: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Invalid member id",
+			request:      &library.PlaceHoldRequest{MemberId: "123", BookId: uuid.NewString()},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid book id",
+			request:      &library.PlaceHoldRequest{MemberId: uuid.NewString(), BookId: "123"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown book",
+			request:      &library.PlaceHoldRequest{MemberId: uuid.NewString(), BookId: uuid.NewString()},
+			codeResponse: codes.NotFound,
+			useCaseErr:   entity.ErrBookNotFound},
+
+		{name: "Book is available",
+			request:      &library.PlaceHoldRequest{MemberId: uuid.NewString(), BookId: uuid.NewString()},
+			codeResponse: codes.FailedPrecondition,
+			useCaseErr:   entity.ErrBookAvailable},
+
+		{name: "Member cannot borrow",
+			request:      &library.PlaceHoldRequest{MemberId: uuid.NewString(), BookId: uuid.NewString()},
+			codeResponse: codes.FailedPrecondition,
+			useCaseErr:   entity.ErrMemberCannotBorrow},
+
+		{name: "Hold already exists",
+			request:      &library.PlaceHoldRequest{MemberId: uuid.NewString(), BookId: uuid.NewString()},
+			codeResponse: codes.AlreadyExists,
+			useCaseErr:   entity.ErrHoldAlreadyExists},
+
+		{name: "Internal error",
+			request:      &library.PlaceHoldRequest{MemberId: uuid.NewString(), BookId: uuid.NewString()},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockHoldUseCase, s := InitHoldTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockHoldUseCase.EXPECT().PlaceHold(ctx, req.GetMemberId(), req.GetBookId()).DoAndReturn(
+					func(_ context.Context, idMember, idBook string) (*library.PlaceHoldResponse, error) {
+						if test.useCaseErr != nil {
+							return nil, test.useCaseErr
+						}
+						return &library.PlaceHoldResponse{
+							Hold: &library.Hold{Id: uuid.NewString(), MemberId: idMember, BookId: idBook, Position: 1},
+						}, nil
+					})
+			}
+
+			response, err := s.PlaceHold(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, req.GetBookId(), response.GetHold().GetBookId())
+			require.Equal(t, uint32(1), response.GetHold().GetPosition())
+		})
+	}
+}
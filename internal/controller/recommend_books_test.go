@@ -0,0 +1,80 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRecommendBooks(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.RecommendBooksRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid recommendations",
+			request:      &library.RecommendBooksRequest{BookId: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Valid recommendations with limit",
+			request:      &library.RecommendBooksRequest{BookId: uuid.NewString(), Limit: 50},
+			codeResponse: codes.OK},
+
+		{name: "Too big limit",
+			request:      &library.RecommendBooksRequest{BookId: uuid.NewString(), Limit: 51},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid book id",
+			request:      &library.RecommendBooksRequest{BookId: "123"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown book",
+			request:      &library.RecommendBooksRequest{BookId: uuid.NewString()},
+			codeResponse: codes.NotFound,
+			useCaseErr:   entity.ErrBookNotFound},
+
+		{name: "Internal error",
+			request:      &library.RecommendBooksRequest{BookId: uuid.NewString()},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockRecommendationUseCase, s := InitRecommendationTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockRecommendationUseCase.EXPECT().RecommendBooks(ctx, req.GetBookId(), req.GetLimit()).DoAndReturn(
+					func(context.Context, string, uint32) (*library.RecommendBooksResponse, error) {
+						if test.useCaseErr != nil {
+							return nil, test.useCaseErr
+						}
+						return &library.RecommendBooksResponse{
+							Recommendations: []*library.Recommendation{{Book: &library.Book{Id: uuid.NewString()}, Score: 5}},
+						}, nil
+					})
+			}
+
+			response, err := s.RecommendBooks(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Len(t, response.GetRecommendations(), 1)
+		})
+	}
+}
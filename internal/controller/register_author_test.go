@@ -27,6 +27,36 @@ func TestRegisterAuthor(t *testing.T) {
 				Name: validAuthorName},
 			codeResponse: codes.OK},
 
+		{name: "Cyrillic author's name",
+			request: &library.RegisterAuthorRequest{
+				Name: "Фёдор Достоевский"},
+			codeResponse: codes.OK},
+
+		{name: "Author's name with accents and apostrophe",
+			request: &library.RegisterAuthorRequest{
+				Name: "Gabriel García Márquez O'Brien"},
+			codeResponse: codes.OK},
+
+		{name: "Author's name with combining marks, dots and hyphen",
+			request: &library.RegisterAuthorRequest{
+				Name: "J. R. R. Jean-Paul Garci\u0301a"},
+			codeResponse: codes.OK},
+
+		{name: "Author's name with double space",
+			request: &library.RegisterAuthorRequest{
+				Name: "Test  testovich"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Author's name starting with hyphen",
+			request: &library.RegisterAuthorRequest{
+				Name: "-Test"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Author's name with punctuation",
+			request: &library.RegisterAuthorRequest{
+				Name: "Test!"},
+			codeResponse: codes.InvalidArgument},
+
 		{name: "Empty author's name",
 			request: &library.RegisterAuthorRequest{
 				Name: ""},
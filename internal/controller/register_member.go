@@ -0,0 +1,40 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/pkg/logger"
+
+	"go.uber.org/zap"
+
+	"github.com/project/library/generated/api/library"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) RegisterMember(ctx context.Context, req *library.RegisterMemberRequest) (*library.RegisterMemberResponse, error) {
+	if err := req.ValidateAll(); logger.CheckError(err, i.logger, "Got invalid request", zap.Any("request", req), zap.Error(err)) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.GetExpiryDate().AsTime().Before(time.Now()) {
+		return nil, status.Error(codes.InvalidArgument, "expiry date can not be in the past")
+	}
+
+	response, err := i.memberUseCase.RegisterMember(ctx, &library.Member{
+		CardNumber:     req.GetCardNumber(),
+		Name:           req.GetName(),
+		Email:          req.GetEmail(),
+		Phone:          req.GetPhone(),
+		Address:        req.GetAddress(),
+		MembershipType: req.GetMembershipType(),
+		ExpiryDate:     req.GetExpiryDate(),
+	})
+
+	if err != nil {
+		return nil, i.convertErr(err)
+	}
+
+	return response, nil
+}
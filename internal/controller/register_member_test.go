@@ -0,0 +1,127 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestRegisterMember(t *testing.T) {
+	t.Parallel()
+
+	nextYear := timestamppb.New(time.Now().AddDate(1, 0, 0))
+	validRequest := func() *library.RegisterMemberRequest {
+		return &library.RegisterMemberRequest{
+			CardNumber:     "C-0001",
+			Name:           "Ivan Petrov",
+			Email:          "ivan@example.com",
+			Phone:          "+7 (900) 123-45-67",
+			Address:        "Moscow",
+			MembershipType: library.MembershipType_MEMBERSHIP_TYPE_ADULT,
+			ExpiryDate:     nextYear,
+		}
+	}
+
+	tests := []struct {
+		name         string
+		modify       func(req *library.RegisterMemberRequest)
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid register member",
+			codeResponse: codes.OK},
+
+		{name: "Valid register member without contacts",
+			modify: func(req *library.RegisterMemberRequest) {
+				req.Email, req.Phone, req.Address = "", "", ""
+			},
+			codeResponse: codes.OK},
+
+		{name: "Short card number",
+			modify:       func(req *library.RegisterMemberRequest) { req.CardNumber = "C1" },
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Empty name",
+			modify:       func(req *library.RegisterMemberRequest) { req.Name = "" },
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid email",
+			modify:       func(req *library.RegisterMemberRequest) { req.Email = "ivan" },
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid phone",
+			modify:       func(req *library.RegisterMemberRequest) { req.Phone = "phone" },
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unspecified membership type",
+			modify:       func(req *library.RegisterMemberRequest) { req.MembershipType = 0 },
+			codeResponse: codes.InvalidArgument},
+
+		{name: "No expiry date",
+			modify:       func(req *library.RegisterMemberRequest) { req.ExpiryDate = nil },
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Expiry date in the past",
+			modify: func(req *library.RegisterMemberRequest) {
+				req.ExpiryDate = timestamppb.New(time.Now().AddDate(0, 0, -1))
+			},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Existing card number",
+			codeResponse: codes.AlreadyExists,
+			useCaseErr:   entity.ErrMemberAlreadyExists},
+
+		{name: "Internal error",
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockMemberUseCase, s := InitMemberTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := validRequest()
+			if test.modify != nil {
+				test.modify(req)
+			}
+			id := uuid.NewString()
+			if code != codes.InvalidArgument {
+				mockMemberUseCase.EXPECT().RegisterMember(ctx, &library.Member{
+					CardNumber:     req.GetCardNumber(),
+					Name:           req.GetName(),
+					Email:          req.GetEmail(),
+					Phone:          req.GetPhone(),
+					Address:        req.GetAddress(),
+					MembershipType: req.GetMembershipType(),
+					ExpiryDate:     req.GetExpiryDate(),
+				}).DoAndReturn(func(_ context.Context, member *library.Member) (*library.RegisterMemberResponse, error) {
+					if test.useCaseErr != nil {
+						return nil, test.useCaseErr
+					}
+					return &library.RegisterMemberResponse{
+						Member: &library.Member{Id: id, CardNumber: member.GetCardNumber()},
+					}, nil
+				})
+			}
+
+			response, err := s.RegisterMember(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, id, response.GetMember().GetId())
+		})
+	}
+}
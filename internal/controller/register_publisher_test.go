@@ -0,0 +1,106 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRegisterPublisher(t *testing.T) {
+	t.Parallel()
+
+	const validPublisherName = "Penguin Random House"
+	tests := []struct {
+		name         string
+		request      *library.RegisterPublisherRequest
+		codeResponse codes.Code
+	}{
+		{name: "Valid registration",
+			request: &library.RegisterPublisherRequest{
+				Name: validPublisherName},
+			codeResponse: codes.OK},
+
+		{name: "Valid imprint registration",
+			request: &library.RegisterPublisherRequest{
+				Name:     validPublisherName,
+				Country:  "US",
+				ParentId: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Empty publisher's name",
+			request: &library.RegisterPublisherRequest{
+				Name: ""},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Too long publisher's name",
+			request: &library.RegisterPublisherRequest{
+				Name: tooLongName},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid country",
+			request: &library.RegisterPublisherRequest{
+				Name:    validPublisherName,
+				Country: "usa"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid parent id",
+			request: &library.RegisterPublisherRequest{
+				Name:     validPublisherName,
+				ParentId: "123"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown parent publisher",
+			request: &library.RegisterPublisherRequest{
+				Name:     validPublisherName,
+				ParentId: uuid.NewString()},
+			codeResponse: codes.NotFound},
+
+		{name: "Internal error",
+			request: &library.RegisterPublisherRequest{
+				Name: validPublisherName},
+			codeResponse: codes.Internal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockPublisherUseCase, s := InitPublisherTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockPublisherUseCase.EXPECT().RegisterPublisher(ctx, req.GetName(), req.GetCountry(), req.GetParentId()).
+					DoAndReturn(func(ctx context.Context, name, country, parentID string) (*library.RegisterPublisherResponse, error) {
+						e := convertPublisherCodeToError(code)
+						if code != codes.OK {
+							return nil, e
+						}
+
+						return &library.RegisterPublisherResponse{
+							Id: uuid.NewString(),
+						}, e
+					})
+			}
+
+			response, err := s.RegisterPublisher(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			err = validation.ValidateStructWithContext(
+				ctx,
+				response,
+				validation.Field(&response.Id, is.UUID))
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRemoveBookLocalization(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.RemoveBookLocalizationRequest
+		codeResponse codes.Code
+	}{
+		{name: "Valid removing localization",
+			request: &library.RemoveBookLocalizationRequest{
+				BookId:   uuid.NewString(),
+				Language: "fr"},
+			codeResponse: codes.OK},
+
+		{name: "Invalid language",
+			request: &library.RemoveBookLocalizationRequest{
+				BookId:   uuid.NewString(),
+				Language: "french!"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid book id",
+			request: &library.RemoveBookLocalizationRequest{
+				BookId:   "123",
+				Language: "fr"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown localization",
+			request: &library.RemoveBookLocalizationRequest{
+				BookId:   uuid.NewString(),
+				Language: "fr"},
+			codeResponse: codes.NotFound},
+
+		{name: "Internal error",
+			request: &library.RemoveBookLocalizationRequest{
+				BookId:   uuid.NewString(),
+				Language: "fr"},
+			codeResponse: codes.Internal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockBooksUseCase, s := InitBooksTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+
+			if code != codes.InvalidArgument {
+				var err error
+				switch code {
+				case codes.NotFound:
+					err = entity.ErrLocalizationNotFound
+				case codes.Internal:
+					err = errInternal
+				default:
+				}
+				mockBooksUseCase.EXPECT().RemoveBookLocalization(ctx, req.GetBookId(), req.GetLanguage()).Return(err)
+			}
+
+			response, err := s.RemoveBookLocalization(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.NotNil(t, response)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRemoveReadingListBook(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.RemoveReadingListBookRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid request",
+			request:      &library.RemoveReadingListBookRequest{Id: uuid.NewString(), OwnerId: "member-1", BookId: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Invalid list id",
+			request:      &library.RemoveReadingListBookRequest{Id: "123", OwnerId: "member-1", BookId: uuid.NewString()},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Missing owner",
+			request:      &library.RemoveReadingListBookRequest{Id: uuid.NewString(), BookId: uuid.NewString()},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid book id",
+			request:      &library.RemoveReadingListBookRequest{Id: uuid.NewString(), OwnerId: "member-1", BookId: "123"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown list",
+			request:      &library.RemoveReadingListBookRequest{Id: uuid.NewString(), OwnerId: "member-1", BookId: uuid.NewString()},
+			codeResponse: codes.NotFound,
+			useCaseErr:   entity.ErrReadingListNotFound},
+
+		{name: "Book not in the list",
+			request:      &library.RemoveReadingListBookRequest{Id: uuid.NewString(), OwnerId: "member-1", BookId: uuid.NewString()},
+			codeResponse: codes.NotFound,
+			useCaseErr:   entity.ErrReadingListBookNotFound},
+
+		{name: "Internal error",
+			request:      &library.RemoveReadingListBookRequest{Id: uuid.NewString(), OwnerId: "member-1", BookId: uuid.NewString()},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockReadingListUseCase, s := InitReadingListTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockReadingListUseCase.EXPECT().RemoveReadingListBook(ctx, req.GetId(), req.GetOwnerId(), req.GetBookId()).Return(test.useCaseErr)
+			}
+
+			response, err := s.RemoveReadingListBook(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.NotNil(t, response)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRenewLoan(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.RenewLoanRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid renewal",
+			request:      &library.RenewLoanRequest{Id: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Invalid id",
+			request:      &library.RenewLoanRequest{Id: "123"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown loan",
+			request:      &library.RenewLoanRequest{Id: uuid.NewString()},
+			codeResponse: codes.NotFound,
+			useCaseErr:   entity.ErrLoanNotFound},
+
+		{name: "Renewal is not allowed",
+			request:      &library.RenewLoanRequest{Id: uuid.NewString()},
+			codeResponse: codes.FailedPrecondition,
+			useCaseErr:   entity.ErrRenewalNotAllowed},
+
+		{name: "Member cannot borrow",
+			request:      &library.RenewLoanRequest{Id: uuid.NewString()},
+			codeResponse: codes.FailedPrecondition,
+			useCaseErr:   entity.ErrMemberCannotBorrow},
+
+		{name: "Internal error",
+			request:      &library.RenewLoanRequest{Id: uuid.NewString()},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockLoanUseCase, s := InitLoanTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockLoanUseCase.EXPECT().RenewLoan(ctx, req.GetId()).DoAndReturn(
+					func(_ context.Context, id string) (*library.RenewLoanResponse, error) {
+						if test.useCaseErr != nil {
+							return nil, test.useCaseErr
+						}
+						return &library.RenewLoanResponse{
+							Loan: &library.Loan{Id: id, Renewals: 1},
+						}, nil
+					})
+			}
+
+			response, err := s.RenewLoan(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, req.GetId(), response.GetLoan().GetId())
+			require.Equal(t, uint32(1), response.GetLoan().GetRenewals())
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReorderReadingListBook(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.ReorderReadingListBookRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid reorder",
+			request:      &library.ReorderReadingListBookRequest{Id: uuid.NewString(), OwnerId: "member-1", BookId: uuid.NewString(), Position: 1},
+			codeResponse: codes.OK},
+
+		{name: "Zero position",
+			request:      &library.ReorderReadingListBookRequest{Id: uuid.NewString(), OwnerId: "member-1", BookId: uuid.NewString()},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid book id",
+			request:      &library.ReorderReadingListBookRequest{Id: uuid.NewString(), OwnerId: "member-1", BookId: "123", Position: 2},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Missing owner",
+			request:      &library.ReorderReadingListBookRequest{Id: uuid.NewString(), BookId: uuid.NewString(), Position: 2},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Book not in the list",
+			request:      &library.ReorderReadingListBookRequest{Id: uuid.NewString(), OwnerId: "member-1", BookId: uuid.NewString(), Position: 2},
+			codeResponse: codes.NotFound,
+			useCaseErr:   entity.ErrReadingListBookNotFound},
+
+		{name: "Internal error",
+			request:      &library.ReorderReadingListBookRequest{Id: uuid.NewString(), OwnerId: "member-1", BookId: uuid.NewString(), Position: 2},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockReadingListUseCase, s := InitReadingListTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockReadingListUseCase.EXPECT().ReorderReadingListBook(ctx, req.GetId(), req.GetOwnerId(), req.GetBookId(), req.GetPosition()).Return(test.useCaseErr)
+			}
+
+			response, err := s.ReorderReadingListBook(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.NotNil(t, response)
+		})
+	}
+}
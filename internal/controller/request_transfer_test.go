@@ -0,0 +1,98 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRequestTransfer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.RequestTransferRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid transfer",
+			request:      &library.RequestTransferRequest{CopyId: uuid.NewString(), ToBranchId: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Invalid copy id",
+			request:      &library.RequestTransferRequest{CopyId: "123", ToBranchId: uuid.NewString()},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Empty branch id",
+			request:      &library.RequestTransferRequest{CopyId: uuid.NewString()},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown copy",
+			request:      &library.RequestTransferRequest{CopyId: uuid.NewString(), ToBranchId: uuid.NewString()},
+			codeResponse: codes.NotFound,
+			useCaseErr:   entity.ErrCopyNotFound},
+
+		{name: "Unknown branch",
+			request:      &library.RequestTransferRequest{CopyId: uuid.NewString(), ToBranchId: uuid.NewString()},
+			codeResponse: codes.NotFound,
+			useCaseErr:   entity.ErrBranchNotFound},
+
+		{name: "Copy is not available",
+			request:      &library.RequestTransferRequest{CopyId: uuid.NewString(), ToBranchId: uuid.NewString()},
+			codeResponse: codes.FailedPrecondition,
+			useCaseErr:   entity.ErrCopyNotAvailable},
+
+		{name: "Copy is already in branch",
+			request:      &library.RequestTransferRequest{CopyId: uuid.NewString(), ToBranchId: uuid.NewString()},
+			codeResponse: codes.FailedPrecondition,
+			useCaseErr:   entity.ErrTransferNotAllowed},
+
+		{name: "Transfer already exists",
+			request:      &library.RequestTransferRequest{CopyId: uuid.NewString(), ToBranchId: uuid.NewString()},
+			codeResponse: codes.AlreadyExists,
+			useCaseErr:   entity.ErrTransferAlreadyExists},
+
+		{name: "Internal error",
+			request:      &library.RequestTransferRequest{CopyId: uuid.NewString(), ToBranchId: uuid.NewString()},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockBranchUseCase, s := InitBranchTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockBranchUseCase.EXPECT().RequestTransfer(ctx, req.GetCopyId(), req.GetToBranchId()).DoAndReturn(
+					func(_ context.Context, idCopy, idToBranch string) (*library.RequestTransferResponse, error) {
+						if test.useCaseErr != nil {
+							return nil, test.useCaseErr
+						}
+						return &library.RequestTransferResponse{
+							Transfer: &library.Transfer{Id: uuid.NewString(), CopyId: idCopy, ToBranchId: idToBranch,
+								Status: library.TransferStatus_TRANSFER_STATUS_REQUESTED},
+						}, nil
+					})
+			}
+
+			response, err := s.RequestTransfer(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, req.GetCopyId(), response.GetTransfer().GetCopyId())
+			require.Equal(t, library.TransferStatus_TRANSFER_STATUS_REQUESTED, response.GetTransfer().GetStatus())
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReturnBook(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.ReturnBookRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid return",
+			request:      &library.ReturnBookRequest{CopyId: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Invalid copy id",
+			request:      &library.ReturnBookRequest{CopyId: "123"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Copy not on loan",
+			request:      &library.ReturnBookRequest{CopyId: uuid.NewString()},
+			codeResponse: codes.NotFound,
+			useCaseErr:   entity.ErrLoanNotFound},
+
+		{name: "Internal error",
+			request:      &library.ReturnBookRequest{CopyId: uuid.NewString()},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockLoanUseCase, s := InitLoanTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockLoanUseCase.EXPECT().ReturnBook(ctx, req.GetCopyId()).DoAndReturn(
+					func(_ context.Context, idCopy string) (*library.ReturnBookResponse, error) {
+						if test.useCaseErr != nil {
+							return nil, test.useCaseErr
+						}
+						return &library.ReturnBookResponse{
+							Loan: &library.Loan{Id: uuid.NewString(), CopyId: idCopy},
+						}, nil
+					})
+			}
+
+			response, err := s.ReturnBook(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, req.GetCopyId(), response.GetLoan().GetCopyId())
+		})
+	}
+}
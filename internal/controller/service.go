@@ -2,6 +2,8 @@ package controller
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/project/library/generated/api/library"
 	generated "github.com/project/library/generated/api/library"
@@ -11,34 +13,226 @@ import (
 type (
 	AuthorUseCase interface {
 		RegisterAuthor(ctx context.Context, authorName string) (*library.RegisterAuthorResponse, error)
-		ChangeAuthorInfo(ctx context.Context, idAuthor, newName string) error
+		ChangeAuthorInfo(ctx context.Context, idAuthor string, newInfo *library.Author, fields []string) error
 		GetAuthorInfo(ctx context.Context, idAuthor string) (*library.GetAuthorInfoResponse, error)
+		FindAuthors(ctx context.Context, name string) (*library.FindAuthorsResponse, error)
+		GetAuthorCollaborators(ctx context.Context, idAuthor string, maxDepth uint32) (*library.GetAuthorCollaboratorsResponse, error)
+		GetCollaborationPath(ctx context.Context, idFrom, idTo string, maxDepth uint32) (*library.GetCollaborationPathResponse, error)
 	}
 
 	BooksUseCase interface {
-		AddBook(ctx context.Context, name string, authorIDs []string) (*library.AddBookResponse, error)
-		GetBookInfo(ctx context.Context, bookID string) (*library.GetBookInfoResponse, error)
-		UpdateBook(ctx context.Context, id, newName string, newAuthorIDs []string) error
-		GetAuthorBooks(ctx context.Context, idAuthor string) (<-chan *library.Book, error)
+		AddBook(
+			ctx context.Context,
+			name string,
+			authorIDs []string,
+			contributors []*library.Contributor,
+			publisherID string,
+			workID string,
+		) (*library.AddBookResponse, error)
+		GetBookInfo(ctx context.Context, bookID, acceptLanguage, idBranch string) (*library.GetBookInfoResponse, error)
+		UpdateBook(
+			ctx context.Context,
+			id, newName string,
+			newAuthorIDs []string,
+			newContributors []*library.Contributor,
+			newPublisherID string,
+		) error
+		GetAuthorBooks(
+			ctx context.Context,
+			idAuthor string,
+			role library.ContributorRole,
+			acceptLanguage string,
+			idBranch string,
+		) (<-chan *library.Book, func() error, error)
+		GetPublisherBooks(ctx context.Context, idPublisher, idBranch string) (<-chan *library.Book, func() error, error)
+		GetWorkEditions(ctx context.Context, idWork, idBranch string) (<-chan *library.Book, func() error, error)
+		SetBookLocalization(ctx context.Context, idBook, language, title, description string) error
+		RemoveBookLocalization(ctx context.Context, idBook, language string) error
+		SetBookSubjects(ctx context.Context, idBook string, subjects []string) error
+		ImportCatalog(ctx context.Context, catalog io.Reader) (*library.ImportCatalogResponse, error)
+		ExportCatalog(
+			ctx context.Context,
+			format library.ExportFormat,
+			updatedFrom, updatedTo time.Time,
+		) (<-chan *library.ExportCatalogResponse, func() error, error)
+		ImportMarc(ctx context.Context, format library.MarcFormat, records io.Reader) (*library.ImportMarcResponse, error)
+		ExportMarc(
+			ctx context.Context,
+			format library.MarcFormat,
+			idBook string,
+		) (<-chan *library.ExportMarcResponse, func() error, error)
+		GetBookCitation(ctx context.Context, idBook string, format library.CitationFormat) (*library.GetBookCitationResponse, error)
+		GetBookCitations(ctx context.Context, ids []string, format library.CitationFormat) (*library.GetBookCitationsResponse, error)
+	}
+
+	PublisherUseCase interface {
+		RegisterPublisher(ctx context.Context, name, country, parentID string) (*library.RegisterPublisherResponse, error)
+		ChangePublisherInfo(ctx context.Context, idPublisher, newName, newCountry, newParentID string) error
+		GetPublisherInfo(ctx context.Context, idPublisher string) (*library.GetPublisherInfoResponse, error)
+	}
+
+	WorkUseCase interface {
+		CreateWork(ctx context.Context, name string, authorIDs []string) (*library.CreateWorkResponse, error)
+		ChangeWorkInfo(ctx context.Context, idWork, newName string, newAuthorIDs []string) error
+		GetWorkInfo(ctx context.Context, idWork string) (*library.GetWorkInfoResponse, error)
+		AttachEdition(ctx context.Context, idWork, idBook string) error
+		DetachEdition(ctx context.Context, idWork, idBook string) error
+	}
+
+	CoverUseCase interface {
+		UploadBookCover(ctx context.Context, idBook string, cover io.Reader) (*library.UploadBookCoverResponse, error)
+		DownloadBookCover(ctx context.Context, idBook string, size library.CoverSize) (<-chan *library.DownloadBookCoverResponse, error)
+	}
+
+	RelationUseCase interface {
+		LinkBooks(ctx context.Context, idBook, idRelatedBook string, relationType library.BookRelationType) error
+		UnlinkBooks(ctx context.Context, idBook, idRelatedBook string, relationType library.BookRelationType) error
+		GetRelatedBooks(ctx context.Context, idBook string, depth int) (*library.GetRelatedBooksResponse, error)
+	}
+
+	CopyUseCase interface {
+		AddCopy(ctx context.Context, newCopy *library.Copy) (*library.AddCopyResponse, error)
+		UpdateCopy(ctx context.Context, updCopy *library.Copy) error
+		GetCopy(ctx context.Context, idCopy string) (*library.GetCopyResponse, error)
+		GetCopyByBarcode(ctx context.Context, barcode string) (*library.GetCopyByBarcodeResponse, error)
+		DeleteCopy(ctx context.Context, idCopy string) error
+		GetBookCopies(ctx context.Context, idBook, idBranch string) (*library.GetBookCopiesResponse, error)
+	}
+
+	MemberUseCase interface {
+		RegisterMember(ctx context.Context, newMember *library.Member) (*library.RegisterMemberResponse, error)
+		UpdateMember(ctx context.Context, updMember *library.Member) error
+		GetMember(ctx context.Context, idMember string) (*library.GetMemberResponse, error)
+		ListMembers(
+			ctx context.Context,
+			status library.MemberStatus,
+			membershipType library.MembershipType,
+			pageSize int,
+			pageToken string,
+		) (*library.ListMembersResponse, error)
+	}
+
+	LoanUseCase interface {
+		CheckoutBook(ctx context.Context, idMember, idCopy string) (*library.CheckoutBookResponse, error)
+		ReturnBook(ctx context.Context, idCopy string) (*library.ReturnBookResponse, error)
+		ListMemberLoans(ctx context.Context, idMember string) (*library.ListMemberLoansResponse, error)
+		ListBookLoans(ctx context.Context, idBook string) (*library.ListBookLoansResponse, error)
+		GetMemberBalance(ctx context.Context, idMember string) (*library.GetMemberBalanceResponse, error)
+		PayFine(ctx context.Context, idLoan string, amount int64, paymentType library.FinePaymentType) (*library.PayFineResponse, error)
+		RenewLoan(ctx context.Context, idLoan string) (*library.RenewLoanResponse, error)
+		ListLoanRenewals(ctx context.Context, idLoan string) (*library.ListLoanRenewalsResponse, error)
+	}
+
+	HoldUseCase interface {
+		PlaceHold(ctx context.Context, idMember, idBook string) (*library.PlaceHoldResponse, error)
+		CancelHold(ctx context.Context, idHold string) (*library.CancelHoldResponse, error)
+		ListHolds(ctx context.Context, idMember, idBook string) (*library.ListHoldsResponse, error)
+	}
+
+	BranchUseCase interface {
+		CreateBranch(ctx context.Context, name, address string) (*library.CreateBranchResponse, error)
+		GetBranch(ctx context.Context, idBranch string) (*library.GetBranchResponse, error)
+		ListBranches(ctx context.Context) (*library.ListBranchesResponse, error)
+		RequestTransfer(ctx context.Context, idCopy, idToBranch string) (*library.RequestTransferResponse, error)
+		ShipTransfer(ctx context.Context, idTransfer string) (*library.ShipTransferResponse, error)
+		ReceiveTransfer(ctx context.Context, idTransfer string) (*library.ReceiveTransferResponse, error)
+		CancelTransfer(ctx context.Context, idTransfer string) (*library.CancelTransferResponse, error)
+		ListTransfers(ctx context.Context, idBranch string, status library.TransferStatus) (*library.ListTransfersResponse, error)
+	}
+
+	ReviewUseCase interface {
+		CreateReview(ctx context.Context, idBook, idMember string, rating uint32, text string) (*library.CreateReviewResponse, error)
+		UpdateReview(ctx context.Context, idReview, idMember string, rating uint32, text string) (*library.UpdateReviewResponse, error)
+		DeleteReview(ctx context.Context, idReview, idMember string) error
+		ListBookReviews(ctx context.Context, idBook string) (*library.ListBookReviewsResponse, error)
+	}
+
+	ReadingListUseCase interface {
+		CreateReadingList(
+			ctx context.Context,
+			idOwner, name string,
+			kind library.ReadingListKind,
+			public bool,
+		) (*library.CreateReadingListResponse, error)
+		ListReadingLists(ctx context.Context, idOwner, idViewer string) (*library.ListReadingListsResponse, error)
+		AddReadingListBook(ctx context.Context, idList, idOwner, idBook string) error
+		RemoveReadingListBook(ctx context.Context, idList, idOwner, idBook string) error
+		ReorderReadingListBook(ctx context.Context, idList, idOwner, idBook string, position uint32) error
+		ShareReadingList(ctx context.Context, idList, idOwner string, public bool) (*library.ShareReadingListResponse, error)
+		GetReadingListBooks(ctx context.Context, idList, idViewer string) (<-chan *library.Book, func() error, error)
+	}
+
+	RecommendationUseCase interface {
+		RecommendBooks(ctx context.Context, idBook string, limit uint32) (*library.RecommendBooksResponse, error)
+	}
+
+	StatsUseCase interface {
+		GetLibraryStats(
+			ctx context.Context,
+			from, to time.Time,
+			interval library.StatsInterval,
+			topAuthors uint32,
+		) (*library.GetLibraryStatsResponse, error)
 	}
 )
 
 var _ generated.LibraryServer = (*implementation)(nil)
 
 type implementation struct {
-	logger        *zap.Logger
-	booksUseCase  BooksUseCase
-	authorUseCase AuthorUseCase
+	logger                *zap.Logger
+	booksUseCase          BooksUseCase
+	authorUseCase         AuthorUseCase
+	publisherUseCase      PublisherUseCase
+	workUseCase           WorkUseCase
+	coverUseCase          CoverUseCase
+	relationUseCase       RelationUseCase
+	copyUseCase           CopyUseCase
+	memberUseCase         MemberUseCase
+	loanUseCase           LoanUseCase
+	holdUseCase           HoldUseCase
+	branchUseCase         BranchUseCase
+	reviewUseCase         ReviewUseCase
+	readingListUseCase    ReadingListUseCase
+	recommendationUseCase RecommendationUseCase
+	statsUseCase          StatsUseCase
+}
+
+// UseCases are use cases served by the controller, use cases which are not used may be nil.
+type UseCases struct {
+	Books          BooksUseCase
+	Author         AuthorUseCase
+	Publisher      PublisherUseCase
+	Work           WorkUseCase
+	Cover          CoverUseCase
+	Relation       RelationUseCase
+	Copy           CopyUseCase
+	Member         MemberUseCase
+	Loan           LoanUseCase
+	Hold           HoldUseCase
+	Branch         BranchUseCase
+	Review         ReviewUseCase
+	ReadingList    ReadingListUseCase
+	Recommendation RecommendationUseCase
+	Stats          StatsUseCase
 }
 
-func New(
-	logger *zap.Logger,
-	booksUseCase BooksUseCase,
-	authorUseCase AuthorUseCase,
-) *implementation {
+func New(logger *zap.Logger, useCases UseCases) *implementation {
 	return &implementation{
-		logger:        logger,
-		booksUseCase:  booksUseCase,
-		authorUseCase: authorUseCase,
+		logger:                logger,
+		booksUseCase:          useCases.Books,
+		authorUseCase:         useCases.Author,
+		publisherUseCase:      useCases.Publisher,
+		workUseCase:           useCases.Work,
+		coverUseCase:          useCases.Cover,
+		relationUseCase:       useCases.Relation,
+		copyUseCase:           useCases.Copy,
+		memberUseCase:         useCases.Member,
+		loanUseCase:           useCases.Loan,
+		holdUseCase:           useCases.Hold,
+		branchUseCase:         useCases.Branch,
+		reviewUseCase:         useCases.Review,
+		readingListUseCase:    useCases.ReadingList,
+		recommendationUseCase: useCases.Recommendation,
+		statsUseCase:          useCases.Stats,
 	}
 }
@@ -0,0 +1,33 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/pkg/logger"
+
+	"go.uber.org/zap"
+
+	"github.com/project/library/generated/api/library"
+	"golang.org/x/text/language"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) SetBookLocalization(ctx context.Context, req *library.SetBookLocalizationRequest) (*library.SetBookLocalizationResponse, error) {
+	if err := req.ValidateAll(); logger.CheckError(err, i.logger, "Got invalid request", zap.Any("request", req), zap.Error(err)) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tag, err := language.Parse(req.GetLanguage())
+	if logger.CheckError(err, i.logger, "Got invalid language", zap.String("language", req.GetLanguage()), zap.Error(err)) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = i.booksUseCase.SetBookLocalization(ctx, req.GetBookId(), tag.String(), req.GetTitle(), req.GetDescription())
+
+	if err != nil {
+		return nil, i.convertErr(err)
+	}
+
+	return &library.SetBookLocalizationResponse{}, nil
+}
@@ -0,0 +1,109 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSetBookLocalization(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		request         *library.SetBookLocalizationRequest
+		requireLanguage string
+		codeResponse    codes.Code
+	}{
+		{name: "Valid setting localization",
+			request: &library.SetBookLocalizationRequest{
+				BookId:      uuid.NewString(),
+				Language:    "en-GB",
+				Title:       "Colour",
+				Description: "About colours"},
+			requireLanguage: "en-GB",
+			codeResponse:    codes.OK},
+
+		{name: "Language is canonicalized",
+			request: &library.SetBookLocalizationRequest{
+				BookId:   uuid.NewString(),
+				Language: "EN-gb",
+				Title:    "Colour"},
+			requireLanguage: "en-GB",
+			codeResponse:    codes.OK},
+
+		{name: "Invalid language",
+			request: &library.SetBookLocalizationRequest{
+				BookId:   uuid.NewString(),
+				Language: "english!",
+				Title:    "Colour"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Empty title",
+			request: &library.SetBookLocalizationRequest{
+				BookId:   uuid.NewString(),
+				Language: "en"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Too long description",
+			request: &library.SetBookLocalizationRequest{
+				BookId:      uuid.NewString(),
+				Language:    "en",
+				Title:       "Colour",
+				Description: strings.Repeat("a", 10001)},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid book id",
+			request: &library.SetBookLocalizationRequest{
+				BookId:   "123",
+				Language: "en",
+				Title:    "Colour"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown book",
+			request: &library.SetBookLocalizationRequest{
+				BookId:   uuid.NewString(),
+				Language: "en",
+				Title:    "Colour"},
+			requireLanguage: "en",
+			codeResponse:    codes.NotFound},
+
+		{name: "Internal error",
+			request: &library.SetBookLocalizationRequest{
+				BookId:   uuid.NewString(),
+				Language: "en",
+				Title:    "Colour"},
+			requireLanguage: "en",
+			codeResponse:    codes.Internal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockBooksUseCase, s := InitBooksTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+
+			if code != codes.InvalidArgument {
+				mockBooksUseCase.EXPECT().SetBookLocalization(ctx, req.GetBookId(), test.requireLanguage, req.GetTitle(), req.GetDescription()).
+					Return(convertBookCodeToError(code))
+			}
+
+			response, err := s.SetBookLocalization(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.NotNil(t, response)
+		})
+	}
+}
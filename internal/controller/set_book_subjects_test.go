@@ -0,0 +1,94 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSetBookSubjects(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.SetBookSubjectsRequest
+		codeResponse codes.Code
+	}{
+		{name: "Valid setting subjects",
+			request: &library.SetBookSubjectsRequest{
+				BookId:   uuid.NewString(),
+				Subjects: []string{"Science fiction", "Humour"}},
+			codeResponse: codes.OK},
+
+		{name: "Removing subjects",
+			request: &library.SetBookSubjectsRequest{
+				BookId: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Empty subject",
+			request: &library.SetBookSubjectsRequest{
+				BookId:   uuid.NewString(),
+				Subjects: []string{""}},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Too long subject",
+			request: &library.SetBookSubjectsRequest{
+				BookId:   uuid.NewString(),
+				Subjects: []string{strings.Repeat("a", 101)}},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Too many subjects",
+			request: &library.SetBookSubjectsRequest{
+				BookId:   uuid.NewString(),
+				Subjects: strings.Split(strings.Repeat("a,", 50)+"a", ",")},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid book id",
+			request: &library.SetBookSubjectsRequest{
+				BookId:   "123",
+				Subjects: []string{"Humour"}},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown book",
+			request: &library.SetBookSubjectsRequest{
+				BookId:   uuid.NewString(),
+				Subjects: []string{"Humour"}},
+			codeResponse: codes.NotFound},
+
+		{name: "Internal error",
+			request: &library.SetBookSubjectsRequest{
+				BookId:   uuid.NewString(),
+				Subjects: []string{"Humour"}},
+			codeResponse: codes.Internal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockBooksUseCase, s := InitBooksTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+
+			if code != codes.InvalidArgument {
+				mockBooksUseCase.EXPECT().SetBookSubjects(ctx, req.GetBookId(), req.GetSubjects()).
+					Return(convertBookCodeToError(code))
+			}
+
+			response, err := s.SetBookSubjects(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.NotNil(t, response)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestShareReadingList(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.ShareReadingListRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid share",
+			request:      &library.ShareReadingListRequest{Id: uuid.NewString(), OwnerId: "member-1", Public: true},
+			codeResponse: codes.OK},
+
+		{name: "Valid unshare",
+			request:      &library.ShareReadingListRequest{Id: uuid.NewString(), OwnerId: "member-1"},
+			codeResponse: codes.OK},
+
+		{name: "Invalid id",
+			request:      &library.ShareReadingListRequest{Id: "123", OwnerId: "member-1", Public: true},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Missing owner",
+			request:      &library.ShareReadingListRequest{Id: uuid.NewString(), Public: true},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown list",
+			request:      &library.ShareReadingListRequest{Id: uuid.NewString(), OwnerId: "member-1", Public: true},
+			codeResponse: codes.NotFound,
+			useCaseErr:   entity.ErrReadingListNotFound},
+
+		{name: "Internal error",
+			request:      &library.ShareReadingListRequest{Id: uuid.NewString(), OwnerId: "member-1"},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockReadingListUseCase, s := InitReadingListTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockReadingListUseCase.EXPECT().ShareReadingList(ctx, req.GetId(), req.GetOwnerId(), req.GetPublic()).DoAndReturn(
+					func(_ context.Context, idList, idOwner string, public bool) (*library.ShareReadingListResponse, error) {
+						if test.useCaseErr != nil {
+							return nil, test.useCaseErr
+						}
+						return &library.ShareReadingListResponse{
+							ReadingList: &library.ReadingList{Id: idList, OwnerId: idOwner, Public: public},
+						}, nil
+					})
+			}
+
+			response, err := s.ShareReadingList(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, req.GetPublic(), response.GetReadingList().GetPublic())
+		})
+	}
+}
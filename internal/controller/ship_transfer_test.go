@@ -0,0 +1,78 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestShipTransfer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.ShipTransferRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid ship",
+			request:      &library.ShipTransferRequest{Id: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Invalid id",
+			request:      &library.ShipTransferRequest{Id: "123"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown transfer",
+			request:      &library.ShipTransferRequest{Id: uuid.NewString()},
+			codeResponse: codes.NotFound,
+			useCaseErr:   entity.ErrTransferNotFound},
+
+		{name: "Copy is not available",
+			request:      &library.ShipTransferRequest{Id: uuid.NewString()},
+			codeResponse: codes.FailedPrecondition,
+			useCaseErr:   entity.ErrCopyNotAvailable},
+
+		{name: "Internal error",
+			request:      &library.ShipTransferRequest{Id: uuid.NewString()},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockBranchUseCase, s := InitBranchTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockBranchUseCase.EXPECT().ShipTransfer(ctx, req.GetId()).DoAndReturn(
+					func(_ context.Context, id string) (*library.ShipTransferResponse, error) {
+						if test.useCaseErr != nil {
+							return nil, test.useCaseErr
+						}
+						return &library.ShipTransferResponse{
+							Transfer: &library.Transfer{Id: id, Status: library.TransferStatus_TRANSFER_STATUS_IN_TRANSIT},
+						}, nil
+					})
+			}
+
+			response, err := s.ShipTransfer(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, req.GetId(), response.GetTransfer().GetId())
+			require.Equal(t, library.TransferStatus_TRANSFER_STATUS_IN_TRANSIT, response.GetTransfer().GetStatus())
+		})
+	}
+}
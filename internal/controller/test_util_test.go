@@ -20,6 +20,10 @@ var (
 	tooLongName = strings.Repeat("Too long name", 40)
 )
 
+func noStreamErr() error {
+	return nil
+}
+
 func InitBooksTest(t *testing.T) (*gomock.Controller, *mocks.MockBooksUseCase, *implementation) {
 	t.Helper()
 	ctrl := gomock.NewController(t)
@@ -27,7 +31,7 @@ func InitBooksTest(t *testing.T) (*gomock.Controller, *mocks.MockBooksUseCase, *
 	logger, e := zap.NewProduction()
 	require.NoError(t, e)
 
-	service := New(logger, booksUseCase, nil)
+	service := New(logger, UseCases{Books: booksUseCase})
 	return ctrl, booksUseCase, service
 }
 
@@ -38,10 +42,87 @@ func InitAuthorTest(t *testing.T) (*gomock.Controller, *mocks.MockAuthorUseCase,
 	logger, e := zap.NewProduction()
 	require.NoError(t, e)
 
-	service := New(logger, nil, authorUseCase)
+	service := New(logger, UseCases{Author: authorUseCase})
 	return ctrl, authorUseCase, service
 }
 
+func InitPublisherTest(t *testing.T) (*gomock.Controller, *mocks.MockPublisherUseCase, *implementation) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	publisherUseCase := mocks.NewMockPublisherUseCase(ctrl)
+	logger, e := zap.NewProduction()
+	require.NoError(t, e)
+
+	service := New(logger, UseCases{Publisher: publisherUseCase})
+	return ctrl, publisherUseCase, service
+}
+
+func InitWorkTest(t *testing.T) (*gomock.Controller, *mocks.MockWorkUseCase, *implementation) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	workUseCase := mocks.NewMockWorkUseCase(ctrl)
+	logger, e := zap.NewProduction()
+	require.NoError(t, e)
+
+	service := New(logger, UseCases{Work: workUseCase})
+	return ctrl, workUseCase, service
+}
+
+func InitCoverTest(t *testing.T) (*gomock.Controller, *mocks.MockCoverUseCase, *implementation) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	coverUseCase := mocks.NewMockCoverUseCase(ctrl)
+	logger, e := zap.NewProduction()
+	require.NoError(t, e)
+
+	service := New(logger, UseCases{Cover: coverUseCase})
+	return ctrl, coverUseCase, service
+}
+
+func InitRelationTest(t *testing.T) (*gomock.Controller, *mocks.MockRelationUseCase, *implementation) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	relationUseCase := mocks.NewMockRelationUseCase(ctrl)
+	logger, e := zap.NewProduction()
+	require.NoError(t, e)
+
+	service := New(logger, UseCases{Relation: relationUseCase})
+	return ctrl, relationUseCase, service
+}
+
+func InitCopyTest(t *testing.T) (*gomock.Controller, *mocks.MockCopyUseCase, *implementation) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	copyUseCase := mocks.NewMockCopyUseCase(ctrl)
+	logger, e := zap.NewProduction()
+	require.NoError(t, e)
+
+	service := New(logger, UseCases{Copy: copyUseCase})
+	return ctrl, copyUseCase, service
+}
+
+func InitMemberTest(t *testing.T) (*gomock.Controller, *mocks.MockMemberUseCase, *implementation) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	memberUseCase := mocks.NewMockMemberUseCase(ctrl)
+	logger, e := zap.NewProduction()
+	require.NoError(t, e)
+
+	service := New(logger, UseCases{Member: memberUseCase})
+	return ctrl, memberUseCase, service
+}
+
+func InitLoanTest(t *testing.T) (*gomock.Controller, *mocks.MockLoanUseCase, *implementation) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	loanUseCase := mocks.NewMockLoanUseCase(ctrl)
+	logger, e := zap.NewProduction()
+	require.NoError(t, e)
+
+	service := New(logger, UseCases{Loan: loanUseCase})
+	return ctrl, loanUseCase, service
+}
+
 func convertBookCodeToError(code codes.Code) error {
 	switch code {
 	case codes.NotFound:
@@ -63,3 +144,106 @@ func convertAuthorCodeToError(code codes.Code) error {
 		return nil
 	}
 }
+
+func convertPublisherCodeToError(code codes.Code) error {
+	switch code {
+	case codes.NotFound:
+		return entity.ErrPublisherNotFound
+	case codes.FailedPrecondition:
+		return entity.ErrPublisherCycle
+	case codes.Internal:
+		return errInternal
+	default:
+		return nil
+	}
+}
+
+func convertWorkCodeToError(code codes.Code) error {
+	switch code {
+	case codes.NotFound:
+		return entity.ErrWorkNotFound
+	case codes.Internal:
+		return errInternal
+	default:
+		return nil
+	}
+}
+
+func convertCoverCodeToError(code codes.Code) error {
+	switch code {
+	case codes.NotFound:
+		return entity.ErrCoverNotFound
+	case codes.InvalidArgument:
+		return entity.ErrInvalidCover
+	case codes.Internal:
+		return errInternal
+	default:
+		return nil
+	}
+}
+
+func InitHoldTest(t *testing.T) (*gomock.Controller, *mocks.MockHoldUseCase, *implementation) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	holdUseCase := mocks.NewMockHoldUseCase(ctrl)
+	logger, e := zap.NewProduction()
+	require.NoError(t, e)
+
+	service := New(logger, UseCases{Hold: holdUseCase})
+	return ctrl, holdUseCase, service
+}
+
+func InitBranchTest(t *testing.T) (*gomock.Controller, *mocks.MockBranchUseCase, *implementation) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	branchUseCase := mocks.NewMockBranchUseCase(ctrl)
+	logger, e := zap.NewProduction()
+	require.NoError(t, e)
+
+	service := New(logger, UseCases{Branch: branchUseCase})
+	return ctrl, branchUseCase, service
+}
+
+func InitReviewTest(t *testing.T) (*gomock.Controller, *mocks.MockReviewUseCase, *implementation) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	reviewUseCase := mocks.NewMockReviewUseCase(ctrl)
+	logger, e := zap.NewProduction()
+	require.NoError(t, e)
+
+	service := New(logger, UseCases{Review: reviewUseCase})
+	return ctrl, reviewUseCase, service
+}
+
+func InitReadingListTest(t *testing.T) (*gomock.Controller, *mocks.MockReadingListUseCase, *implementation) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	readingListUseCase := mocks.NewMockReadingListUseCase(ctrl)
+	logger, e := zap.NewProduction()
+	require.NoError(t, e)
+
+	service := New(logger, UseCases{ReadingList: readingListUseCase})
+	return ctrl, readingListUseCase, service
+}
+
+func InitRecommendationTest(t *testing.T) (*gomock.Controller, *mocks.MockRecommendationUseCase, *implementation) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	recommendationUseCase := mocks.NewMockRecommendationUseCase(ctrl)
+	logger, e := zap.NewProduction()
+	require.NoError(t, e)
+
+	service := New(logger, UseCases{Recommendation: recommendationUseCase})
+	return ctrl, recommendationUseCase, service
+}
+
+func InitStatsTest(t *testing.T) (*gomock.Controller, *mocks.MockStatsUseCase, *implementation) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	statsUseCase := mocks.NewMockStatsUseCase(ctrl)
+	logger, e := zap.NewProduction()
+	require.NoError(t, e)
+
+	service := New(logger, UseCases{Stats: statsUseCase})
+	return ctrl, statsUseCase, service
+}
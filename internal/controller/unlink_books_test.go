@@ -0,0 +1,119 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnlinkBooks(t *testing.T) {
+	t.Parallel()
+
+	sameID := uuid.NewString()
+	tests := []struct {
+		name         string
+		request      *library.UnlinkBooksRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid unlink",
+			request: &library.UnlinkBooksRequest{
+				BookId:        uuid.NewString(),
+				Type:          library.BookRelationType_BOOK_RELATION_TYPE_TRANSLATION_OF,
+				RelatedBookId: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Valid unlink of inverse type",
+			request: &library.UnlinkBooksRequest{
+				BookId:        uuid.NewString(),
+				Type:          library.BookRelationType_BOOK_RELATION_TYPE_CONTAINS,
+				RelatedBookId: uuid.NewString()},
+			codeResponse: codes.OK},
+
+		{name: "Invalid book id",
+			request: &library.UnlinkBooksRequest{
+				BookId:        "123",
+				Type:          library.BookRelationType_BOOK_RELATION_TYPE_SEQUEL_OF,
+				RelatedBookId: uuid.NewString()},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid related book id",
+			request: &library.UnlinkBooksRequest{
+				BookId:        uuid.NewString(),
+				Type:          library.BookRelationType_BOOK_RELATION_TYPE_SEQUEL_OF,
+				RelatedBookId: "123"},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unspecified type",
+			request: &library.UnlinkBooksRequest{
+				BookId:        uuid.NewString(),
+				RelatedBookId: uuid.NewString()},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown type",
+			request: &library.UnlinkBooksRequest{
+				BookId:        uuid.NewString(),
+				Type:          100,
+				RelatedBookId: uuid.NewString()},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unlink from itself",
+			request: &library.UnlinkBooksRequest{
+				BookId:        sameID,
+				Type:          library.BookRelationType_BOOK_RELATION_TYPE_COMMENTARY_ON,
+				RelatedBookId: sameID},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown book",
+			request: &library.UnlinkBooksRequest{
+				BookId:        uuid.NewString(),
+				Type:          library.BookRelationType_BOOK_RELATION_TYPE_ADAPTATION_OF,
+				RelatedBookId: uuid.NewString()},
+			codeResponse: codes.NotFound,
+			useCaseErr:   entity.ErrBookNotFound},
+
+		{name: "Unknown relation",
+			request: &library.UnlinkBooksRequest{
+				BookId:        uuid.NewString(),
+				Type:          library.BookRelationType_BOOK_RELATION_TYPE_PART_OF,
+				RelatedBookId: uuid.NewString()},
+			codeResponse: codes.NotFound,
+			useCaseErr:   entity.ErrRelationNotFound},
+
+		{name: "Internal error",
+			request: &library.UnlinkBooksRequest{
+				BookId:        uuid.NewString(),
+				Type:          library.BookRelationType_BOOK_RELATION_TYPE_SEQUEL_OF,
+				RelatedBookId: uuid.NewString()},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockRelationUseCase, s := InitRelationTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockRelationUseCase.EXPECT().UnlinkBooks(ctx, req.GetBookId(), req.GetRelatedBookId(), req.GetType()).Return(test.useCaseErr)
+			}
+
+			response, err := s.UnlinkBooks(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.NotNil(t, response)
+		})
+	}
+}
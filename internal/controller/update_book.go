@@ -17,7 +17,7 @@ func (i *implementation) UpdateBook(ctx context.Context, req *library.UpdateBook
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	err := i.booksUseCase.UpdateBook(ctx, req.GetId(), req.GetName(), req.GetAuthorIds())
+	err := i.booksUseCase.UpdateBook(ctx, req.GetId(), req.GetName(), req.GetAuthorIds(), req.GetContributors(), req.GetPublisherId())
 
 	if err != nil {
 		return nil, i.convertErr(err)
@@ -27,12 +27,37 @@ func TestUpdateBook(t *testing.T) {
 				AuthorIds: []string{uuid.NewString()}},
 			codeResponse: codes.OK},
 
+		{name: "Valid update with contributors",
+			request: &library.UpdateBookRequest{
+				Id:   uuid.NewString(),
+				Name: validBookName,
+				Contributors: []*library.Contributor{
+					{AuthorId: uuid.NewString(), Role: library.ContributorRole_CONTRIBUTOR_ROLE_ILLUSTRATOR},
+				}},
+			codeResponse: codes.OK},
+
 		{name: "Invalid id",
 			request: &library.UpdateBookRequest{
 				Id:   "123",
 				Name: validBookName},
 			codeResponse: codes.InvalidArgument},
 
+		{name: "Invalid contributor role",
+			request: &library.UpdateBookRequest{
+				Id:   uuid.NewString(),
+				Name: validBookName,
+				Contributors: []*library.Contributor{
+					{AuthorId: uuid.NewString(), Role: library.ContributorRole(42)},
+				}},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid publisher id",
+			request: &library.UpdateBookRequest{
+				Id:          uuid.NewString(),
+				Name:        validBookName,
+				PublisherId: "123"},
+			codeResponse: codes.InvalidArgument},
+
 		{name: "Book with unknown authors",
 			request: &library.UpdateBookRequest{
 				Id:        uuid.NewString(),
@@ -40,6 +65,13 @@ func TestUpdateBook(t *testing.T) {
 				AuthorIds: []string{uuid.NewString()}},
 			codeResponse: codes.NotFound},
 
+		{name: "Book with unknown publisher",
+			request: &library.UpdateBookRequest{
+				Id:          uuid.NewString(),
+				Name:        validBookName,
+				PublisherId: uuid.NewString()},
+			codeResponse: codes.NotFound},
+
 		{name: "Unknown book",
 			request: &library.UpdateBookRequest{
 				Id:   uuid.NewString(),
@@ -63,9 +95,8 @@ func TestUpdateBook(t *testing.T) {
 			code := test.codeResponse
 
 			if code != codes.InvalidArgument {
-				mockBooksUseCase.EXPECT().UpdateBook(ctx, req.GetId(), req.GetName(), req.GetAuthorIds()).DoAndReturn(func(ctx context.Context, id, name string, ids []string) error {
-					return convertBookCodeToError(code)
-				})
+				mockBooksUseCase.EXPECT().UpdateBook(ctx, req.GetId(), req.GetName(), req.GetAuthorIds(), req.GetContributors(), req.GetPublisherId()).
+					Return(convertBookCodeToError(code))
 			}
 			_, err := s.UpdateBook(ctx, req)
 			require.Equal(t, status.Code(err), code)
@@ -0,0 +1,47 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/pkg/logger"
+
+	"go.uber.org/zap"
+
+	"github.com/project/library/generated/api/library"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) UpdateCopy(ctx context.Context, req *library.UpdateCopyRequest) (*library.UpdateCopyResponse, error) {
+	if err := req.ValidateAll(); logger.CheckError(err, i.logger, "Got invalid request", zap.Any("request", req), zap.Error(err)) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.GetStatus() == library.CopyStatus_COPY_STATUS_ON_LOAN {
+		return nil, status.Error(codes.InvalidArgument, "copy can be put on loan only by checkout")
+	}
+
+	if req.GetStatus() == library.CopyStatus_COPY_STATUS_ON_HOLD {
+		return nil, status.Error(codes.InvalidArgument, "copy can be kept for hold only by return")
+	}
+
+	if req.GetStatus() == library.CopyStatus_COPY_STATUS_IN_TRANSIT {
+		return nil, status.Error(codes.InvalidArgument, "copy can be sent to another branch only by transfer")
+	}
+
+	err := i.copyUseCase.UpdateCopy(ctx, &library.Copy{
+		Id:              req.GetId(),
+		Barcode:         req.GetBarcode(),
+		Location:        req.GetLocation(),
+		AcquisitionDate: req.GetAcquisitionDate(),
+		Condition:       req.GetCondition(),
+		Status:          req.GetStatus(),
+		HomeBranchId:    req.GetHomeBranchId(),
+	})
+
+	if err != nil {
+		return nil, i.convertErr(err)
+	}
+
+	return &library.UpdateCopyResponse{}, nil
+}
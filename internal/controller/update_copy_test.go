@@ -0,0 +1,135 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUpdateCopy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.UpdateCopyRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid update copy",
+			request: &library.UpdateCopyRequest{
+				Id:        uuid.NewString(),
+				Barcode:   "LIB-0001",
+				Location:  "B-1",
+				Condition: library.CopyCondition_COPY_CONDITION_FAIR,
+				Status:    library.CopyStatus_COPY_STATUS_LOST},
+			codeResponse: codes.OK},
+
+		{name: "Invalid id",
+			request: &library.UpdateCopyRequest{
+				Id:        "123",
+				Barcode:   "LIB-0001",
+				Condition: library.CopyCondition_COPY_CONDITION_FAIR,
+				Status:    library.CopyStatus_COPY_STATUS_AVAILABLE},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unspecified status is kept",
+			request: &library.UpdateCopyRequest{
+				Id:        uuid.NewString(),
+				Barcode:   "LIB-0001",
+				Condition: library.CopyCondition_COPY_CONDITION_FAIR},
+			codeResponse: codes.OK},
+
+		{name: "Copy on loan",
+			request: &library.UpdateCopyRequest{
+				Id:        uuid.NewString(),
+				Barcode:   "LIB-0001",
+				Condition: library.CopyCondition_COPY_CONDITION_FAIR,
+				Status:    library.CopyStatus_COPY_STATUS_AVAILABLE},
+			codeResponse: codes.FailedPrecondition,
+			useCaseErr:   entity.ErrCopyNotAvailable},
+
+		{name: "Status on loan",
+			request: &library.UpdateCopyRequest{
+				Id:        uuid.NewString(),
+				Barcode:   "LIB-0001",
+				Condition: library.CopyCondition_COPY_CONDITION_FAIR,
+				Status:    library.CopyStatus_COPY_STATUS_ON_LOAN},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Status on hold",
+			request: &library.UpdateCopyRequest{
+				Id:        uuid.NewString(),
+				Barcode:   "LIB-0001",
+				Condition: library.CopyCondition_COPY_CONDITION_FAIR,
+				Status:    library.CopyStatus_COPY_STATUS_ON_HOLD},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Status in transit",
+			request: &library.UpdateCopyRequest{
+				Id:        uuid.NewString(),
+				Barcode:   "LIB-0001",
+				Condition: library.CopyCondition_COPY_CONDITION_FAIR,
+				Status:    library.CopyStatus_COPY_STATUS_IN_TRANSIT},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown condition",
+			request: &library.UpdateCopyRequest{
+				Id:        uuid.NewString(),
+				Barcode:   "LIB-0001",
+				Condition: 100,
+				Status:    library.CopyStatus_COPY_STATUS_AVAILABLE},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown copy",
+			request: &library.UpdateCopyRequest{
+				Id:        uuid.NewString(),
+				Barcode:   "LIB-0001",
+				Condition: library.CopyCondition_COPY_CONDITION_FAIR,
+				Status:    library.CopyStatus_COPY_STATUS_WITHDRAWN},
+			codeResponse: codes.NotFound,
+			useCaseErr:   entity.ErrCopyNotFound},
+
+		{name: "Existing barcode",
+			request: &library.UpdateCopyRequest{
+				Id:        uuid.NewString(),
+				Barcode:   "LIB-0002",
+				Condition: library.CopyCondition_COPY_CONDITION_FAIR,
+				Status:    library.CopyStatus_COPY_STATUS_AVAILABLE},
+			codeResponse: codes.AlreadyExists,
+			useCaseErr:   entity.ErrCopyAlreadyExists},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockCopyUseCase, s := InitCopyTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockCopyUseCase.EXPECT().UpdateCopy(ctx, &library.Copy{
+					Id:        req.GetId(),
+					Barcode:   req.GetBarcode(),
+					Location:  req.GetLocation(),
+					Condition: req.GetCondition(),
+					Status:    req.GetStatus(),
+				}).Return(test.useCaseErr)
+			}
+
+			response, err := s.UpdateCopy(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.NotNil(t, response)
+		})
+	}
+}
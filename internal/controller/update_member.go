@@ -0,0 +1,37 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/project/library/pkg/logger"
+
+	"go.uber.org/zap"
+
+	"github.com/project/library/generated/api/library"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *implementation) UpdateMember(ctx context.Context, req *library.UpdateMemberRequest) (*library.UpdateMemberResponse, error) {
+	if err := req.ValidateAll(); logger.CheckError(err, i.logger, "Got invalid request", zap.Any("request", req), zap.Error(err)) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err := i.memberUseCase.UpdateMember(ctx, &library.Member{
+		Id:             req.GetId(),
+		CardNumber:     req.GetCardNumber(),
+		Name:           req.GetName(),
+		Email:          req.GetEmail(),
+		Phone:          req.GetPhone(),
+		Address:        req.GetAddress(),
+		MembershipType: req.GetMembershipType(),
+		ExpiryDate:     req.GetExpiryDate(),
+		Status:         req.GetStatus(),
+	})
+
+	if err != nil {
+		return nil, i.convertErr(err)
+	}
+
+	return &library.UpdateMemberResponse{}, nil
+}
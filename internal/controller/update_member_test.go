@@ -0,0 +1,93 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestUpdateMember(t *testing.T) {
+	t.Parallel()
+
+	lastYear := timestamppb.New(time.Now().AddDate(-1, 0, 0))
+	validRequest := func() *library.UpdateMemberRequest {
+		return &library.UpdateMemberRequest{
+			Id:             uuid.NewString(),
+			CardNumber:     "C-0001",
+			Name:           "Ivan Petrov",
+			MembershipType: library.MembershipType_MEMBERSHIP_TYPE_SENIOR,
+			ExpiryDate:     lastYear,
+			Status:         library.MemberStatus_MEMBER_STATUS_SUSPENDED,
+		}
+	}
+
+	tests := []struct {
+		name         string
+		modify       func(req *library.UpdateMemberRequest)
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid update member",
+			codeResponse: codes.OK},
+
+		{name: "Invalid id",
+			modify:       func(req *library.UpdateMemberRequest) { req.Id = "123" },
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unspecified status",
+			modify:       func(req *library.UpdateMemberRequest) { req.Status = 0 },
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown membership type",
+			modify:       func(req *library.UpdateMemberRequest) { req.MembershipType = 100 },
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown member",
+			codeResponse: codes.NotFound,
+			useCaseErr:   entity.ErrMemberNotFound},
+
+		{name: "Existing card number",
+			codeResponse: codes.AlreadyExists,
+			useCaseErr:   entity.ErrMemberAlreadyExists},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockMemberUseCase, s := InitMemberTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := validRequest()
+			if test.modify != nil {
+				test.modify(req)
+			}
+			if code != codes.InvalidArgument {
+				mockMemberUseCase.EXPECT().UpdateMember(ctx, &library.Member{
+					Id:             req.GetId(),
+					CardNumber:     req.GetCardNumber(),
+					Name:           req.GetName(),
+					MembershipType: req.GetMembershipType(),
+					ExpiryDate:     req.GetExpiryDate(),
+					Status:         req.GetStatus(),
+				}).Return(test.useCaseErr)
+			}
+
+			response, err := s.UpdateMember(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.NotNil(t, response)
+		})
+	}
+}
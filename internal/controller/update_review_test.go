@@ -0,0 +1,80 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUpdateReview(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		request      *library.UpdateReviewRequest
+		codeResponse codes.Code
+		useCaseErr   error
+	}{
+		{name: "Valid update",
+			request:      &library.UpdateReviewRequest{Id: uuid.NewString(), MemberId: uuid.NewString(), Rating: 4, Text: "Better on second read"},
+			codeResponse: codes.OK},
+
+		{name: "Invalid id",
+			request:      &library.UpdateReviewRequest{Id: "123", MemberId: uuid.NewString(), Rating: 4},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid member id",
+			request:      &library.UpdateReviewRequest{Id: uuid.NewString(), MemberId: "456", Rating: 4},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid rating",
+			request:      &library.UpdateReviewRequest{Id: uuid.NewString(), MemberId: uuid.NewString(), Rating: 10},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Review of another member",
+			request:      &library.UpdateReviewRequest{Id: uuid.NewString(), MemberId: uuid.NewString(), Rating: 4},
+			codeResponse: codes.NotFound,
+			useCaseErr:   entity.ErrReviewNotFound},
+
+		{name: "Internal error",
+			request:      &library.UpdateReviewRequest{Id: uuid.NewString(), MemberId: uuid.NewString(), Rating: 4},
+			codeResponse: codes.Internal,
+			useCaseErr:   errInternal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, mockReviewUseCase, s := InitReviewTest(t)
+			ctx := context.Background()
+			code := test.codeResponse
+			req := test.request
+			if code != codes.InvalidArgument {
+				mockReviewUseCase.EXPECT().UpdateReview(ctx, req.GetId(), req.GetMemberId(), req.GetRating(), req.GetText()).DoAndReturn(
+					func(_ context.Context, id, _ string, rating uint32, text string) (*library.UpdateReviewResponse, error) {
+						if test.useCaseErr != nil {
+							return nil, test.useCaseErr
+						}
+						return &library.UpdateReviewResponse{
+							Review: &library.Review{Id: id, Rating: rating, Text: text},
+						}, nil
+					})
+			}
+
+			response, err := s.UpdateReview(ctx, req)
+			require.Equal(t, status.Code(err), code)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, req.GetText(), response.GetReview().GetText())
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// coverReader reads the cover image from chunks of upload stream.
+type coverReader struct {
+	stream library.Library_UploadBookCoverServer
+	chunk  []byte
+}
+
+func (r *coverReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+
+		if err = req.ValidateAll(); err != nil {
+			return 0, fmt.Errorf("%s: %w", err.Error(), entity.ErrInvalidCover)
+		}
+		r.chunk = req.GetChunk()
+	}
+
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	return n, nil
+}
+
+func (i *implementation) UploadBookCover(stream library.Library_UploadBookCoverServer) error {
+	req, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return status.Error(codes.InvalidArgument, "empty upload")
+	}
+	if logger.CheckError(err, i.logger, "Receiving error", zap.Error(err)) {
+		return err
+	}
+
+	if err = req.ValidateAll(); logger.CheckError(err, i.logger, "Got invalid request", zap.String("book id", req.GetBookId()), zap.Error(err)) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.GetBookId() == "" {
+		return status.Error(codes.InvalidArgument, "book_id must be set in the first message")
+	}
+
+	response, err := i.coverUseCase.UploadBookCover(stream.Context(), req.GetBookId(), &coverReader{
+		stream: stream,
+		chunk:  req.GetChunk(),
+	})
+
+	if err != nil {
+		return i.convertErr(err)
+	}
+
+	return stream.SendAndClose(response)
+}
@@ -0,0 +1,103 @@
+package controller
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUploadBookCover(t *testing.T) {
+	t.Parallel()
+
+	bookID := uuid.NewString()
+	tests := []struct {
+		name         string
+		requests     []*library.UploadBookCoverRequest
+		codeResponse codes.Code
+	}{
+		{name: "Valid upload",
+			requests: []*library.UploadBookCoverRequest{
+				{BookId: bookID, Chunk: []byte("ima")},
+				{Chunk: []byte("ge")}},
+			codeResponse: codes.OK},
+
+		{name: "Empty upload",
+			requests:     []*library.UploadBookCoverRequest{},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "No book id in first message",
+			requests: []*library.UploadBookCoverRequest{
+				{Chunk: []byte("image")}},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid book id",
+			requests: []*library.UploadBookCoverRequest{
+				{BookId: "123", Chunk: []byte("image")}},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Invalid image",
+			requests: []*library.UploadBookCoverRequest{
+				{BookId: bookID, Chunk: []byte("image")}},
+			codeResponse: codes.InvalidArgument},
+
+		{name: "Unknown book",
+			requests: []*library.UploadBookCoverRequest{
+				{BookId: bookID, Chunk: []byte("image")}},
+			codeResponse: codes.NotFound},
+
+		{name: "Internal error",
+			requests: []*library.UploadBookCoverRequest{
+				{BookId: bookID, Chunk: []byte("image")}},
+			codeResponse: codes.Internal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl, mockCoverUseCase, s := InitCoverTest(t)
+			mockServer := mocks.NewMockLibrary_UploadBookCoverServer(ctrl)
+			ctx := context.Background()
+			code := test.codeResponse
+			requests := test.requests
+			response := &library.UploadBookCoverResponse{Hash: "hash", ContentType: "image/png"}
+
+			mockServer.EXPECT().Recv().DoAndReturn(func() (*library.UploadBookCoverRequest, error) {
+				if len(requests) == 0 {
+					return nil, io.EOF
+				}
+				req := requests[0]
+				requests = requests[1:]
+				return req, nil
+			}).AnyTimes()
+
+			if code != codes.InvalidArgument || test.name == "Invalid image" {
+				mockServer.EXPECT().Context().Return(ctx)
+				mockCoverUseCase.EXPECT().UploadBookCover(ctx, bookID, gomock.Any()).DoAndReturn(func(ctx context.Context, id string, cover io.Reader) (*library.UploadBookCoverResponse, error) {
+					data, err := io.ReadAll(cover)
+					require.NoError(t, err)
+					require.Equal(t, []byte("image"), data)
+
+					if code != codes.OK {
+						return nil, convertCoverCodeToError(code)
+					}
+					return response, nil
+				})
+			}
+			if code == codes.OK {
+				mockServer.EXPECT().SendAndClose(response).Return(nil)
+			}
+
+			err := s.UploadBookCover(mockServer)
+			require.Equal(t, status.Code(err), code)
+		})
+	}
+}
@@ -1,19 +1,113 @@
 package controller
 
 import (
+	"context"
 	"errors"
+	"strings"
 
 	"github.com/project/library/internal/entity"
+	"golang.org/x/text/language"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
+// acceptLanguageKeys are metadata keys of Accept-Language header of gRPC and of gateway requests.
+var acceptLanguageKeys = []string{"accept-language", "grpcgateway-accept-language"}
+
+// acceptLanguage returns requested languages, if they are defined in request, else the languages from header.
+func acceptLanguage(ctx context.Context, requested string) (string, error) {
+	if requested != "" {
+		_, _, err := language.ParseAcceptLanguage(requested)
+		return requested, err
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, key := range acceptLanguageKeys {
+		if values := md.Get(key); len(values) > 0 {
+			return strings.Join(values, ","), nil
+		}
+	}
+
+	return "", nil
+}
+
 func (i *implementation) convertErr(err error) error {
 	switch {
 	case errors.Is(err, entity.ErrAuthorNotFound):
 		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrInvalidLifeDates):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entity.ErrCollaborationPathNotFound):
+		return status.Error(codes.NotFound, err.Error())
 	case errors.Is(err, entity.ErrBookNotFound):
 		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrPublisherNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrPublisherCycle):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, entity.ErrWorkNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrLocalizationNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrCoverNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrInvalidCover):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entity.ErrInvalidImport):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entity.ErrRelationNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrRelationCycle):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, entity.ErrCopyNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrCopyAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, entity.ErrMemberNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrMemberAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, entity.ErrLoanNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrCopyNotAvailable):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, entity.ErrCopyHasLoans):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, entity.ErrMemberCannotBorrow):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, entity.ErrFineOverpaid):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, entity.ErrRenewalNotAllowed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, entity.ErrHoldNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrHoldAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, entity.ErrBookAvailable):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, entity.ErrBranchNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrBranchAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, entity.ErrTransferNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrTransferAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, entity.ErrTransferNotAllowed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, entity.ErrReviewNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrReviewAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, entity.ErrReadingListNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrReadingListAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, entity.ErrReadingListBookNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrReadingListBookExists):
+		return status.Error(codes.AlreadyExists, err.Error())
 	default:
 		return status.Error(codes.Internal, err.Error())
 	}
@@ -6,13 +6,38 @@ import (
 )
 
 type Author struct {
-	ID        string
-	Name      string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID          string
+	Name        string
+	BirthDate   *time.Time
+	DeathDate   *time.Time
+	Nationality string
+	Biography   string
+	Aliases     []string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Fields of Author which can be changed separately.
+const (
+	AuthorFieldName        = "name"
+	AuthorFieldBirthDate   = "birth_date"
+	AuthorFieldDeathDate   = "death_date"
+	AuthorFieldNationality = "nationality"
+	AuthorFieldBiography   = "biography"
+	AuthorFieldAliases     = "aliases"
+)
+
+var AuthorFields = []string{
+	AuthorFieldName,
+	AuthorFieldBirthDate,
+	AuthorFieldDeathDate,
+	AuthorFieldNationality,
+	AuthorFieldBiography,
+	AuthorFieldAliases,
 }
 
 var (
 	ErrAuthorNotFound      = errors.New("author not found")
 	ErrAuthorAlreadyExists = errors.New("author already exists")
+	ErrInvalidLifeDates    = errors.New("death date can not be before birth date")
 )
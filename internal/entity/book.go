@@ -1,19 +1,108 @@
 package entity
 
 import (
+	"bytes"
 	"errors"
 	"time"
 )
 
+type ContributorRole string
+
+const (
+	RoleAuthor      ContributorRole = "AUTHOR"
+	RoleEditor      ContributorRole = "EDITOR"
+	RoleTranslator  ContributorRole = "TRANSLATOR"
+	RoleIllustrator ContributorRole = "ILLUSTRATOR"
+)
+
+type Contributor struct {
+	AuthorID string
+	Role     ContributorRole
+}
+
 type Book struct {
-	ID        string
-	Name      string
-	AuthorIDs []string
+	ID   string
+	Name string
+	// AuthorIDs are ids of Contributors with RoleAuthor
+	AuthorIDs    []string
+	Contributors []Contributor
+	PublisherID  string
+	WorkID       string
+	// ISBN is ISBN-13 without separators, empty if it is unknown
+	ISBN string
+	// PublicationYear is zero if it is unknown
+	PublicationYear int16
+	// Localizations are titles and descriptions of the book in other languages
+	Localizations []Localization
+	// Subjects are in lower case and ordered alphabetically
+	Subjects  []string
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
 
+// Localization is a title and a description of the book in the language with BCP 47 tag.
+type Localization struct {
+	BookID      string
+	Language    string
+	Title       string
+	Description string
+}
+
 var (
 	ErrBookNotFound      = errors.New("book not found")
 	ErrBookAlreadyExists = errors.New("book already exists")
+	ErrInvalidISBN       = errors.New("invalid ISBN")
+
+	ErrLocalizationNotFound = errors.New("localization not found")
 )
+
+// NormalizeISBN checks the check digit of ISBN-10 or ISBN-13, separated by hyphens or spaces or not,
+// and returns it as ISBN-13 without separators.
+func NormalizeISBN(isbn string) (string, error) {
+	digits := make([]byte, 0, len(isbn))
+	for i := 0; i < len(isbn); i++ {
+		switch c := isbn[i]; {
+		case c == '-' || c == ' ':
+		case c >= '0' && c <= '9', (c == 'X' || c == 'x') && len(digits) == 9:
+			digits = append(digits, c)
+		default:
+			return "", ErrInvalidISBN
+		}
+	}
+
+	switch len(digits) {
+	case 10:
+		sum := 0
+		for i, c := range digits {
+			d := int(c - '0')
+			if c == 'X' || c == 'x' {
+				d = 10
+			}
+			sum += (10 - i) * d
+		}
+		if sum%11 != 0 {
+			return "", ErrInvalidISBN
+		}
+		isbn13 := append([]byte("978"), digits[:9]...)
+		return string(append(isbn13, isbn13CheckDigit(isbn13))), nil
+	case 13:
+		if bytes.ContainsAny(digits, "Xx") || isbn13CheckDigit(digits[:12]) != digits[12] {
+			return "", ErrInvalidISBN
+		}
+		return string(digits), nil
+	default:
+		return "", ErrInvalidISBN
+	}
+}
+
+func isbn13CheckDigit(digits []byte) byte {
+	sum := 0
+	for i, c := range digits {
+		weight := 1
+		if i%2 == 1 {
+			weight = 3
+		}
+		sum += weight * int(c-'0')
+	}
+	return byte('0' + (10-sum%10)%10)
+}
@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+type Branch struct {
+	ID        string
+	Name      string
+	Address   string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type TransferStatus string
+
+const (
+	TransferRequested TransferStatus = "REQUESTED"
+	TransferInTransit TransferStatus = "IN_TRANSIT"
+	TransferReceived  TransferStatus = "RECEIVED"
+	TransferCancelled TransferStatus = "CANCELLED"
+)
+
+// Transfer moves the copy to another branch, FromBranchID is empty if the copy was not in any branch.
+type Transfer struct {
+	ID           string
+	CopyID       string
+	FromBranchID string
+	ToBranchID   string
+	Status       TransferStatus
+	RequestedAt  time.Time
+	ShippedAt    *time.Time
+	ReceivedAt   *time.Time
+}
+
+// TransferFilter selects transfers from or to the branch, empty fields are not used.
+type TransferFilter struct {
+	BranchID string
+	Status   TransferStatus
+}
+
+var (
+	ErrBranchNotFound        = errors.New("branch not found")
+	ErrBranchAlreadyExists   = errors.New("branch with this name already exists")
+	ErrTransferNotFound      = errors.New("transfer not found")
+	ErrTransferAlreadyExists = errors.New("copy already has an open transfer")
+	ErrTransferNotAllowed    = errors.New("transfer is not allowed")
+)
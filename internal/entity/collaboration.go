@@ -0,0 +1,22 @@
+package entity
+
+import "errors"
+
+// Collaborator is the author reachable from another author through books they share,
+// Depth is the length of the shortest such path.
+type Collaborator struct {
+	AuthorID    string
+	Name        string
+	Depth       int64
+	SharedBooks int64
+}
+
+// CollaborationStep is the author of the collaboration path and the book
+// shared with the previous author of the path.
+type CollaborationStep struct {
+	AuthorID string
+	Name     string
+	BookID   string
+}
+
+var ErrCollaborationPathNotFound = errors.New("authors are not connected by collaborations")
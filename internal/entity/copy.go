@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+type CopyCondition string
+
+const (
+	ConditionNew     CopyCondition = "NEW"
+	ConditionGood    CopyCondition = "GOOD"
+	ConditionFair    CopyCondition = "FAIR"
+	ConditionPoor    CopyCondition = "POOR"
+	ConditionDamaged CopyCondition = "DAMAGED"
+)
+
+type CopyStatus string
+
+const (
+	CopyAvailable CopyStatus = "AVAILABLE"
+	CopyOnLoan    CopyStatus = "ON_LOAN"
+	CopyLost      CopyStatus = "LOST"
+	CopyWithdrawn CopyStatus = "WITHDRAWN"
+	CopyOnHold    CopyStatus = "ON_HOLD"
+	CopyInTransit CopyStatus = "IN_TRANSIT"
+)
+
+// Copy is a physical item of the book on the shelf.
+// HomeBranchID is the branch the copy belongs to, CurrentBranchID is the branch it is in now.
+type Copy struct {
+	ID              string
+	BookID          string
+	Barcode         string
+	Location        string
+	AcquisitionDate *time.Time
+	Condition       CopyCondition
+	Status          CopyStatus
+	HomeBranchID    string
+	CurrentBranchID string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// Availability counts copies of the book which are not withdrawn and copies which can be lent now.
+type Availability struct {
+	Total     int
+	Available int
+}
+
+var (
+	ErrCopyNotFound      = errors.New("copy not found")
+	ErrCopyAlreadyExists = errors.New("copy with this barcode already exists")
+)
@@ -0,0 +1,32 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+type CoverSize string
+
+const (
+	CoverSizeOriginal CoverSize = "ORIGINAL"
+	CoverSizeSmall    CoverSize = "SMALL"
+	CoverSizeMedium   CoverSize = "MEDIUM"
+	CoverSizeLarge    CoverSize = "LARGE"
+)
+
+// Cover is an image of the book cover in one of the sizes,
+// the image itself is stored in the file storage by its Hash.
+type Cover struct {
+	BookID      string
+	Size        CoverSize
+	Hash        string
+	ContentType string
+	Width       int
+	Height      int
+	CreatedAt   time.Time
+}
+
+var (
+	ErrCoverNotFound = errors.New("cover not found")
+	ErrInvalidCover  = errors.New("invalid cover")
+)
@@ -0,0 +1,9 @@
+package entity
+
+// ExportedBook is the book of the exported catalog with names of its authors in the order of Book.AuthorIDs
+// and the name of its publisher, empty if the book has no publisher.
+type ExportedBook struct {
+	Book
+	AuthorNames   []string
+	PublisherName string
+}
@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+type HoldStatus string
+
+const (
+	HoldWaiting        HoldStatus = "WAITING"
+	HoldReadyForPickup HoldStatus = "READY_FOR_PICKUP"
+	HoldFulfilled      HoldStatus = "FULFILLED"
+	HoldCancelled      HoldStatus = "CANCELLED"
+	HoldExpired        HoldStatus = "EXPIRED"
+)
+
+// Hold is the place of the member in the queue for the book.
+// When a copy of the book is returned, it is kept for the first waiting hold until ExpiresAt.
+type Hold struct {
+	ID        string
+	BookID    string
+	MemberID  string
+	CopyID    string
+	Status    HoldStatus
+	Position  int
+	PlacedAt  time.Time
+	ReadyAt   *time.Time
+	ExpiresAt *time.Time
+}
+
+// HoldFilter selects active holds of the member and/or the book.
+type HoldFilter struct {
+	MemberID string
+	BookID   string
+}
+
+var (
+	ErrHoldNotFound      = errors.New("active hold not found")
+	ErrHoldAlreadyExists = errors.New("member already has a hold on this book")
+	ErrBookAvailable     = errors.New("book has available copies")
+)
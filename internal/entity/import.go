@@ -0,0 +1,26 @@
+package entity
+
+import "errors"
+
+// ImportBatchSize is the number of rows of the imported catalog inserted in one transaction.
+const ImportBatchSize = 500
+
+// ImportRow is the book of the imported catalog, authors are resolved by their names
+// and created if there are no authors with such names.
+type ImportRow struct {
+	Line        int64
+	Title       string
+	AuthorNames []string
+	ISBN        string
+	// PublicationYear is zero if it is unknown
+	PublicationYear int16
+}
+
+// ImportResult is the id of the book added for the row of the imported catalog or the reason it was skipped.
+type ImportResult struct {
+	Line   int64
+	BookID string
+	Err    error
+}
+
+var ErrInvalidImport = errors.New("invalid import")
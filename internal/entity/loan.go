@@ -0,0 +1,115 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// Loan is the copy lent to the member, ReturnedAt is nil while the loan is active.
+// OverdueAt is set when the loan is found past due, Fine is accrued for it in minor currency units,
+// FinePaid is the part of the fine which is paid or waived.
+// Renewals is the number of times the due date was extended.
+type Loan struct {
+	ID           string
+	CopyID       string
+	BookID       string
+	MemberID     string
+	CheckedOutAt time.Time
+	DueAt        time.Time
+	ReturnedAt   *time.Time
+	OverdueAt    *time.Time
+	Fine         int64
+	FinePaid     int64
+	Renewals     int64
+}
+
+type FinePaymentType string
+
+const (
+	PaymentPaid   FinePaymentType = "PAID"
+	PaymentWaived FinePaymentType = "WAIVED"
+)
+
+// FinePayment is the Amount of the fine of the loan which is paid by the member or waived by the library.
+type FinePayment struct {
+	ID     string
+	LoanID string
+	Type   FinePaymentType
+	Amount int64
+	PaidAt time.Time
+}
+
+// LoanRenewal is the record of the loan history about the extension of the due date.
+type LoanRenewal struct {
+	ID            string
+	LoanID        string
+	RenewedAt     time.Time
+	PreviousDueAt time.Time
+	DueAt         time.Time
+}
+
+// LoanPolicy defines for how long copies are lent to members and kept for their holds.
+type LoanPolicy struct {
+	Period       time.Duration
+	PeriodByType map[MembershipType]time.Duration
+	PickupWindow time.Duration
+	Fines        FinePolicy
+	Renewals     RenewalPolicy
+}
+
+// FinePolicy defines fines for overdue loans, days beyond GraceDays are charged PerDay each,
+// but no more than MaxPerItem for a loan.
+type FinePolicy struct {
+	PerDay     int64
+	GraceDays  int64
+	MaxPerItem int64
+}
+
+// RenewalPolicy defines how many times and for how long loans are renewed.
+// Loans of members who owe more than MaxFines or have overdue loans are not renewed.
+type RenewalPolicy struct {
+	MaxRenewals       int64
+	MaxRenewalsByType map[MembershipType]int64
+	Period            time.Duration
+	PeriodByType      map[MembershipType]time.Duration
+	MaxFines          int64
+}
+
+// Balance is what the member owes the library, OutstandingFines are fines which are not paid or waived.
+type Balance struct {
+	OutstandingFines int64
+	OverdueLoans     []Loan
+}
+
+// LoanPeriod returns the period of loans for members with the membership type.
+func (p LoanPolicy) LoanPeriod(membershipType MembershipType) time.Duration {
+	if period, ok := p.PeriodByType[membershipType]; ok {
+		return period
+	}
+	return p.Period
+}
+
+// Limit returns the maximum number of renewals of a loan for members with the membership type.
+func (p RenewalPolicy) Limit(membershipType MembershipType) int64 {
+	if limit, ok := p.MaxRenewalsByType[membershipType]; ok {
+		return limit
+	}
+	return p.MaxRenewals
+}
+
+// RenewalPeriod returns for how long a loan is extended for members with the membership type.
+func (p RenewalPolicy) RenewalPeriod(membershipType MembershipType) time.Duration {
+	if period, ok := p.PeriodByType[membershipType]; ok {
+		return period
+	}
+	return p.Period
+}
+
+var (
+	ErrLoanNotFound       = errors.New("active loan not found")
+	ErrCopyNotAvailable   = errors.New("copy is not available")
+	ErrCopyHasLoans       = errors.New("copy has loans, withdraw it instead")
+	ErrMemberCannotBorrow = errors.New("member can not borrow books")
+	ErrFineOverpaid       = errors.New("amount exceeds the outstanding fine")
+	ErrRenewalNotAllowed  = errors.New("loan can not be renewed")
+)
@@ -0,0 +1,66 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+type MembershipType string
+
+const (
+	MembershipAdult   MembershipType = "ADULT"
+	MembershipChild   MembershipType = "CHILD"
+	MembershipStudent MembershipType = "STUDENT"
+	MembershipSenior  MembershipType = "SENIOR"
+	MembershipStaff   MembershipType = "STAFF"
+)
+
+// MembershipTypes are all types of membership.
+var MembershipTypes = []MembershipType{
+	MembershipAdult,
+	MembershipChild,
+	MembershipStudent,
+	MembershipSenior,
+	MembershipStaff,
+}
+
+type MemberStatus string
+
+const (
+	MemberActive    MemberStatus = "ACTIVE"
+	MemberSuspended MemberStatus = "SUSPENDED"
+)
+
+// Member is a patron of the library identified by the number of the library card.
+type Member struct {
+	ID         string
+	CardNumber string
+	Name       string
+	Email      string
+	Phone      string
+	Address    string
+	Type       MembershipType
+	ExpiryDate time.Time
+	Status     MemberStatus
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// CanBorrow reports whether the member is active and the membership has not expired at the moment,
+// membership is valid until the end of the expiry date.
+func (m *Member) CanBorrow(now time.Time) bool {
+	return m.Status == MemberActive && now.Before(m.ExpiryDate.AddDate(0, 0, 1))
+}
+
+// MemberFilter selects members ordered by card number, empty fields are not used.
+type MemberFilter struct {
+	Status          MemberStatus
+	Type            MembershipType
+	AfterCardNumber string
+	Limit           int
+}
+
+var (
+	ErrMemberNotFound      = errors.New("member not found")
+	ErrMemberAlreadyExists = errors.New("member with this card number already exists")
+)
@@ -0,0 +1,20 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+type Publisher struct {
+	ID        string
+	Name      string
+	Country   string
+	ParentID  string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+var (
+	ErrPublisherNotFound = errors.New("publisher not found")
+	ErrPublisherCycle    = errors.New("imprints make a cycle")
+)
@@ -0,0 +1,40 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+type ReadingListKind string
+
+const (
+	ReadingListToRead  ReadingListKind = "TO_READ"
+	ReadingListReading ReadingListKind = "READING"
+	ReadingListRead    ReadingListKind = "READ"
+	ReadingListCustom  ReadingListKind = "CUSTOM"
+)
+
+// ReadingList is the shelf of books of the user, a user has one list of each kind except custom ones.
+// Private lists are visible only to their owner.
+type ReadingList struct {
+	ID        string
+	OwnerID   string
+	Name      string
+	Kind      ReadingListKind
+	Public    bool
+	BookCount int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// VisibleTo reports whether the user may see the list.
+func (l ReadingList) VisibleTo(viewerID string) bool {
+	return l.Public || l.OwnerID == viewerID
+}
+
+var (
+	ErrReadingListNotFound      = errors.New("reading list not found")
+	ErrReadingListAlreadyExists = errors.New("reading list with this name or kind already exists")
+	ErrReadingListBookNotFound  = errors.New("book is not in the reading list")
+	ErrReadingListBookExists    = errors.New("book is already in the reading list")
+)
@@ -0,0 +1,42 @@
+package entity
+
+const (
+	// MaxRecommendations is the number of recommendations precomputed for each book.
+	MaxRecommendations = 50
+	// RecommendationBatchSize is the number of books whose recommendations are rebuilt in one transaction.
+	RecommendationBatchSize = 500
+	// MaxRecommendationCandidates is the number of books taken for each author, subject, reading list
+	// or borrower of the book when its recommendations are computed, so popular ones don't blow up the rebuild.
+	MaxRecommendationCandidates = 200
+)
+
+// RecommendationWeights are the points given to a recommended book for each signal shared with the book.
+type RecommendationWeights struct {
+	SharedAuthor  int64
+	SharedSubject int64
+	Relation      int64
+	ReadingList   int64
+	CoBorrower    int64
+}
+
+// DefaultRecommendationWeights prefer the catalog data to the behaviour of readers.
+var DefaultRecommendationWeights = RecommendationWeights{
+	SharedAuthor:  5,
+	SharedSubject: 3,
+	Relation:      4,
+	ReadingList:   2,
+	CoBorrower:    1,
+}
+
+// Recommendation is the book recommended for another book with the signals its score consists of:
+// authors and contributors of both books, subjects of both books, relations between them,
+// reading lists containing both of them and members who borrowed both of them.
+type Recommendation struct {
+	Book           Book
+	SharedAuthors  int64
+	SharedSubjects int64
+	Relations      int64
+	ReadingLists   int64
+	CoBorrowers    int64
+	Score          int64
+}
@@ -0,0 +1,87 @@
+package entity
+
+import "errors"
+
+// RelationType is a relation of the book to the related book.
+type RelationType string
+
+const (
+	RelationSequelOf      RelationType = "SEQUEL_OF"
+	RelationPrequelOf     RelationType = "PREQUEL_OF"
+	RelationAdaptationOf  RelationType = "ADAPTATION_OF"
+	RelationAdaptedAs     RelationType = "ADAPTED_AS"
+	RelationTranslationOf RelationType = "TRANSLATION_OF"
+	RelationTranslatedAs  RelationType = "TRANSLATED_AS"
+	RelationPartOf        RelationType = "PART_OF"
+	RelationContains      RelationType = "CONTAINS"
+	RelationCommentaryOn  RelationType = "COMMENTARY_ON"
+	RelationCommentedBy   RelationType = "COMMENTED_BY"
+)
+
+// forwardRelations maps the stored types of relations to their inverse types.
+var forwardRelations = map[RelationType]RelationType{
+	RelationSequelOf:      RelationPrequelOf,
+	RelationAdaptationOf:  RelationAdaptedAs,
+	RelationTranslationOf: RelationTranslatedAs,
+	RelationPartOf:        RelationContains,
+	RelationCommentaryOn:  RelationCommentedBy,
+}
+
+// Inverse returns the type of relation of the related book to the book.
+func (t RelationType) Inverse() RelationType {
+	if inverse, ok := forwardRelations[t]; ok {
+		return inverse
+	}
+	for forward, inverse := range forwardRelations {
+		if inverse == t {
+			return forward
+		}
+	}
+	return t
+}
+
+// IsForward reports whether relations of the type are stored as is,
+// relations of other types are stored as their inverses.
+func (t RelationType) IsForward() bool {
+	_, ok := forwardRelations[t]
+	return ok
+}
+
+// IsHierarchical reports whether relations of the type can not make cycles:
+// book can not be a part, a translation, a sequel or an adaptation of itself even through other books.
+func (t RelationType) IsHierarchical() bool {
+	return t != RelationCommentaryOn && t != RelationCommentedBy
+}
+
+// Relation means that the book with BookID is Type of the book with RelatedBookID.
+type Relation struct {
+	BookID        string
+	RelatedBookID string
+	Type          RelationType
+}
+
+// Forward returns the same relation with the stored type.
+func (r Relation) Forward() Relation {
+	if r.Type.IsForward() {
+		return r
+	}
+	return Relation{
+		BookID:        r.RelatedBookID,
+		RelatedBookID: r.BookID,
+		Type:          r.Type.Inverse(),
+	}
+}
+
+// RelatedBook is the Book which is Type of the book with ViaBookID
+// and is Depth relations away from the requested book.
+type RelatedBook struct {
+	Book      Book
+	Type      RelationType
+	ViaBookID string
+	Depth     int
+}
+
+var (
+	ErrRelationNotFound = errors.New("relation not found")
+	ErrRelationCycle    = errors.New("relation makes a cycle")
+)
@@ -0,0 +1,36 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// Review is the rating from 1 to 5 and the text of the member about the book.
+type Review struct {
+	ID        string
+	BookID    string
+	MemberID  string
+	Rating    int64
+	Text      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Rating is the aggregate of reviews of the book.
+type Rating struct {
+	Count int64
+	Total int64
+}
+
+// Average returns the average rating of the book, 0 if the book has no reviews.
+func (r Rating) Average() float64 {
+	if r.Count == 0 {
+		return 0
+	}
+	return float64(r.Total) / float64(r.Count)
+}
+
+var (
+	ErrReviewNotFound      = errors.New("review not found")
+	ErrReviewAlreadyExists = errors.New("member already reviewed the book")
+)
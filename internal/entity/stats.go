@@ -0,0 +1,34 @@
+package entity
+
+import "time"
+
+// StatsInterval is the length of the buckets books added to the library are counted in.
+type StatsInterval string
+
+const (
+	StatsDay   StatsInterval = "day"
+	StatsWeek  StatsInterval = "week"
+	StatsMonth StatsInterval = "month"
+)
+
+// LibraryStats are the totals of the catalog as of RefreshedAt. Only contributors
+// of the AUTHOR role are counted as authors of books.
+type LibraryStats struct {
+	BookCount             int64
+	AuthorCount           int64
+	BooksWithoutAuthors   int64
+	AverageAuthorsPerBook float64
+	RefreshedAt           time.Time
+}
+
+// BooksAdded is the number of books added in the bucket starting at Start.
+type BooksAdded struct {
+	Start time.Time
+	Count int64
+}
+
+type AuthorBookCount struct {
+	AuthorID  string
+	Name      string
+	BookCount int64
+}
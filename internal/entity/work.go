@@ -0,0 +1,16 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+type Work struct {
+	ID        string
+	Name      string
+	AuthorIDs []string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+var ErrWorkNotFound = errors.New("work not found")
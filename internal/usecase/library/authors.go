@@ -2,12 +2,14 @@ package library
 
 import (
 	"context"
+	"time"
 
 	"github.com/project/library/pkg/logger"
 
 	"github.com/project/library/generated/api/library"
 	"github.com/project/library/internal/entity"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (l *libraryImpl) RegisterAuthor(ctx context.Context, authorName string) (*library.RegisterAuthorResponse, error) {
@@ -27,15 +29,20 @@ func (l *libraryImpl) RegisterAuthor(ctx context.Context, authorName string) (*l
 	}, nil
 }
 
-func (l *libraryImpl) ChangeAuthorInfo(ctx context.Context, idAuthor, newName string) error {
+func (l *libraryImpl) ChangeAuthorInfo(ctx context.Context, idAuthor string, newInfo *library.Author, fields []string) error {
 	err := l.authorRepository.ChangeAuthorInfo(ctx, entity.Author{
-		ID:   idAuthor,
-		Name: newName,
-	})
+		ID:          idAuthor,
+		Name:        newInfo.GetName(),
+		BirthDate:   convertDate(newInfo.GetBirthDate()),
+		DeathDate:   convertDate(newInfo.GetDeathDate()),
+		Nationality: newInfo.GetNationality(),
+		Biography:   newInfo.GetBiography(),
+		Aliases:     newInfo.GetAliases(),
+	}, fields)
 
 	if !logger.CheckError(err, l.logger, "Failed changing author", zap.Error(err)) {
 		if l.logger != nil {
-			l.logger.Info("Changed the author with id", zap.String("id of author", idAuthor))
+			l.logger.Info("Changed the author with id", zap.String("id of author", idAuthor), zap.Strings("fields", fields))
 		}
 	}
 	return err
@@ -52,7 +59,60 @@ func (l *libraryImpl) GetAuthorInfo(ctx context.Context, idAuthor string) (*libr
 	}
 
 	return &library.GetAuthorInfoResponse{
-		Id:   author.ID,
-		Name: author.Name,
+		Id:          author.ID,
+		Name:        author.Name,
+		BirthDate:   convertDateToAPI(author.BirthDate),
+		DeathDate:   convertDateToAPI(author.DeathDate),
+		Nationality: author.Nationality,
+		Biography:   author.Biography,
+		Aliases:     author.Aliases,
 	}, err
 }
+
+func (l *libraryImpl) FindAuthors(ctx context.Context, name string) (*library.FindAuthorsResponse, error) {
+	authors, err := l.authorRepository.FindAuthors(ctx, name)
+
+	if logger.CheckError(err, l.logger, "Failed find authors", zap.String("name", name), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Found authors", zap.String("name", name), zap.Int("count", len(authors)))
+	}
+
+	result := make([]*library.Author, len(authors))
+	for i := range authors {
+		result[i] = convertAuthor(&authors[i])
+	}
+
+	return &library.FindAuthorsResponse{
+		Authors: result,
+	}, nil
+}
+
+func convertAuthor(author *entity.Author) *library.Author {
+	return &library.Author{
+		Id:          author.ID,
+		Name:        author.Name,
+		BirthDate:   convertDateToAPI(author.BirthDate),
+		DeathDate:   convertDateToAPI(author.DeathDate),
+		Nationality: author.Nationality,
+		Biography:   author.Biography,
+		Aliases:     author.Aliases,
+	}
+}
+
+// convertDate returns nil for an unknown date.
+func convertDate(date *timestamppb.Timestamp) *time.Time {
+	if date == nil {
+		return nil
+	}
+	result := date.AsTime()
+	return &result
+}
+
+func convertDateToAPI(date *time.Time) *timestamppb.Timestamp {
+	if date == nil {
+		return nil
+	}
+	return timestamppb.New(*date)
+}
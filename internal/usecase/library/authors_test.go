@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/project/library/internal/entity"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var errInternalAuthor = errors.New("internal error")
@@ -27,7 +29,7 @@ func initAuthorTest(t *testing.T) (context.Context, *mocks.MockAuthorRepository,
 	logger, e := zap.NewProduction()
 	require.NoError(t, e)
 
-	auc := New(logger, mockAuthorRepo, nil)
+	auc := New(logger, Repositories{Author: mockAuthorRepo}, Options{})
 	return ctx, mockAuthorRepo, auc
 }
 
@@ -40,7 +42,7 @@ func initAuthorTransactorTest(t *testing.T) (context.Context, *mocks.MockAuthorR
 	logger, e := zap.NewProduction()
 	require.NoError(t, e)
 
-	auc := New(logger, mockAuthorRepo, nil)
+	auc := New(logger, Repositories{Author: mockAuthorRepo}, Options{})
 	return ctx, mockAuthorRepo, auc
 }
 
@@ -115,13 +117,89 @@ func TestChangeAuthorInfo(t *testing.T) {
 			mockAuthorRepo.EXPECT().ChangeAuthorInfo(ctx, entity.Author{
 				ID:   id,
 				Name: name,
-			}).Return(tErr)
-			err := s.ChangeAuthorInfo(ctx, id, name)
+			}, []string{entity.AuthorFieldName}).Return(tErr)
+			err := s.ChangeAuthorInfo(ctx, id, &library.Author{Name: name}, []string{entity.AuthorFieldName})
 			require.Equal(t, tErr, err)
 		})
 	}
 }
 
+func TestChangeAuthorProfile(t *testing.T) {
+	t.Parallel()
+
+	const id = "123"
+	birthDate := time.Date(1828, time.September, 9, 0, 0, 0, 0, time.UTC)
+	fields := []string{entity.AuthorFieldBirthDate, entity.AuthorFieldDeathDate, entity.AuthorFieldAliases}
+
+	ctx, mockAuthorRepo, s := initAuthorTest(t)
+	mockAuthorRepo.EXPECT().ChangeAuthorInfo(ctx, entity.Author{
+		ID:        id,
+		BirthDate: &birthDate,
+		Aliases:   []string{"Leo Tolstoy"},
+	}, fields).Return(nil)
+
+	err := s.ChangeAuthorInfo(ctx, id, &library.Author{
+		BirthDate: timestamppb.New(birthDate),
+		Aliases:   []string{"Leo Tolstoy"},
+	}, fields)
+	require.NoError(t, err)
+}
+
+func TestFindAuthors(t *testing.T) {
+	t.Parallel()
+
+	const name = "Leo Tolstoy"
+	deathDate := time.Date(1910, time.November, 20, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		authors         []entity.Author
+		requireResponse *library.FindAuthorsResponse
+		requireErr      error
+	}{
+		{
+			name: "found by alias",
+			authors: []entity.Author{{
+				ID:          "1",
+				Name:        "Lev Tolstoy",
+				DeathDate:   &deathDate,
+				Nationality: "RU",
+				Aliases:     []string{name},
+			}},
+			requireResponse: &library.FindAuthorsResponse{
+				Authors: []*library.Author{{
+					Id:          "1",
+					Name:        "Lev Tolstoy",
+					DeathDate:   timestamppb.New(deathDate),
+					Nationality: "RU",
+					Aliases:     []string{name},
+				}},
+			}},
+
+		{
+			name:            "nothing found",
+			authors:         []entity.Author{},
+			requireResponse: &library.FindAuthorsResponse{Authors: []*library.Author{}}},
+
+		{
+			name:       "find with internal error",
+			requireErr: errInternalAuthor},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, mockAuthorRepo, s := initAuthorTest(t)
+			mockAuthorRepo.EXPECT().FindAuthors(ctx, name).Return(test.authors, test.requireErr)
+
+			response, err := s.FindAuthors(ctx, name)
+			require.Equal(t, test.requireErr, err)
+			require.Equal(t, test.requireResponse, response)
+		})
+	}
+}
+
 func TestGetAuthorInfo(t *testing.T) {
 	t.Parallel()
 
@@ -2,29 +2,138 @@ package library
 
 import (
 	"context"
+	"slices"
+	"strings"
 
 	"github.com/project/library/pkg/logger"
 
 	"github.com/project/library/generated/api/library"
 	"github.com/project/library/internal/entity"
 	"go.uber.org/zap"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+var contributorRoles = map[library.ContributorRole]entity.ContributorRole{
+	library.ContributorRole_CONTRIBUTOR_ROLE_AUTHOR:      entity.RoleAuthor,
+	library.ContributorRole_CONTRIBUTOR_ROLE_EDITOR:      entity.RoleEditor,
+	library.ContributorRole_CONTRIBUTOR_ROLE_TRANSLATOR:  entity.RoleTranslator,
+	library.ContributorRole_CONTRIBUTOR_ROLE_ILLUSTRATOR: entity.RoleIllustrator,
+}
+
+func convertRoleToAPI(role entity.ContributorRole) library.ContributorRole {
+	for apiRole, r := range contributorRoles {
+		if r == role {
+			return apiRole
+		}
+	}
+	return library.ContributorRole_CONTRIBUTOR_ROLE_UNSPECIFIED
+}
+
+// mergeContributors makes contributors of the book from authorIDs with RoleAuthor
+// and from contributors with their roles, repeated pairs of author and role are skipped.
+func mergeContributors(authorIDs []string, contributors []*library.Contributor) ([]string, []entity.Contributor) {
+	var (
+		ids    []string
+		merged []entity.Contributor
+	)
+	seen := make(map[entity.Contributor]struct{}, len(authorIDs)+len(contributors))
+	add := func(c entity.Contributor) {
+		if _, ok := seen[c]; ok {
+			return
+		}
+		seen[c] = struct{}{}
+		merged = append(merged, c)
+		if c.Role == entity.RoleAuthor {
+			ids = append(ids, c.AuthorID)
+		}
+	}
+
+	for _, id := range authorIDs {
+		add(entity.Contributor{AuthorID: id, Role: entity.RoleAuthor})
+	}
+	for _, c := range contributors {
+		add(entity.Contributor{AuthorID: c.GetAuthorId(), Role: contributorRoles[c.GetRole()]})
+	}
+
+	return ids, merged
+}
+
 func convertBook(book *entity.Book) *library.Book {
+	contributors := make([]*library.Contributor, len(book.Contributors))
+	for i, c := range book.Contributors {
+		contributors[i] = &library.Contributor{
+			AuthorId: c.AuthorID,
+			Role:     convertRoleToAPI(c.Role),
+		}
+	}
+
 	return &library.Book{
-		Id:        book.ID,
-		Name:      book.Name,
-		AuthorId:  book.AuthorIDs,
-		CreatedAt: timestamppb.New(book.CreatedAt),
-		UpdatedAt: timestamppb.New(book.UpdatedAt),
+		Id:              book.ID,
+		Name:            book.Name,
+		AuthorId:        book.AuthorIDs,
+		Contributors:    contributors,
+		PublisherId:     book.PublisherID,
+		WorkId:          book.WorkID,
+		Isbn:            book.ISBN,
+		PublicationYear: uint32(book.PublicationYear),
+		Subjects:        book.Subjects,
+		CreatedAt:       timestamppb.New(book.CreatedAt),
+		UpdatedAt:       timestamppb.New(book.UpdatedAt),
+	}
+}
+
+// parseAcceptLanguage returns languages in order of preference,
+// invalid value means no preference, so the original name is used.
+func parseAcceptLanguage(acceptLanguage string) []language.Tag {
+	desired, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil {
+		return nil
 	}
+	return desired
 }
 
-func (l *libraryImpl) AddBook(ctx context.Context, name string, authorIDs []string) (*library.AddBookResponse, error) {
+// localizeBook replaces name of the book with its title in the language,
+// which matches the desired languages best, the original name is kept if there is no such language.
+func localizeBook(book *library.Book, localizations []entity.Localization, desired []language.Tag) {
+	if len(desired) == 0 || len(localizations) == 0 {
+		return
+	}
+
+	// the first supported language is the fallback, it stands for the original name
+	supported := make([]language.Tag, 0, len(localizations)+1)
+	supported = append(supported, language.Und)
+	for _, localization := range localizations {
+		supported = append(supported, language.Make(localization.Language))
+	}
+
+	_, index, confidence := language.NewMatcher(supported).Match(desired...)
+	if index == 0 || confidence == language.No {
+		return
+	}
+
+	localization := localizations[index-1]
+	book.Name = localization.Title
+	book.Description = localization.Description
+	book.Language = localization.Language
+}
+
+func (l *libraryImpl) AddBook(
+	ctx context.Context,
+	name string,
+	authorIDs []string,
+	contributors []*library.Contributor,
+	publisherID string,
+	workID string,
+) (*library.AddBookResponse, error) {
+	ids, merged := mergeContributors(authorIDs, contributors)
 	book, err := l.booksRepository.AddBook(ctx, entity.Book{
-		Name:      name,
-		AuthorIDs: authorIDs,
+		Name:         name,
+		AuthorIDs:    ids,
+		Contributors: merged,
+		PublisherID:  publisherID,
+		WorkID:       workID,
 	})
 
 	if logger.CheckError(err, l.logger, "Failed adding book", zap.Error(err)) {
@@ -39,7 +148,7 @@ func (l *libraryImpl) AddBook(ctx context.Context, name string, authorIDs []stri
 	}, nil
 }
 
-func (l *libraryImpl) GetBookInfo(ctx context.Context, bookID string) (*library.GetBookInfoResponse, error) {
+func (l *libraryImpl) GetBookInfo(ctx context.Context, bookID, acceptLanguage, idBranch string) (*library.GetBookInfoResponse, error) {
 	book, err := l.booksRepository.GetBook(ctx, bookID)
 
 	if logger.CheckError(err, l.logger, "Failed get book info", zap.String("id of book", bookID), zap.Error(err)) {
@@ -49,16 +158,42 @@ func (l *libraryImpl) GetBookInfo(ctx context.Context, bookID string) (*library.
 		l.logger.Info("Get the book", zap.String("id of book", bookID))
 	}
 
+	availability, err := l.copyRepository.GetBookAvailability(ctx, bookID, idBranch)
+
+	if logger.CheckError(err, l.logger, "Failed get book availability", zap.String("id of book", bookID), zap.Error(err)) {
+		return nil, err
+	}
+
+	rating, err := l.reviewRepository.GetBookRating(ctx, bookID)
+
+	if logger.CheckError(err, l.logger, "Failed get book rating", zap.String("id of book", bookID), zap.Error(err)) {
+		return nil, err
+	}
+
+	result := convertBook(&book)
+	localizeBook(result, book.Localizations, parseAcceptLanguage(acceptLanguage))
+
 	return &library.GetBookInfoResponse{
-		Book: convertBook(&book),
+		Book:         result,
+		Availability: convertAvailability(availability),
+		Rating:       convertRating(rating),
 	}, nil
 }
 
-func (l *libraryImpl) UpdateBook(ctx context.Context, id, newName string, newAuthorIDs []string) error {
+func (l *libraryImpl) UpdateBook(
+	ctx context.Context,
+	id, newName string,
+	newAuthorIDs []string,
+	newContributors []*library.Contributor,
+	newPublisherID string,
+) error {
+	ids, merged := mergeContributors(newAuthorIDs, newContributors)
 	err := l.booksRepository.UpdateBook(ctx, entity.Book{
-		ID:        id,
-		Name:      newName,
-		AuthorIDs: newAuthorIDs,
+		ID:           id,
+		Name:         newName,
+		AuthorIDs:    ids,
+		Contributors: merged,
+		PublisherID:  newPublisherID,
 	})
 
 	if !logger.CheckError(err, l.logger, "Failed update book", zap.Error(err)) {
@@ -70,29 +205,121 @@ func (l *libraryImpl) UpdateBook(ctx context.Context, id, newName string, newAut
 	return err
 }
 
-func (l *libraryImpl) GetAuthorBooks(ctx context.Context, idAuthor string) (<-chan *library.Book, error) {
-	books, err := l.booksRepository.GetAuthorBooks(ctx, idAuthor)
+func (l *libraryImpl) GetAuthorBooks(
+	ctx context.Context,
+	idAuthor string,
+	role library.ContributorRole,
+	acceptLanguage string,
+	idBranch string,
+) (<-chan *library.Book, func() error, error) {
+	books, streamErr, err := l.booksRepository.GetAuthorBooks(ctx, idAuthor, contributorRoles[role], idBranch)
 
 	if logger.CheckError(err, l.logger, "Failed get author books", zap.Error(err)) {
-		return nil, err
+		return nil, nil, err
 	}
 	if l.logger != nil {
 		l.logger.Info("Got the author's book", zap.String("author's id", idAuthor))
 	}
 
+	return convertBooks(ctx, books, parseAcceptLanguage(acceptLanguage)), streamErr, nil
+}
+
+func (l *libraryImpl) GetPublisherBooks(ctx context.Context, idPublisher, idBranch string) (<-chan *library.Book, func() error, error) {
+	books, streamErr, err := l.booksRepository.GetPublisherBooks(ctx, idPublisher, idBranch)
+
+	if logger.CheckError(err, l.logger, "Failed get publisher books", zap.Error(err)) {
+		return nil, nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Got the publisher's book", zap.String("publisher's id", idPublisher))
+	}
+
+	return convertBooks(ctx, books, nil), streamErr, nil
+}
+
+func (l *libraryImpl) GetWorkEditions(ctx context.Context, idWork, idBranch string) (<-chan *library.Book, func() error, error) {
+	books, streamErr, err := l.booksRepository.GetWorkEditions(ctx, idWork, idBranch)
+
+	if logger.CheckError(err, l.logger, "Failed get work editions", zap.Error(err)) {
+		return nil, nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Got the work's editions", zap.String("work's id", idWork))
+	}
+
+	return convertBooks(ctx, books, nil), streamErr, nil
+}
+
+func (l *libraryImpl) SetBookLocalization(ctx context.Context, idBook, lang, title, description string) error {
+	err := l.booksRepository.SetBookLocalization(ctx, entity.Localization{
+		BookID:      idBook,
+		Language:    lang,
+		Title:       title,
+		Description: description,
+	})
+
+	if !logger.CheckError(err, l.logger, "Failed set book localization", zap.Error(err)) {
+		if l.logger != nil {
+			l.logger.Info("Set the book localization", zap.String("id of book", idBook), zap.String("language", lang))
+		}
+	}
+
+	return err
+}
+
+// SetBookSubjects replaces subjects of the book, subjects are trimmed, normalized to NFC and turned into lower case,
+// empty and repeated ones are skipped.
+func (l *libraryImpl) SetBookSubjects(ctx context.Context, idBook string, subjects []string) error {
+	normalized := make([]string, 0, len(subjects))
+	for _, subject := range subjects {
+		subject = strings.ToLower(norm.NFC.String(strings.TrimSpace(subject)))
+		if subject != "" && !slices.Contains(normalized, subject) {
+			normalized = append(normalized, subject)
+		}
+	}
+
+	err := l.booksRepository.SetBookSubjects(ctx, idBook, normalized)
+
+	if !logger.CheckError(err, l.logger, "Failed set book subjects", zap.Error(err)) {
+		if l.logger != nil {
+			l.logger.Info("Set the book subjects", zap.String("id of book", idBook), zap.Strings("subjects", normalized))
+		}
+	}
+
+	return err
+}
+
+func (l *libraryImpl) RemoveBookLocalization(ctx context.Context, idBook, lang string) error {
+	err := l.booksRepository.RemoveBookLocalization(ctx, idBook, lang)
+
+	if !logger.CheckError(err, l.logger, "Failed remove book localization", zap.Error(err)) {
+		if l.logger != nil {
+			l.logger.Info("Removed the book localization", zap.String("id of book", idBook), zap.String("language", lang))
+		}
+	}
+
+	return err
+}
+
+// convertBooks converts books and localizes them to the desired languages.
+// The returned channel is closed after books, so the error of the stream of books may be checked then.
+// When ctx is done, the rest of books is drained, their producer stops on ctx too.
+func convertBooks(ctx context.Context, books <-chan entity.Book, desired []language.Tag) <-chan *library.Book {
 	ans := make(chan *library.Book)
 	go func() {
 		defer close(ans)
 		for b := range books {
-			ans <- &library.Book{
-				Id:        b.ID,
-				Name:      b.Name,
-				AuthorId:  b.AuthorIDs,
-				CreatedAt: timestamppb.New(b.CreatedAt),
-				UpdatedAt: timestamppb.New(b.UpdatedAt),
+			book := convertBook(&b)
+			localizeBook(book, b.Localizations, desired)
+			select {
+			case ans <- book:
+			case <-ctx.Done():
+				for range books {
+				}
+				return
 			}
 		}
 	}()
 
-	return ans, err
+	return ans
 }
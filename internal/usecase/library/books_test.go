@@ -26,11 +26,15 @@ func initBookTest(t *testing.T) (context.Context, *mocks.MockBooksRepository, *l
 	t.Helper()
 	ctrl := gomock.NewController(t)
 	mockBooksRepo := mocks.NewMockBooksRepository(ctrl)
+	mockCopyRepo := mocks.NewMockCopyRepository(ctrl)
+	mockCopyRepo.EXPECT().GetBookAvailability(gomock.Any(), gomock.Any(), gomock.Any()).Return(entity.Availability{}, nil).AnyTimes()
+	mockReviewRepo := mocks.NewMockReviewRepository(ctrl)
+	mockReviewRepo.EXPECT().GetBookRating(gomock.Any(), gomock.Any()).Return(entity.Rating{}, nil).AnyTimes()
 	ctx := context.Background()
 	logger, e := zap.NewProduction()
 	require.NoError(t, e)
 
-	auc := New(logger, nil, mockBooksRepo)
+	auc := New(logger, Repositories{Books: mockBooksRepo, Copy: mockCopyRepo, Review: mockReviewRepo}, Options{})
 	return ctx, mockBooksRepo, auc
 }
 
@@ -43,14 +47,17 @@ func initBookTransactorTest(t *testing.T) (context.Context, *mocks.MockBooksRepo
 	logger, e := zap.NewProduction()
 	require.NoError(t, e)
 
-	auc := New(logger, nil, mockBookRepo)
+	auc := New(logger, Repositories{Books: mockBookRepo}, Options{})
 	return ctx, mockBookRepo, auc
 }
 
 func TestAddBook(t *testing.T) {
 	t.Parallel()
 
-	const name = "TestBook"
+	const (
+		name        = "TestBook"
+		publisherID = "4"
+	)
 	authors := []string{"1", "2", "3"}
 
 	tests := []struct {
@@ -76,7 +83,7 @@ func TestAddBook(t *testing.T) {
 				}
 				return input, tDBErr
 			})
-			response, err := s.AddBook(ctx, name, authors)
+			response, err := s.AddBook(ctx, name, authors, nil, publisherID, "")
 			if tDBErr != nil {
 				require.Equal(t, tDBErr, err)
 				require.Nil(t, response)
@@ -92,6 +99,7 @@ func TestAddBook(t *testing.T) {
 			require.NoError(t, err)
 			require.Equal(t, name, rBook.GetName())
 			require.Equal(t, authors, rBook.GetAuthorId())
+			require.Equal(t, publisherID, rBook.GetPublisherId())
 		})
 	}
 }
@@ -100,11 +108,18 @@ func TestUpdateBook(t *testing.T) {
 	t.Parallel()
 
 	const (
-		id   = "123"
-		name = "TestBook"
+		id          = "123"
+		name        = "TestBook"
+		publisherID = "4"
 	)
 	authors := []string{"1", "2", "3"}
 
+	contributors := []entity.Contributor{
+		{AuthorID: "1", Role: entity.RoleAuthor},
+		{AuthorID: "2", Role: entity.RoleAuthor},
+		{AuthorID: "3", Role: entity.RoleAuthor},
+	}
+
 	tests := []struct {
 		name       string
 		requireErr error
@@ -121,12 +136,14 @@ func TestUpdateBook(t *testing.T) {
 
 			ctx, mockBookRepo, s := initBookTest(t)
 			mockBookRepo.EXPECT().UpdateBook(ctx, entity.Book{
-				ID:        id,
-				Name:      name,
-				AuthorIDs: authors,
+				ID:           id,
+				Name:         name,
+				AuthorIDs:    authors,
+				Contributors: contributors,
+				PublisherID:  publisherID,
 			}).Return(test.requireErr)
 
-			err := s.UpdateBook(ctx, id, name, authors)
+			err := s.UpdateBook(ctx, id, name, authors, nil, publisherID)
 			require.Equal(t, err, test.requireErr)
 		})
 	}
@@ -181,7 +198,7 @@ func TestGetBookInfo(t *testing.T) {
 				}, nil
 			})
 
-			response, err := s.GetBookInfo(ctx, id)
+			response, err := s.GetBookInfo(ctx, id, "", "")
 			require.Equal(t, tErr, err)
 			if tErr != nil {
 				require.Nil(t, response)
@@ -216,6 +233,10 @@ func makeFilledChan(books []entity.Book) <-chan entity.Book {
 	return ans
 }
 
+func noStreamErr() error {
+	return nil
+}
+
 func readFilledChan(t *testing.T, books []entity.Book, bChan <-chan *library.Book) {
 	t.Helper()
 	if bChan == nil {
@@ -266,10 +287,407 @@ func TestGetAuthorBooks(t *testing.T) {
 				returnChan = makeFilledChan(tBooks)
 			}
 
-			mockBookRepo.EXPECT().GetAuthorBooks(ctx, gomock.Any()).Return(returnChan, tErr)
-			bks, err := s.GetAuthorBooks(ctx, test.id)
+			mockBookRepo.EXPECT().GetAuthorBooks(ctx, gomock.Any(), entity.ContributorRole(""), "").Return(returnChan, noStreamErr, tErr)
+			bks, streamErr, err := s.GetAuthorBooks(ctx, test.id, library.ContributorRole_CONTRIBUTOR_ROLE_UNSPECIFIED, "", "")
+			require.Equal(t, tErr, err)
+			readFilledChan(t, tBooks, bks)
+			if err == nil {
+				require.NoError(t, streamErr())
+			}
+		})
+	}
+}
+
+func TestGetPublisherBooks(t *testing.T) {
+	t.Parallel()
+
+	const idPublisher = "123"
+
+	tests := []struct {
+		name         string
+		id           string
+		requireBooks []entity.Book
+		requireErr   error
+	}{
+		{name: "valid get publisher books",
+			id:           idPublisher,
+			requireBooks: generateBooks(3, "456"),
+			requireErr:   nil},
+
+		{name: "get publisher books with internal error",
+			id:           idPublisher,
+			requireBooks: nil,
+			requireErr:   errInternalBooks},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, mockBookRepo, s := initBookTest(t)
+			tBooks := test.requireBooks
+			tErr := test.requireErr
+
+			var returnChan <-chan entity.Book
+			if tErr == nil {
+				returnChan = makeFilledChan(tBooks)
+			}
+
+			mockBookRepo.EXPECT().GetPublisherBooks(ctx, test.id, "").Return(returnChan, noStreamErr, tErr)
+			bks, streamErr, err := s.GetPublisherBooks(ctx, test.id, "")
+			require.Equal(t, tErr, err)
+			readFilledChan(t, tBooks, bks)
+			if err == nil {
+				require.NoError(t, streamErr())
+			}
+		})
+	}
+}
+
+func TestGetWorkEditions(t *testing.T) {
+	t.Parallel()
+
+	const idWork = "123"
+
+	tests := []struct {
+		name         string
+		id           string
+		requireBooks []entity.Book
+		requireErr   error
+	}{
+		{name: "valid get work editions",
+			id:           idWork,
+			requireBooks: generateBooks(3, "456"),
+			requireErr:   nil},
+
+		{name: "get work editions with internal error",
+			id:           idWork,
+			requireBooks: nil,
+			requireErr:   errInternalBooks},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, mockBookRepo, s := initBookTest(t)
+			tBooks := test.requireBooks
+			tErr := test.requireErr
+
+			var returnChan <-chan entity.Book
+			if tErr == nil {
+				returnChan = makeFilledChan(tBooks)
+			}
+
+			mockBookRepo.EXPECT().GetWorkEditions(ctx, test.id, "").Return(returnChan, noStreamErr, tErr)
+			bks, streamErr, err := s.GetWorkEditions(ctx, test.id, "")
 			require.Equal(t, tErr, err)
 			readFilledChan(t, tBooks, bks)
+			if err == nil {
+				require.NoError(t, streamErr())
+			}
 		})
 	}
 }
+
+func TestAddBookWithContributors(t *testing.T) {
+	t.Parallel()
+
+	const name = "TestBook"
+
+	tests := []struct {
+		name                string
+		authorIDs           []string
+		contributors        []*library.Contributor
+		requireAuthorIDs    []string
+		requireContributors []entity.Contributor
+	}{
+		{name: "only author ids",
+			authorIDs:        []string{"1", "2"},
+			requireAuthorIDs: []string{"1", "2"},
+			requireContributors: []entity.Contributor{
+				{AuthorID: "1", Role: entity.RoleAuthor},
+				{AuthorID: "2", Role: entity.RoleAuthor},
+			}},
+
+		{name: "author ids and contributors",
+			authorIDs: []string{"1"},
+			contributors: []*library.Contributor{
+				{AuthorId: "2", Role: library.ContributorRole_CONTRIBUTOR_ROLE_TRANSLATOR},
+				{AuthorId: "3", Role: library.ContributorRole_CONTRIBUTOR_ROLE_AUTHOR},
+			},
+			requireAuthorIDs: []string{"1", "3"},
+			requireContributors: []entity.Contributor{
+				{AuthorID: "1", Role: entity.RoleAuthor},
+				{AuthorID: "2", Role: entity.RoleTranslator},
+				{AuthorID: "3", Role: entity.RoleAuthor},
+			}},
+
+		{name: "order of authors is preserved",
+			authorIDs: []string{"3", "1"},
+			contributors: []*library.Contributor{
+				{AuthorId: "2", Role: library.ContributorRole_CONTRIBUTOR_ROLE_AUTHOR},
+			},
+			requireAuthorIDs: []string{"3", "1", "2"},
+			requireContributors: []entity.Contributor{
+				{AuthorID: "3", Role: entity.RoleAuthor},
+				{AuthorID: "1", Role: entity.RoleAuthor},
+				{AuthorID: "2", Role: entity.RoleAuthor},
+			}},
+
+		{name: "repeated contributors",
+			authorIDs: []string{"1"},
+			contributors: []*library.Contributor{
+				{AuthorId: "1", Role: library.ContributorRole_CONTRIBUTOR_ROLE_AUTHOR},
+				{AuthorId: "1", Role: library.ContributorRole_CONTRIBUTOR_ROLE_ILLUSTRATOR},
+			},
+			requireAuthorIDs: []string{"1"},
+			requireContributors: []entity.Contributor{
+				{AuthorID: "1", Role: entity.RoleAuthor},
+				{AuthorID: "1", Role: entity.RoleIllustrator},
+			}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, mockBookRepo, s := initBookTest(t)
+
+			mockBookRepo.EXPECT().AddBook(ctx, entity.Book{
+				Name:         name,
+				AuthorIDs:    test.requireAuthorIDs,
+				Contributors: test.requireContributors,
+			}).DoAndReturn(func(ctx context.Context, input entity.Book) (entity.Book, error) {
+				return input, nil
+			})
+
+			response, err := s.AddBook(ctx, name, test.authorIDs, test.contributors, "", "")
+			require.NoError(t, err)
+			rBook := response.GetBook()
+			require.Equal(t, test.requireAuthorIDs, rBook.GetAuthorId())
+			require.Len(t, rBook.GetContributors(), len(test.requireContributors))
+			for i, c := range rBook.GetContributors() {
+				require.Equal(t, test.requireContributors[i].AuthorID, c.GetAuthorId())
+				require.Equal(t, test.requireContributors[i].Role, contributorRoles[c.GetRole()])
+			}
+		})
+	}
+}
+
+func TestGetAuthorBooksByRole(t *testing.T) {
+	t.Parallel()
+
+	const idAuthor = "123"
+
+	ctx, mockBookRepo, s := initBookTest(t)
+	books := generateBooks(2, idAuthor)
+
+	mockBookRepo.EXPECT().GetAuthorBooks(ctx, idAuthor, entity.RoleTranslator, "").Return(makeFilledChan(books), noStreamErr, nil)
+	bks, _, err := s.GetAuthorBooks(ctx, idAuthor, library.ContributorRole_CONTRIBUTOR_ROLE_TRANSLATOR, "", "")
+	require.NoError(t, err)
+	readFilledChan(t, books, bks)
+}
+
+func TestGetAuthorBooksCanceled(t *testing.T) {
+	t.Parallel()
+
+	const idAuthor = "123"
+
+	ctx, mockBookRepo, s := initBookTest(t)
+	ctx, cancel := context.WithCancel(ctx)
+	books := makeFilledChan(generateBooks(3, idAuthor))
+
+	mockBookRepo.EXPECT().GetAuthorBooks(ctx, idAuthor, entity.ContributorRole(""), "").Return(books, noStreamErr, nil)
+	bks, _, err := s.GetAuthorBooks(ctx, idAuthor, library.ContributorRole_CONTRIBUTOR_ROLE_UNSPECIFIED, "", "")
+	require.NoError(t, err)
+
+	cancel()
+	for range bks {
+	}
+	require.Empty(t, books)
+}
+
+func TestGetLocalizedBookInfo(t *testing.T) {
+	t.Parallel()
+
+	const (
+		id   = "123"
+		name = "Colour"
+	)
+	localizations := []entity.Localization{
+		{BookID: id, Language: "en-GB", Title: "Colour", Description: "About colours"},
+		{BookID: id, Language: "fr", Title: "Couleur", Description: "Sur les couleurs"},
+	}
+
+	tests := []struct {
+		name            string
+		acceptLanguage  string
+		requireName     string
+		requireLanguage string
+	}{
+		{name: "no preference",
+			requireName: name},
+		{name: "exact language",
+			acceptLanguage:  "fr",
+			requireName:     "Couleur",
+			requireLanguage: "fr"},
+		{name: "regional variant",
+			acceptLanguage:  "fr-CH, en;q=0.5",
+			requireName:     "Couleur",
+			requireLanguage: "fr"},
+		{name: "first matching language",
+			acceptLanguage:  "de, en-GB;q=0.8, fr;q=0.5",
+			requireName:     "Colour",
+			requireLanguage: "en-GB"},
+		{name: "fallback to original",
+			acceptLanguage: "de",
+			requireName:    name},
+		{name: "invalid languages",
+			acceptLanguage: "fr;q=high",
+			requireName:    name},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, mockBookRepo, s := initBookTest(t)
+			mockBookRepo.EXPECT().GetBook(ctx, id).Return(entity.Book{
+				ID:            id,
+				Name:          name,
+				Localizations: localizations,
+			}, nil)
+
+			response, err := s.GetBookInfo(ctx, id, test.acceptLanguage, "")
+			require.NoError(t, err)
+			require.Equal(t, test.requireName, response.GetBook().GetName())
+			require.Equal(t, test.requireLanguage, response.GetBook().GetLanguage())
+		})
+	}
+}
+
+func TestGetLocalizedAuthorBooks(t *testing.T) {
+	t.Parallel()
+
+	const idAuthor = "123"
+
+	ctx, mockBookRepo, s := initBookTest(t)
+	books := generateBooks(2, idAuthor)
+	books[0].Localizations = []entity.Localization{{BookID: books[0].ID, Language: "ru", Title: "Книга"}}
+
+	mockBookRepo.EXPECT().GetAuthorBooks(ctx, idAuthor, entity.ContributorRole(""), "").Return(makeFilledChan(books), noStreamErr, nil)
+	bks, _, err := s.GetAuthorBooks(ctx, idAuthor, library.ContributorRole_CONTRIBUTOR_ROLE_UNSPECIFIED, "ru-RU", "")
+	require.NoError(t, err)
+
+	localized := <-bks
+	require.Equal(t, "Книга", localized.GetName())
+	require.Equal(t, "ru", localized.GetLanguage())
+
+	original := <-bks
+	require.Equal(t, books[1].Name, original.GetName())
+	require.Empty(t, original.GetLanguage())
+}
+
+func TestBookLocalization(t *testing.T) {
+	t.Parallel()
+
+	const (
+		id   = "123"
+		lang = "en-GB"
+	)
+
+	tests := []struct {
+		name       string
+		requireErr error
+	}{
+		{name: "valid change of localization"},
+		{name: "unknown book",
+			requireErr: entity.ErrBookNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, mockBookRepo, s := initBookTest(t)
+			mockBookRepo.EXPECT().SetBookLocalization(ctx, entity.Localization{
+				BookID:      id,
+				Language:    lang,
+				Title:       "Colour",
+				Description: "About colours",
+			}).Return(test.requireErr)
+			mockBookRepo.EXPECT().RemoveBookLocalization(ctx, id, lang).Return(test.requireErr)
+
+			require.Equal(t, test.requireErr, s.SetBookLocalization(ctx, id, lang, "Colour", "About colours"))
+			require.Equal(t, test.requireErr, s.RemoveBookLocalization(ctx, id, lang))
+		})
+	}
+}
+
+func TestSetBookSubjects(t *testing.T) {
+	t.Parallel()
+
+	const id = "123"
+
+	tests := []struct {
+		name            string
+		subjects        []string
+		requireSubjects []string
+		requireErr      error
+	}{
+		{name: "subjects are normalized",
+			subjects:        []string{" Science Fiction ", "Humour", "science fiction", "  ", "Café"},
+			requireSubjects: []string{"science fiction", "humour", "café"}},
+		{name: "subjects are removed",
+			requireSubjects: []string{}},
+		{name: "unknown book",
+			subjects:        []string{"Humour"},
+			requireSubjects: []string{"humour"},
+			requireErr:      entity.ErrBookNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, mockBookRepo, s := initBookTest(t)
+			mockBookRepo.EXPECT().SetBookSubjects(ctx, id, test.requireSubjects).Return(test.requireErr)
+
+			require.Equal(t, test.requireErr, s.SetBookSubjects(ctx, id, test.subjects))
+		})
+	}
+}
+
+func TestAddEdition(t *testing.T) {
+	t.Parallel()
+
+	const (
+		name   = "Dune. Illustrated edition"
+		idWork = "123"
+	)
+
+	ctx, mockBookRepo, s := initBookTest(t)
+
+	illustrator := []*library.Contributor{{AuthorId: "2", Role: library.ContributorRole_CONTRIBUTOR_ROLE_ILLUSTRATOR}}
+	mockBookRepo.EXPECT().AddBook(ctx, entity.Book{
+		Name:         name,
+		Contributors: []entity.Contributor{{AuthorID: "2", Role: entity.RoleIllustrator}},
+		WorkID:       idWork,
+	}).Return(entity.Book{
+		ID:        uuid.NewString(),
+		Name:      name,
+		AuthorIDs: []string{"1"},
+		Contributors: []entity.Contributor{
+			{AuthorID: "1", Role: entity.RoleAuthor},
+			{AuthorID: "2", Role: entity.RoleIllustrator},
+		},
+		WorkID: idWork,
+	}, nil)
+
+	response, err := s.AddBook(ctx, name, nil, illustrator, "", idWork)
+	require.NoError(t, err)
+	require.Equal(t, idWork, response.GetBook().GetWorkId())
+	require.Equal(t, []string{"1"}, response.GetBook().GetAuthorId())
+	require.Len(t, response.GetBook().GetContributors(), 2)
+}
@@ -0,0 +1,199 @@
+package library
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/pkg/logger"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var transferStatuses = map[library.TransferStatus]entity.TransferStatus{
+	library.TransferStatus_TRANSFER_STATUS_REQUESTED:  entity.TransferRequested,
+	library.TransferStatus_TRANSFER_STATUS_IN_TRANSIT: entity.TransferInTransit,
+	library.TransferStatus_TRANSFER_STATUS_RECEIVED:   entity.TransferReceived,
+	library.TransferStatus_TRANSFER_STATUS_CANCELLED:  entity.TransferCancelled,
+}
+
+func convertTransferStatusToAPI(status entity.TransferStatus) library.TransferStatus {
+	for apiStatus, s := range transferStatuses {
+		if s == status {
+			return apiStatus
+		}
+	}
+	return library.TransferStatus_TRANSFER_STATUS_UNSPECIFIED
+}
+
+func convertBranch(branch *entity.Branch) *library.Branch {
+	return &library.Branch{
+		Id:        branch.ID,
+		Name:      branch.Name,
+		Address:   branch.Address,
+		CreatedAt: timestamppb.New(branch.CreatedAt),
+		UpdatedAt: timestamppb.New(branch.UpdatedAt),
+	}
+}
+
+func convertTransfer(transfer *entity.Transfer) *library.Transfer {
+	result := &library.Transfer{
+		Id:           transfer.ID,
+		CopyId:       transfer.CopyID,
+		FromBranchId: transfer.FromBranchID,
+		ToBranchId:   transfer.ToBranchID,
+		Status:       convertTransferStatusToAPI(transfer.Status),
+		RequestedAt:  timestamppb.New(transfer.RequestedAt),
+	}
+	if transfer.ShippedAt != nil {
+		result.ShippedAt = timestamppb.New(*transfer.ShippedAt)
+	}
+	if transfer.ReceivedAt != nil {
+		result.ReceivedAt = timestamppb.New(*transfer.ReceivedAt)
+	}
+	return result
+}
+
+func (l *libraryImpl) CreateBranch(ctx context.Context, name, address string) (*library.CreateBranchResponse, error) {
+	branch, err := l.branchRepository.CreateBranch(ctx, entity.Branch{
+		Name:    name,
+		Address: address,
+	})
+
+	if logger.CheckError(err, l.logger, "Failed create branch", zap.String("name", name), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Created branch", zap.String("id", branch.ID), zap.String("name", name))
+	}
+
+	return &library.CreateBranchResponse{
+		Branch: convertBranch(&branch),
+	}, nil
+}
+
+func (l *libraryImpl) GetBranch(ctx context.Context, idBranch string) (*library.GetBranchResponse, error) {
+	branch, err := l.branchRepository.GetBranch(ctx, idBranch)
+
+	if logger.CheckError(err, l.logger, "Failed get branch", zap.String("id of branch", idBranch), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Get the branch", zap.String("id of branch", idBranch))
+	}
+
+	return &library.GetBranchResponse{
+		Branch: convertBranch(&branch),
+	}, nil
+}
+
+func (l *libraryImpl) ListBranches(ctx context.Context) (*library.ListBranchesResponse, error) {
+	branches, err := l.branchRepository.ListBranches(ctx)
+
+	if logger.CheckError(err, l.logger, "Failed list branches", zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("List branches", zap.Int("count", len(branches)))
+	}
+
+	result := make([]*library.Branch, 0, len(branches))
+	for i := range branches {
+		result = append(result, convertBranch(&branches[i]))
+	}
+
+	return &library.ListBranchesResponse{
+		Branches: result,
+	}, nil
+}
+
+func (l *libraryImpl) RequestTransfer(ctx context.Context, idCopy, idToBranch string) (*library.RequestTransferResponse, error) {
+	transfer, err := l.branchRepository.RequestTransfer(ctx, entity.Transfer{
+		CopyID:      idCopy,
+		ToBranchID:  idToBranch,
+		RequestedAt: time.Now(),
+	})
+
+	if logger.CheckError(err, l.logger, "Failed request transfer", zap.String("id of copy", idCopy), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Requested transfer", zap.String("id of transfer", transfer.ID), zap.String("id of copy", idCopy),
+			zap.String("from branch", transfer.FromBranchID), zap.String("to branch", idToBranch))
+	}
+
+	return &library.RequestTransferResponse{
+		Transfer: convertTransfer(&transfer),
+	}, nil
+}
+
+func (l *libraryImpl) ShipTransfer(ctx context.Context, idTransfer string) (*library.ShipTransferResponse, error) {
+	transfer, err := l.branchRepository.ShipTransfer(ctx, idTransfer, time.Now())
+
+	if logger.CheckError(err, l.logger, "Failed ship transfer", zap.String("id of transfer", idTransfer), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Shipped transfer", zap.String("id of transfer", idTransfer))
+	}
+
+	return &library.ShipTransferResponse{
+		Transfer: convertTransfer(&transfer),
+	}, nil
+}
+
+func (l *libraryImpl) ReceiveTransfer(ctx context.Context, idTransfer string) (*library.ReceiveTransferResponse, error) {
+	now := time.Now()
+	transfer, err := l.branchRepository.ReceiveTransfer(ctx, idTransfer, now, now.Add(l.loanPolicy.PickupWindow))
+
+	if logger.CheckError(err, l.logger, "Failed receive transfer", zap.String("id of transfer", idTransfer), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Received transfer", zap.String("id of transfer", idTransfer), zap.String("branch", transfer.ToBranchID))
+	}
+
+	return &library.ReceiveTransferResponse{
+		Transfer: convertTransfer(&transfer),
+	}, nil
+}
+
+func (l *libraryImpl) CancelTransfer(ctx context.Context, idTransfer string) (*library.CancelTransferResponse, error) {
+	transfer, err := l.branchRepository.CancelTransfer(ctx, idTransfer)
+
+	if logger.CheckError(err, l.logger, "Failed cancel transfer", zap.String("id of transfer", idTransfer), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Cancelled transfer", zap.String("id of transfer", idTransfer))
+	}
+
+	return &library.CancelTransferResponse{
+		Transfer: convertTransfer(&transfer),
+	}, nil
+}
+
+func (l *libraryImpl) ListTransfers(ctx context.Context, idBranch string, status library.TransferStatus) (*library.ListTransfersResponse, error) {
+	transfers, err := l.branchRepository.ListTransfers(ctx, entity.TransferFilter{
+		BranchID: idBranch,
+		Status:   transferStatuses[status],
+	})
+
+	if logger.CheckError(err, l.logger, "Failed list transfers", zap.String("id of branch", idBranch), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("List transfers", zap.String("id of branch", idBranch), zap.Int("count", len(transfers)))
+	}
+
+	result := make([]*library.Transfer, 0, len(transfers))
+	for i := range transfers {
+		result = append(result, convertTransfer(&transfers[i]))
+	}
+
+	return &library.ListTransfersResponse{
+		Transfers: result,
+	}, nil
+}
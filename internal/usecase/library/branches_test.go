@@ -0,0 +1,187 @@
+package library
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/project/library/internal/usecase/library/mocks"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var errInternalBranches = errors.New("internal error")
+
+func initBranchTest(t *testing.T) (context.Context, *mocks.MockBranchRepository, *libraryImpl) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockBranchRepo := mocks.NewMockBranchRepository(ctrl)
+	ctx := context.Background()
+	logger, e := zap.NewProduction()
+	require.NoError(t, e)
+
+	buc := New(logger, Repositories{Branch: mockBranchRepo}, Options{LoanPolicy: testLoanPolicy})
+	return ctx, mockBranchRepo, buc
+}
+
+func TestCreateBranch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		requireErr error
+	}{
+		{name: "valid branch"},
+		{name: "duplicate name",
+			requireErr: entity.ErrBranchAlreadyExists},
+		{name: "internal error",
+			requireErr: errInternalBranches},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, mockBranchRepo, s := initBranchTest(t)
+
+			mockBranchRepo.EXPECT().CreateBranch(ctx, entity.Branch{Name: "Central", Address: "Main st. 1"}).
+				DoAndReturn(func(_ context.Context, branch entity.Branch) (entity.Branch, error) {
+					if test.requireErr != nil {
+						return entity.Branch{}, test.requireErr
+					}
+					branch.ID = "1"
+					return branch, nil
+				})
+
+			response, err := s.CreateBranch(ctx, "Central", "Main st. 1")
+			require.ErrorIs(t, err, test.requireErr)
+			if test.requireErr != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, "1", response.GetBranch().GetId())
+			require.Equal(t, "Central", response.GetBranch().GetName())
+		})
+	}
+}
+
+func TestListBranches(t *testing.T) {
+	t.Parallel()
+
+	ctx, mockBranchRepo, s := initBranchTest(t)
+
+	mockBranchRepo.EXPECT().ListBranches(ctx).Return([]entity.Branch{{ID: "1"}, {ID: "2"}}, nil)
+	response, err := s.ListBranches(ctx)
+	require.NoError(t, err)
+	require.Len(t, response.GetBranches(), 2)
+
+	mockBranchRepo.EXPECT().ListBranches(ctx).Return(nil, errInternalBranches)
+	response, err = s.ListBranches(ctx)
+	require.ErrorIs(t, err, errInternalBranches)
+	require.Nil(t, response)
+}
+
+func TestRequestTransfer(t *testing.T) {
+	t.Parallel()
+
+	const (
+		idCopy   = "123"
+		idBranch = "456"
+	)
+
+	tests := []struct {
+		name       string
+		requireErr error
+	}{
+		{name: "valid transfer"},
+		{name: "copy on loan",
+			requireErr: entity.ErrCopyNotAvailable},
+		{name: "copy already in branch",
+			requireErr: entity.ErrTransferNotAllowed},
+		{name: "open transfer",
+			requireErr: entity.ErrTransferAlreadyExists},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, mockBranchRepo, s := initBranchTest(t)
+
+			mockBranchRepo.EXPECT().RequestTransfer(ctx, gomock.Any()).DoAndReturn(
+				func(_ context.Context, transfer entity.Transfer) (entity.Transfer, error) {
+					require.Equal(t, idCopy, transfer.CopyID)
+					require.Equal(t, idBranch, transfer.ToBranchID)
+					require.False(t, transfer.RequestedAt.IsZero())
+					if test.requireErr != nil {
+						return entity.Transfer{}, test.requireErr
+					}
+					transfer.ID = "1"
+					transfer.FromBranchID = "789"
+					transfer.Status = entity.TransferRequested
+					return transfer, nil
+				})
+
+			response, err := s.RequestTransfer(ctx, idCopy, idBranch)
+			require.ErrorIs(t, err, test.requireErr)
+			if test.requireErr != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, "789", response.GetTransfer().GetFromBranchId())
+			require.Equal(t, library.TransferStatus_TRANSFER_STATUS_REQUESTED, response.GetTransfer().GetStatus())
+			require.Nil(t, response.GetTransfer().GetShippedAt())
+		})
+	}
+}
+
+func TestReceiveTransfer(t *testing.T) {
+	t.Parallel()
+
+	const idTransfer = "123"
+
+	ctx, mockBranchRepo, s := initBranchTest(t)
+
+	mockBranchRepo.EXPECT().ReceiveTransfer(ctx, idTransfer, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, id string, receivedAt, pickupUntil time.Time) (entity.Transfer, error) {
+			require.Equal(t, testLoanPolicy.PickupWindow, pickupUntil.Sub(receivedAt))
+			shipped := receivedAt.Add(-time.Hour)
+			return entity.Transfer{ID: id, Status: entity.TransferReceived, ShippedAt: &shipped, ReceivedAt: &receivedAt}, nil
+		})
+
+	response, err := s.ReceiveTransfer(ctx, idTransfer)
+	require.NoError(t, err)
+	require.Equal(t, library.TransferStatus_TRANSFER_STATUS_RECEIVED, response.GetTransfer().GetStatus())
+	require.NotNil(t, response.GetTransfer().GetReceivedAt())
+
+	mockBranchRepo.EXPECT().ReceiveTransfer(ctx, idTransfer, gomock.Any(), gomock.Any()).Return(entity.Transfer{}, entity.ErrTransferNotFound)
+	response, err = s.ReceiveTransfer(ctx, idTransfer)
+	require.ErrorIs(t, err, entity.ErrTransferNotFound)
+	require.Nil(t, response)
+}
+
+func TestListTransfers(t *testing.T) {
+	t.Parallel()
+
+	const idBranch = "456"
+
+	ctx, mockBranchRepo, s := initBranchTest(t)
+
+	mockBranchRepo.EXPECT().ListTransfers(ctx, entity.TransferFilter{BranchID: idBranch, Status: entity.TransferInTransit}).
+		Return([]entity.Transfer{{ID: "1", Status: entity.TransferInTransit}}, nil)
+	response, err := s.ListTransfers(ctx, idBranch, library.TransferStatus_TRANSFER_STATUS_IN_TRANSIT)
+	require.NoError(t, err)
+	require.Len(t, response.GetTransfers(), 1)
+	require.Equal(t, library.TransferStatus_TRANSFER_STATUS_IN_TRANSIT, response.GetTransfers()[0].GetStatus())
+
+	mockBranchRepo.EXPECT().ListTransfers(ctx, entity.TransferFilter{}).Return(nil, errInternalBranches)
+	response, err = s.ListTransfers(ctx, "", library.TransferStatus_TRANSFER_STATUS_UNSPECIFIED)
+	require.ErrorIs(t, err, errInternalBranches)
+	require.Nil(t, response)
+}
@@ -0,0 +1,299 @@
+package library
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/project/library/pkg/logger"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"go.uber.org/zap"
+	"golang.org/x/text/unicode/norm"
+)
+
+var citationContentTypes = map[library.CitationFormat]string{
+	library.CitationFormat_CITATION_FORMAT_BIBTEX:   "application/x-bibtex; charset=utf-8",
+	library.CitationFormat_CITATION_FORMAT_RIS:      "application/x-research-info-systems; charset=utf-8",
+	library.CitationFormat_CITATION_FORMAT_CSL_JSON: "application/vnd.citationstyles.csl+json",
+}
+
+// citeKeyStopWords are skipped when the first word of the title is taken into the cite key.
+var citeKeyStopWords = map[string]bool{"a": true, "an": true, "the": true}
+
+var bibtexEscaper = strings.NewReplacer(
+	`\`, `\textbackslash{}`,
+	"{", `\{`,
+	"}", `\}`,
+	"&", `\&`,
+	"%", `\%`,
+	"$", `\$`,
+	"#", `\#`,
+	"_", `\_`,
+	"~", `\textasciitilde{}`,
+	"^", `\textasciicircum{}`,
+)
+
+// citedName is the name of the author split into the family name, the last word of the name, and given names.
+type citedName struct {
+	Family string `json:"family"`
+	Given  string `json:"given,omitempty"`
+}
+
+func splitName(name string) citedName {
+	words := strings.Fields(name)
+	if len(words) == 0 {
+		return citedName{}
+	}
+	return citedName{Family: words[len(words)-1], Given: strings.Join(words[:len(words)-1], " ")}
+}
+
+// inverted returns the name as "Family, Given" used in BibTeX and RIS.
+func (n citedName) inverted() string {
+	if n.Given == "" {
+		return n.Family
+	}
+	return n.Family + ", " + n.Given
+}
+
+// citeKeyWord returns the word in lower case ASCII letters and digits, diacritics are removed
+// and other characters are dropped.
+func citeKeyWord(word string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(word) {
+		r = unicode.ToLower(r)
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// citeKey returns the key of the book made of the family name of the first author, the publication year,
+// the first significant word of the title and the first part of the book id, the id part keeps keys
+// of different books from colliding. The key is generated only when the book is cited the first time,
+// see citeKeys.
+func citeKey(book entity.ExportedBook) string {
+	author := "anon"
+	if len(book.AuthorNames) > 0 {
+		if family := citeKeyWord(splitName(book.AuthorNames[0]).Family); family != "" {
+			author = family
+		}
+	}
+
+	year := "nd"
+	if book.PublicationYear != 0 {
+		year = strconv.Itoa(int(book.PublicationYear))
+	}
+
+	var title string
+	for _, word := range strings.Fields(book.Name) {
+		if w := citeKeyWord(word); w != "" && !citeKeyStopWords[w] {
+			title = w
+			break
+		}
+	}
+
+	id, _, _ := strings.Cut(book.ID, "-")
+	return author + year + title + "-" + id
+}
+
+func bibtexEntry(book entity.ExportedBook, key string) string {
+	var b strings.Builder
+	field := func(name, value string) {
+		if value != "" {
+			fmt.Fprintf(&b, "  %s = {%s},\n", name, value)
+		}
+	}
+
+	authors := make([]string, len(book.AuthorNames))
+	for i, name := range book.AuthorNames {
+		authors[i] = bibtexEscaper.Replace(splitName(name).inverted())
+	}
+
+	fmt.Fprintf(&b, "@book{%s,\n", key)
+	field("author", strings.Join(authors, " and "))
+	field("title", bibtexEscaper.Replace(book.Name))
+	if book.PublicationYear != 0 {
+		field("year", strconv.Itoa(int(book.PublicationYear)))
+	}
+	field("publisher", bibtexEscaper.Replace(book.PublisherName))
+	field("isbn", book.ISBN)
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func risEntry(book entity.ExportedBook, key string) string {
+	var b strings.Builder
+	tag := func(tag, value string) {
+		if value != "" {
+			// RIS lines end with CR LF
+			fmt.Fprintf(&b, "%s  - %s\r\n", tag, value)
+		}
+	}
+
+	tag("TY", "BOOK")
+	tag("ID", key)
+	for _, name := range book.AuthorNames {
+		tag("AU", splitName(name).inverted())
+	}
+	tag("TI", book.Name)
+	if book.PublicationYear != 0 {
+		tag("PY", strconv.Itoa(int(book.PublicationYear)))
+	}
+	tag("PB", book.PublisherName)
+	tag("SN", book.ISBN)
+	b.WriteString("ER  - \r\n")
+	return b.String()
+}
+
+type cslDate struct {
+	DateParts [][]int `json:"date-parts"`
+}
+
+type cslItem struct {
+	ID        string      `json:"id"`
+	Type      string      `json:"type"`
+	Title     string      `json:"title"`
+	Author    []citedName `json:"author,omitempty"`
+	Issued    *cslDate    `json:"issued,omitempty"`
+	Publisher string      `json:"publisher,omitempty"`
+	ISBN      string      `json:"ISBN,omitempty"`
+}
+
+func cslJSONItem(book entity.ExportedBook, key string) cslItem {
+	item := cslItem{
+		ID:        key,
+		Type:      "book",
+		Title:     book.Name,
+		Publisher: book.PublisherName,
+		ISBN:      book.ISBN,
+	}
+	for _, name := range book.AuthorNames {
+		item.Author = append(item.Author, splitName(name))
+	}
+	if book.PublicationYear != 0 {
+		item.Issued = &cslDate{DateParts: [][]int{{int(book.PublicationYear)}}}
+	}
+	return item
+}
+
+// citeKeys returns cite keys of the books. The key of the book is stored when it is cited the first time,
+// so the key stays the same when the author, the year or the title of the book changes later.
+func (l *libraryImpl) citeKeys(ctx context.Context, books []entity.ExportedBook) ([]string, error) {
+	generated := make(map[string]string, len(books))
+	for _, book := range books {
+		generated[book.ID] = citeKey(book)
+	}
+
+	stored, err := l.booksRepository.SaveCiteKeys(ctx, generated)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, len(books))
+	for i, book := range books {
+		keys[i] = stored[book.ID]
+	}
+	return keys, nil
+}
+
+// renderCitations returns entries of the books in the format with their cite keys,
+// CSL-JSON is always an array of items.
+func renderCitations(books []entity.ExportedBook, keys []string, format library.CitationFormat) (string, error) {
+	switch format {
+	case library.CitationFormat_CITATION_FORMAT_RIS:
+		var b strings.Builder
+		for i, book := range books {
+			b.WriteString(risEntry(book, keys[i]))
+		}
+		return b.String(), nil
+	case library.CitationFormat_CITATION_FORMAT_CSL_JSON:
+		items := make([]cslItem, len(books))
+		for i, book := range books {
+			items[i] = cslJSONItem(book, keys[i])
+		}
+		data, err := json.MarshalIndent(items, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		return string(data) + "\n", nil
+	default:
+		entries := make([]string, len(books))
+		for i, book := range books {
+			entries[i] = bibtexEntry(book, keys[i])
+		}
+		return strings.Join(entries, "\n"), nil
+	}
+}
+
+func (l *libraryImpl) GetBookCitation(
+	ctx context.Context,
+	idBook string,
+	format library.CitationFormat,
+) (*library.GetBookCitationResponse, error) {
+	book, err := l.booksRepository.GetExportedBook(ctx, idBook)
+	if logger.CheckError(err, l.logger, "Failed get book", zap.String("book id", idBook), zap.Error(err)) {
+		return nil, err
+	}
+
+	keys, err := l.citeKeys(ctx, []entity.ExportedBook{book})
+	if logger.CheckError(err, l.logger, "Failed get cite key", zap.String("book id", idBook), zap.Error(err)) {
+		return nil, err
+	}
+
+	citation, err := renderCitations([]entity.ExportedBook{book}, keys, format)
+	if logger.CheckError(err, l.logger, "Failed rendering citation", zap.String("book id", idBook), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Got the book citation", zap.String("book id", idBook), zap.String("format", format.String()))
+	}
+
+	return &library.GetBookCitationResponse{
+		ContentType: citationContentTypes[format],
+		Citation:    citation,
+		CiteKey:     keys[0],
+	}, nil
+}
+
+func (l *libraryImpl) GetBookCitations(
+	ctx context.Context,
+	ids []string,
+	format library.CitationFormat,
+) (*library.GetBookCitationsResponse, error) {
+	books, err := l.booksRepository.GetExportedBooks(ctx, ids)
+	if logger.CheckError(err, l.logger, "Failed get books", zap.Strings("book ids", ids), zap.Error(err)) {
+		return nil, err
+	}
+
+	// books are returned in the order of ids, so the first missing book is where ids and books differ
+	for i, id := range ids {
+		if i >= len(books) || !strings.EqualFold(books[i].ID, id) {
+			return nil, fmt.Errorf("book %s: %w", id, entity.ErrBookNotFound)
+		}
+	}
+
+	keys, err := l.citeKeys(ctx, books)
+	if logger.CheckError(err, l.logger, "Failed get cite keys", zap.Strings("book ids", ids), zap.Error(err)) {
+		return nil, err
+	}
+
+	citation, err := renderCitations(books, keys, format)
+	if logger.CheckError(err, l.logger, "Failed rendering citations", zap.Strings("book ids", ids), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Got the books citations", zap.Int("books", len(books)), zap.String("format", format.String()))
+	}
+
+	return &library.GetBookCitationsResponse{
+		ContentType: citationContentTypes[format],
+		Citation:    citation,
+		CiteKeys:    keys,
+	}, nil
+}
@@ -0,0 +1,205 @@
+package library
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// storeNewCiteKeys stores the generated keys of books cited the first time as the repository does.
+func storeNewCiteKeys(ctx context.Context, keys map[string]string) (map[string]string, error) {
+	return keys, nil
+}
+
+func testCitedBooks() []entity.ExportedBook {
+	return []entity.ExportedBook{
+		{
+			Book: entity.Book{
+				ID:              "3f2a9c1b-0000-4000-8000-000000000001",
+				Name:            "The Hitchhiker's Guide & 100% more",
+				ISBN:            "9780306406157",
+				PublicationYear: 1979,
+			},
+			AuthorNames:   []string{"Douglas Adams", "Émile Zola"},
+			PublisherName: "Pan Books",
+		},
+		{
+			Book: entity.Book{
+				ID:   "0a1b2c3d-0000-4000-8000-000000000002",
+				Name: "Беовульф",
+			},
+			AuthorNames: []string{"Homer"},
+		},
+	}
+}
+
+func TestCiteKey(t *testing.T) {
+	t.Parallel()
+
+	books := testCitedBooks()
+	require.Equal(t, "adams1979hitchhikers-3f2a9c1b", citeKey(books[0]))
+	require.Equal(t, "homernd-0a1b2c3d", citeKey(books[1]))
+	require.Equal(t, "anonnd-0a1b2c3d", citeKey(entity.ExportedBook{Book: books[1].Book}))
+}
+
+func TestGetBookCitation(t *testing.T) {
+	t.Parallel()
+
+	book := testCitedBooks()[0]
+
+	tests := []struct {
+		name        string
+		format      library.CitationFormat
+		contentType string
+		citation    string
+	}{
+		{name: "bibtex",
+			format:      library.CitationFormat_CITATION_FORMAT_BIBTEX,
+			contentType: "application/x-bibtex; charset=utf-8",
+			citation: "@book{adams1979hitchhikers-3f2a9c1b,\n" +
+				"  author = {Adams, Douglas and Zola, Émile},\n" +
+				"  title = {The Hitchhiker's Guide \\& 100\\% more},\n" +
+				"  year = {1979},\n" +
+				"  publisher = {Pan Books},\n" +
+				"  isbn = {9780306406157},\n" +
+				"}\n"},
+
+		{name: "ris",
+			format:      library.CitationFormat_CITATION_FORMAT_RIS,
+			contentType: "application/x-research-info-systems; charset=utf-8",
+			citation: "TY  - BOOK\r\n" +
+				"ID  - adams1979hitchhikers-3f2a9c1b\r\n" +
+				"AU  - Adams, Douglas\r\n" +
+				"AU  - Zola, Émile\r\n" +
+				"TI  - The Hitchhiker's Guide & 100% more\r\n" +
+				"PY  - 1979\r\n" +
+				"PB  - Pan Books\r\n" +
+				"SN  - 9780306406157\r\n" +
+				"ER  - \r\n"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			ctx, booksRepo, s := initBookTest(t)
+
+			booksRepo.EXPECT().GetExportedBook(ctx, book.ID).Return(book, nil).Times(2)
+			booksRepo.EXPECT().SaveCiteKeys(ctx, map[string]string{book.ID: "adams1979hitchhikers-3f2a9c1b"}).
+				DoAndReturn(storeNewCiteKeys).Times(2)
+
+			response, err := s.GetBookCitation(ctx, book.ID, test.format)
+			require.NoError(t, err)
+			require.Equal(t, &library.GetBookCitationResponse{
+				ContentType: test.contentType,
+				Citation:    test.citation,
+				CiteKey:     "adams1979hitchhikers-3f2a9c1b",
+			}, response)
+
+			again, err := s.GetBookCitation(ctx, book.ID, test.format)
+			require.NoError(t, err)
+			require.Equal(t, response, again)
+		})
+	}
+
+	t.Run("changed book keeps its key", func(t *testing.T) {
+		t.Parallel()
+		ctx, booksRepo, s := initBookTest(t)
+
+		renamed := book
+		renamed.Name = "Mostly Harmless"
+		renamed.PublicationYear = 1992
+
+		booksRepo.EXPECT().GetExportedBook(ctx, book.ID).Return(renamed, nil)
+		booksRepo.EXPECT().SaveCiteKeys(ctx, map[string]string{book.ID: "adams1992mostly-3f2a9c1b"}).
+			Return(map[string]string{book.ID: "adams1979hitchhikers-3f2a9c1b"}, nil)
+
+		response, err := s.GetBookCitation(ctx, book.ID, library.CitationFormat_CITATION_FORMAT_BIBTEX)
+		require.NoError(t, err)
+		require.Equal(t, "adams1979hitchhikers-3f2a9c1b", response.GetCiteKey())
+		require.Contains(t, response.GetCitation(), "@book{adams1979hitchhikers-3f2a9c1b,\n")
+		require.Contains(t, response.GetCitation(), "title = {Mostly Harmless}")
+	})
+
+	t.Run("cite key error", func(t *testing.T) {
+		t.Parallel()
+		ctx, booksRepo, s := initBookTest(t)
+
+		errSave := errors.New("save error")
+		booksRepo.EXPECT().GetExportedBook(ctx, book.ID).Return(book, nil)
+		booksRepo.EXPECT().SaveCiteKeys(ctx, gomock.Any()).Return(nil, errSave)
+
+		_, err := s.GetBookCitation(ctx, book.ID, library.CitationFormat_CITATION_FORMAT_BIBTEX)
+		require.ErrorIs(t, err, errSave)
+	})
+
+	t.Run("unknown book", func(t *testing.T) {
+		t.Parallel()
+		ctx, booksRepo, s := initBookTest(t)
+
+		booksRepo.EXPECT().GetExportedBook(ctx, book.ID).Return(entity.ExportedBook{}, entity.ErrBookNotFound)
+
+		_, err := s.GetBookCitation(ctx, book.ID, library.CitationFormat_CITATION_FORMAT_BIBTEX)
+		require.ErrorIs(t, err, entity.ErrBookNotFound)
+	})
+}
+
+func TestGetBookCitations(t *testing.T) {
+	t.Parallel()
+
+	books := testCitedBooks()
+	ids := []string{books[0].ID, books[1].ID}
+
+	t.Run("csl-json", func(t *testing.T) {
+		t.Parallel()
+		ctx, booksRepo, s := initBookTest(t)
+
+		booksRepo.EXPECT().GetExportedBooks(ctx, ids).Return(books, nil)
+		booksRepo.EXPECT().SaveCiteKeys(ctx, gomock.Any()).DoAndReturn(storeNewCiteKeys)
+
+		response, err := s.GetBookCitations(ctx, ids, library.CitationFormat_CITATION_FORMAT_CSL_JSON)
+		require.NoError(t, err)
+		require.Equal(t, "application/vnd.citationstyles.csl+json", response.GetContentType())
+		require.Equal(t, []string{"adams1979hitchhikers-3f2a9c1b", "homernd-0a1b2c3d"}, response.GetCiteKeys())
+
+		var items []map[string]any
+		require.NoError(t, json.Unmarshal([]byte(response.GetCitation()), &items))
+		require.Len(t, items, 2)
+		require.Equal(t, "adams1979hitchhikers-3f2a9c1b", items[0]["id"])
+		require.Equal(t, "book", items[0]["type"])
+		require.Equal(t, []any{map[string]any{"family": "Adams", "given": "Douglas"},
+			map[string]any{"family": "Zola", "given": "Émile"}}, items[0]["author"])
+		require.Equal(t, map[string]any{"date-parts": []any{[]any{1979.0}}}, items[0]["issued"])
+		require.Equal(t, "9780306406157", items[0]["ISBN"])
+		require.Equal(t, []any{map[string]any{"family": "Homer"}}, items[1]["author"])
+		require.NotContains(t, items[1], "issued")
+	})
+
+	t.Run("bibtex entries are separated", func(t *testing.T) {
+		t.Parallel()
+		ctx, booksRepo, s := initBookTest(t)
+
+		booksRepo.EXPECT().GetExportedBooks(ctx, ids).Return(books, nil)
+		booksRepo.EXPECT().SaveCiteKeys(ctx, gomock.Any()).DoAndReturn(storeNewCiteKeys)
+
+		response, err := s.GetBookCitations(ctx, ids, library.CitationFormat_CITATION_FORMAT_BIBTEX)
+		require.NoError(t, err)
+		require.Contains(t, response.GetCitation(), "}\n\n@book{homernd-0a1b2c3d,\n  author = {Homer},\n  title = {Беовульф},\n}\n")
+	})
+
+	t.Run("unknown book", func(t *testing.T) {
+		t.Parallel()
+		ctx, booksRepo, s := initBookTest(t)
+
+		booksRepo.EXPECT().GetExportedBooks(ctx, ids).Return(books[1:], nil)
+
+		_, err := s.GetBookCitations(ctx, ids, library.CitationFormat_CITATION_FORMAT_RIS)
+		require.ErrorIs(t, err, entity.ErrBookNotFound)
+		require.ErrorContains(t, err, books[0].ID)
+	})
+}
@@ -0,0 +1,88 @@
+package library
+
+import (
+	"context"
+
+	"github.com/project/library/pkg/logger"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultCollaboratorsDepth     = 1
+	defaultCollaborationPathDepth = 4
+)
+
+func convertCollaborator(collaborator *entity.Collaborator) *library.Collaborator {
+	return &library.Collaborator{
+		AuthorId:    collaborator.AuthorID,
+		Name:        collaborator.Name,
+		Depth:       uint32(collaborator.Depth),
+		SharedBooks: uint32(collaborator.SharedBooks),
+	}
+}
+
+func (l *libraryImpl) GetAuthorCollaborators(
+	ctx context.Context,
+	idAuthor string,
+	maxDepth uint32,
+) (*library.GetAuthorCollaboratorsResponse, error) {
+	if maxDepth == 0 {
+		maxDepth = defaultCollaboratorsDepth
+	}
+
+	collaborators, err := l.authorRepository.GetCollaborators(ctx, idAuthor, int64(maxDepth))
+
+	if logger.CheckError(err, l.logger, "Failed get collaborators", zap.String("id of author", idAuthor), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Got collaborators", zap.String("id of author", idAuthor), zap.Int("count", len(collaborators)))
+	}
+
+	result := make([]*library.Collaborator, len(collaborators))
+	for i := range collaborators {
+		result[i] = convertCollaborator(&collaborators[i])
+	}
+
+	return &library.GetAuthorCollaboratorsResponse{
+		Collaborators: result,
+	}, nil
+}
+
+func (l *libraryImpl) GetCollaborationPath(
+	ctx context.Context,
+	idFrom, idTo string,
+	maxDepth uint32,
+) (*library.GetCollaborationPathResponse, error) {
+	if maxDepth == 0 {
+		maxDepth = defaultCollaborationPathDepth
+	}
+
+	steps, err := l.authorRepository.GetCollaborationPath(ctx, idFrom, idTo, int64(maxDepth))
+
+	if logger.CheckError(err, l.logger, "Failed get collaboration path", zap.String("from", idFrom),
+		zap.String("to", idTo), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Got collaboration path", zap.String("from", idFrom), zap.String("to", idTo),
+			zap.Int("distance", len(steps)-1))
+	}
+
+	result := make([]*library.CollaborationStep, len(steps))
+	for i := range steps {
+		result[i] = &library.CollaborationStep{
+			AuthorId: steps[i].AuthorID,
+			Name:     steps[i].Name,
+			BookId:   steps[i].BookID,
+		}
+	}
+
+	return &library.GetCollaborationPathResponse{
+		Distance: uint32(len(steps) - 1),
+		Steps:    result,
+	}, nil
+}
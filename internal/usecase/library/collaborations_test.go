@@ -0,0 +1,97 @@
+package library
+
+import (
+	"testing"
+
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAuthorCollaborators(t *testing.T) {
+	t.Parallel()
+
+	const idAuthor = "123"
+
+	tests := []struct {
+		name         string
+		maxDepth     uint32
+		requireDepth int64
+		requireErr   error
+	}{
+		{name: "default depth",
+			requireDepth: defaultCollaboratorsDepth},
+		{name: "given depth",
+			maxDepth:     3,
+			requireDepth: 3},
+		{name: "internal error",
+			maxDepth:     2,
+			requireDepth: 2,
+			requireErr:   errInternalAuthor},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, mockAuthorRepo, s := initAuthorTest(t)
+
+			var collaborators []entity.Collaborator
+			if test.requireErr == nil {
+				collaborators = []entity.Collaborator{
+					{AuthorID: "1", Name: "First", Depth: 1, SharedBooks: 2},
+					{AuthorID: "2", Name: "Second", Depth: 2, SharedBooks: 1},
+				}
+			}
+			mockAuthorRepo.EXPECT().GetCollaborators(ctx, idAuthor, test.requireDepth).Return(collaborators, test.requireErr)
+
+			response, err := s.GetAuthorCollaborators(ctx, idAuthor, test.maxDepth)
+			require.ErrorIs(t, err, test.requireErr)
+			if test.requireErr != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Len(t, response.GetCollaborators(), 2)
+			require.Equal(t, uint32(2), response.GetCollaborators()[0].GetSharedBooks())
+			require.Equal(t, uint32(2), response.GetCollaborators()[1].GetDepth())
+		})
+	}
+}
+
+func TestGetCollaborationPath(t *testing.T) {
+	t.Parallel()
+
+	const (
+		idFrom = "123"
+		idTo   = "456"
+	)
+
+	ctx, mockAuthorRepo, s := initAuthorTest(t)
+
+	mockAuthorRepo.EXPECT().GetCollaborationPath(ctx, idFrom, idTo, int64(defaultCollaborationPathDepth)).
+		Return([]entity.CollaborationStep{
+			{AuthorID: idFrom, Name: "From"},
+			{AuthorID: "789", Name: "Middle", BookID: "1"},
+			{AuthorID: idTo, Name: "To", BookID: "2"},
+		}, nil)
+
+	response, err := s.GetCollaborationPath(ctx, idFrom, idTo, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), response.GetDistance())
+	require.Len(t, response.GetSteps(), 3)
+	require.Empty(t, response.GetSteps()[0].GetBookId())
+	require.Equal(t, "2", response.GetSteps()[2].GetBookId())
+
+	mockAuthorRepo.EXPECT().GetCollaborationPath(ctx, idFrom, idFrom, int64(1)).
+		Return([]entity.CollaborationStep{{AuthorID: idFrom, Name: "From"}}, nil)
+
+	response, err = s.GetCollaborationPath(ctx, idFrom, idFrom, 1)
+	require.NoError(t, err)
+	require.Zero(t, response.GetDistance())
+
+	mockAuthorRepo.EXPECT().GetCollaborationPath(ctx, idFrom, idTo, int64(6)).
+		Return(nil, entity.ErrCollaborationPathNotFound)
+
+	response, err = s.GetCollaborationPath(ctx, idFrom, idTo, 6)
+	require.ErrorIs(t, err, entity.ErrCollaborationPathNotFound)
+	require.Nil(t, response)
+}
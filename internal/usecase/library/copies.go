@@ -0,0 +1,174 @@
+package library
+
+import (
+	"context"
+
+	"github.com/project/library/pkg/logger"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var copyConditions = map[library.CopyCondition]entity.CopyCondition{
+	library.CopyCondition_COPY_CONDITION_NEW:     entity.ConditionNew,
+	library.CopyCondition_COPY_CONDITION_GOOD:    entity.ConditionGood,
+	library.CopyCondition_COPY_CONDITION_FAIR:    entity.ConditionFair,
+	library.CopyCondition_COPY_CONDITION_POOR:    entity.ConditionPoor,
+	library.CopyCondition_COPY_CONDITION_DAMAGED: entity.ConditionDamaged,
+}
+
+var copyStatuses = map[library.CopyStatus]entity.CopyStatus{
+	library.CopyStatus_COPY_STATUS_AVAILABLE:  entity.CopyAvailable,
+	library.CopyStatus_COPY_STATUS_ON_LOAN:    entity.CopyOnLoan,
+	library.CopyStatus_COPY_STATUS_LOST:       entity.CopyLost,
+	library.CopyStatus_COPY_STATUS_WITHDRAWN:  entity.CopyWithdrawn,
+	library.CopyStatus_COPY_STATUS_ON_HOLD:    entity.CopyOnHold,
+	library.CopyStatus_COPY_STATUS_IN_TRANSIT: entity.CopyInTransit,
+}
+
+func convertConditionToAPI(condition entity.CopyCondition) library.CopyCondition {
+	for apiCondition, c := range copyConditions {
+		if c == condition {
+			return apiCondition
+		}
+	}
+	return library.CopyCondition_COPY_CONDITION_UNSPECIFIED
+}
+
+func convertStatusToAPI(status entity.CopyStatus) library.CopyStatus {
+	for apiStatus, s := range copyStatuses {
+		if s == status {
+			return apiStatus
+		}
+	}
+	return library.CopyStatus_COPY_STATUS_UNSPECIFIED
+}
+
+func convertCopy(c *entity.Copy) *library.Copy {
+	return &library.Copy{
+		Id:              c.ID,
+		BookId:          c.BookID,
+		Barcode:         c.Barcode,
+		Location:        c.Location,
+		AcquisitionDate: convertDateToAPI(c.AcquisitionDate),
+		Condition:       convertConditionToAPI(c.Condition),
+		Status:          convertStatusToAPI(c.Status),
+		HomeBranchId:    c.HomeBranchID,
+		CurrentBranchId: c.CurrentBranchID,
+		CreatedAt:       timestamppb.New(c.CreatedAt),
+		UpdatedAt:       timestamppb.New(c.UpdatedAt),
+	}
+}
+
+func convertAvailability(availability entity.Availability) *library.CopyAvailability {
+	return &library.CopyAvailability{
+		Total:     uint32(availability.Total),
+		Available: uint32(availability.Available),
+	}
+}
+
+func (l *libraryImpl) AddCopy(ctx context.Context, newCopy *library.Copy) (*library.AddCopyResponse, error) {
+	result, err := l.copyRepository.AddCopy(ctx, entity.Copy{
+		BookID:          newCopy.GetBookId(),
+		Barcode:         newCopy.GetBarcode(),
+		Location:        newCopy.GetLocation(),
+		AcquisitionDate: convertDate(newCopy.GetAcquisitionDate()),
+		Condition:       copyConditions[newCopy.GetCondition()],
+		HomeBranchID:    newCopy.GetHomeBranchId(),
+	})
+
+	if logger.CheckError(err, l.logger, "Failed adding copy", zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Added copy", zap.String("id", result.ID), zap.String("id of book", result.BookID))
+	}
+
+	return &library.AddCopyResponse{
+		Copy: convertCopy(&result),
+	}, nil
+}
+
+func (l *libraryImpl) UpdateCopy(ctx context.Context, updCopy *library.Copy) error {
+	err := l.copyRepository.UpdateCopy(ctx, entity.Copy{
+		ID:              updCopy.GetId(),
+		Barcode:         updCopy.GetBarcode(),
+		Location:        updCopy.GetLocation(),
+		AcquisitionDate: convertDate(updCopy.GetAcquisitionDate()),
+		Condition:       copyConditions[updCopy.GetCondition()],
+		Status:          copyStatuses[updCopy.GetStatus()],
+		HomeBranchID:    updCopy.GetHomeBranchId(),
+	})
+
+	if !logger.CheckError(err, l.logger, "Failed updating copy", zap.Error(err)) {
+		if l.logger != nil {
+			l.logger.Info("Updated copy", zap.String("id", updCopy.GetId()))
+		}
+	}
+
+	return err
+}
+
+func (l *libraryImpl) GetCopy(ctx context.Context, idCopy string) (*library.GetCopyResponse, error) {
+	result, err := l.copyRepository.GetCopy(ctx, idCopy)
+
+	if logger.CheckError(err, l.logger, "Failed get copy", zap.String("id of copy", idCopy), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Get the copy", zap.String("id of copy", idCopy))
+	}
+
+	return &library.GetCopyResponse{
+		Copy: convertCopy(&result),
+	}, nil
+}
+
+func (l *libraryImpl) GetCopyByBarcode(ctx context.Context, barcode string) (*library.GetCopyByBarcodeResponse, error) {
+	result, err := l.copyRepository.GetCopyByBarcode(ctx, barcode)
+
+	if logger.CheckError(err, l.logger, "Failed get copy by barcode", zap.String("barcode", barcode), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Get the copy by barcode", zap.String("barcode", barcode))
+	}
+
+	return &library.GetCopyByBarcodeResponse{
+		Copy: convertCopy(&result),
+	}, nil
+}
+
+func (l *libraryImpl) DeleteCopy(ctx context.Context, idCopy string) error {
+	err := l.copyRepository.DeleteCopy(ctx, idCopy)
+
+	if !logger.CheckError(err, l.logger, "Failed deleting copy", zap.Error(err)) {
+		if l.logger != nil {
+			l.logger.Info("Deleted copy", zap.String("id", idCopy))
+		}
+	}
+
+	return err
+}
+
+func (l *libraryImpl) GetBookCopies(ctx context.Context, idBook, idBranch string) (*library.GetBookCopiesResponse, error) {
+	copies, err := l.copyRepository.GetBookCopies(ctx, idBook, idBranch)
+
+	if logger.CheckError(err, l.logger, "Failed get copies of book", zap.String("id of book", idBook), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Get copies of book", zap.String("id of book", idBook), zap.Int("count", len(copies)))
+	}
+
+	result := make([]*library.Copy, 0, len(copies))
+	for i := range copies {
+		result = append(result, convertCopy(&copies[i]))
+	}
+
+	return &library.GetBookCopiesResponse{
+		Copies: result,
+	}, nil
+}
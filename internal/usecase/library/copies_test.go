@@ -0,0 +1,261 @@
+package library
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/usecase/library/mocks"
+
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var errInternalCopies = errors.New("internal error")
+
+func initCopyTest(t *testing.T) (context.Context, *mocks.MockBooksRepository, *mocks.MockCopyRepository, *libraryImpl) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockBooksRepo := mocks.NewMockBooksRepository(ctrl)
+	mockCopyRepo := mocks.NewMockCopyRepository(ctrl)
+	mockReviewRepo := mocks.NewMockReviewRepository(ctrl)
+	mockReviewRepo.EXPECT().GetBookRating(gomock.Any(), gomock.Any()).Return(entity.Rating{}, nil).AnyTimes()
+	ctx := context.Background()
+	logger, e := zap.NewProduction()
+	require.NoError(t, e)
+
+	cuc := New(logger, Repositories{Books: mockBooksRepo, Copy: mockCopyRepo, Review: mockReviewRepo}, Options{})
+	return ctx, mockBooksRepo, mockCopyRepo, cuc
+}
+
+func TestAddCopy(t *testing.T) {
+	t.Parallel()
+
+	const (
+		id      = "123"
+		idBook  = "456"
+		barcode = "LIB-0001"
+	)
+	acquired := time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		requireErr error
+	}{
+		{name: "valid add copy"},
+		{name: "add copy with existing barcode",
+			requireErr: entity.ErrCopyAlreadyExists},
+		{name: "add copy of unknown book",
+			requireErr: entity.ErrBookNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, _, mockCopyRepo, s := initCopyTest(t)
+
+			mockCopyRepo.EXPECT().AddCopy(ctx, entity.Copy{
+				BookID:          idBook,
+				Barcode:         barcode,
+				Location:        "A-1",
+				AcquisitionDate: &acquired,
+				Condition:       entity.ConditionNew,
+			}).DoAndReturn(func(_ context.Context, c entity.Copy) (entity.Copy, error) {
+				if test.requireErr != nil {
+					return entity.Copy{}, test.requireErr
+				}
+				c.ID = id
+				c.Status = entity.CopyAvailable
+				return c, nil
+			})
+
+			response, err := s.AddCopy(ctx, &library.Copy{
+				BookId:          idBook,
+				Barcode:         barcode,
+				Location:        "A-1",
+				AcquisitionDate: timestamppb.New(acquired),
+				Condition:       library.CopyCondition_COPY_CONDITION_NEW,
+			})
+			require.Equal(t, test.requireErr, err)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, id, response.GetCopy().GetId())
+			require.Equal(t, library.CopyStatus_COPY_STATUS_AVAILABLE, response.GetCopy().GetStatus())
+			require.Equal(t, library.CopyCondition_COPY_CONDITION_NEW, response.GetCopy().GetCondition())
+			require.True(t, acquired.Equal(response.GetCopy().GetAcquisitionDate().AsTime()))
+		})
+	}
+}
+
+func TestUpdateDeleteCopy(t *testing.T) {
+	t.Parallel()
+
+	const id = "123"
+
+	tests := []struct {
+		name       string
+		delete     bool
+		requireErr error
+	}{
+		{name: "valid update copy"},
+		{name: "update unknown copy",
+			requireErr: entity.ErrCopyNotFound},
+		{name: "valid delete copy",
+			delete: true},
+		{name: "delete with internal error",
+			delete:     true,
+			requireErr: errInternalCopies},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, _, mockCopyRepo, s := initCopyTest(t)
+
+			var err error
+			if test.delete {
+				mockCopyRepo.EXPECT().DeleteCopy(ctx, id).Return(test.requireErr)
+				err = s.DeleteCopy(ctx, id)
+			} else {
+				mockCopyRepo.EXPECT().UpdateCopy(ctx, entity.Copy{
+					ID:        id,
+					Barcode:   "LIB-0002",
+					Condition: entity.ConditionPoor,
+					Status:    entity.CopyLost,
+				}).Return(test.requireErr)
+				err = s.UpdateCopy(ctx, &library.Copy{
+					Id:        id,
+					Barcode:   "LIB-0002",
+					Condition: library.CopyCondition_COPY_CONDITION_POOR,
+					Status:    library.CopyStatus_COPY_STATUS_LOST,
+				})
+			}
+			require.Equal(t, test.requireErr, err)
+		})
+	}
+}
+
+func TestGetCopy(t *testing.T) {
+	t.Parallel()
+
+	const (
+		id      = "123"
+		barcode = "LIB-0001"
+	)
+
+	tests := []struct {
+		name       string
+		byBarcode  bool
+		requireErr error
+	}{
+		{name: "valid get copy"},
+		{name: "get unknown copy",
+			requireErr: entity.ErrCopyNotFound},
+		{name: "valid get copy by barcode",
+			byBarcode: true},
+		{name: "get copy by unknown barcode",
+			byBarcode:  true,
+			requireErr: entity.ErrCopyNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, _, mockCopyRepo, s := initCopyTest(t)
+			result := entity.Copy{
+				ID:        id,
+				Barcode:   barcode,
+				Condition: entity.ConditionGood,
+				Status:    entity.CopyOnLoan,
+			}
+			if test.requireErr != nil {
+				result = entity.Copy{}
+			}
+
+			var (
+				c   *library.Copy
+				err error
+			)
+			if test.byBarcode {
+				mockCopyRepo.EXPECT().GetCopyByBarcode(ctx, barcode).Return(result, test.requireErr)
+				var response *library.GetCopyByBarcodeResponse
+				response, err = s.GetCopyByBarcode(ctx, barcode)
+				c = response.GetCopy()
+			} else {
+				mockCopyRepo.EXPECT().GetCopy(ctx, id).Return(result, test.requireErr)
+				var response *library.GetCopyResponse
+				response, err = s.GetCopy(ctx, id)
+				c = response.GetCopy()
+			}
+
+			require.Equal(t, test.requireErr, err)
+			if err != nil {
+				require.Nil(t, c)
+				return
+			}
+			require.Equal(t, id, c.GetId())
+			require.Equal(t, barcode, c.GetBarcode())
+			require.Nil(t, c.GetAcquisitionDate())
+			require.Equal(t, library.CopyStatus_COPY_STATUS_ON_LOAN, c.GetStatus())
+		})
+	}
+}
+
+func TestGetBookCopies(t *testing.T) {
+	t.Parallel()
+
+	const idBook = "456"
+
+	ctx, _, mockCopyRepo, s := initCopyTest(t)
+	mockCopyRepo.EXPECT().GetBookCopies(ctx, idBook, "").Return([]entity.Copy{
+		{ID: "1", BookID: idBook, Barcode: "A", Condition: entity.ConditionGood, Status: entity.CopyAvailable},
+		{ID: "2", BookID: idBook, Barcode: "B", Condition: entity.ConditionDamaged, Status: entity.CopyWithdrawn},
+	}, nil)
+
+	response, err := s.GetBookCopies(ctx, idBook, "")
+	require.NoError(t, err)
+	require.Len(t, response.GetCopies(), 2)
+	require.Equal(t, library.CopyCondition_COPY_CONDITION_DAMAGED, response.GetCopies()[1].GetCondition())
+	require.Equal(t, library.CopyStatus_COPY_STATUS_WITHDRAWN, response.GetCopies()[1].GetStatus())
+
+	ctx, _, mockCopyRepo, s = initCopyTest(t)
+	mockCopyRepo.EXPECT().GetBookCopies(ctx, idBook, "").Return(nil, errInternalCopies)
+
+	response, err = s.GetBookCopies(ctx, idBook, "")
+	require.Equal(t, errInternalCopies, err)
+	require.Nil(t, response)
+}
+
+func TestGetBookInfoAvailability(t *testing.T) {
+	t.Parallel()
+
+	const idBook = "456"
+
+	ctx, mockBooksRepo, mockCopyRepo, s := initCopyTest(t)
+	mockBooksRepo.EXPECT().GetBook(ctx, idBook).Return(entity.Book{ID: idBook}, nil)
+	mockCopyRepo.EXPECT().GetBookAvailability(ctx, idBook, "").Return(entity.Availability{Total: 3, Available: 1}, nil)
+
+	response, err := s.GetBookInfo(ctx, idBook, "", "")
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), response.GetAvailability().GetTotal())
+	require.Equal(t, uint32(1), response.GetAvailability().GetAvailable())
+
+	ctx, mockBooksRepo, mockCopyRepo, s = initCopyTest(t)
+	mockBooksRepo.EXPECT().GetBook(ctx, idBook).Return(entity.Book{ID: idBook}, nil)
+	mockCopyRepo.EXPECT().GetBookAvailability(ctx, idBook, "").Return(entity.Availability{}, errInternalCopies)
+
+	response, err = s.GetBookInfo(ctx, idBook, "", "")
+	require.Equal(t, errInternalCopies, err)
+	require.Nil(t, response)
+}
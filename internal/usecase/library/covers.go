@@ -0,0 +1,214 @@
+package library
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"net/http"
+
+	"github.com/project/library/pkg/logger"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"go.uber.org/zap"
+)
+
+const (
+	coverChunkSize       = 64 * 1024
+	maxCoverPixels       = 40_000_000
+	thumbnailJPEGQuality = 85
+)
+
+// coverThumbnails are the standard sizes of thumbnails with the maximum length of their sides.
+var coverThumbnails = []struct {
+	size entity.CoverSize
+	side int
+}{
+	{size: entity.CoverSizeSmall, side: 160},
+	{size: entity.CoverSizeMedium, side: 320},
+	{size: entity.CoverSizeLarge, side: 640},
+}
+
+var coverSizes = map[library.CoverSize]entity.CoverSize{
+	library.CoverSize_COVER_SIZE_ORIGINAL: entity.CoverSizeOriginal,
+	library.CoverSize_COVER_SIZE_SMALL:    entity.CoverSizeSmall,
+	library.CoverSize_COVER_SIZE_MEDIUM:   entity.CoverSizeMedium,
+	library.CoverSize_COVER_SIZE_LARGE:    entity.CoverSizeLarge,
+}
+
+func (l *libraryImpl) UploadBookCover(ctx context.Context, idBook string, cover io.Reader) (*library.UploadBookCoverResponse, error) {
+	data, err := io.ReadAll(io.LimitReader(cover, l.maxCoverSize+1))
+	if logger.CheckError(err, l.logger, "Failed reading cover", zap.String("book id", idBook), zap.Error(err)) {
+		return nil, err
+	}
+
+	if int64(len(data)) > l.maxCoverSize {
+		return nil, fmt.Errorf("cover is larger than %d bytes: %w", l.maxCoverSize, entity.ErrInvalidCover)
+	}
+
+	if _, err = l.booksRepository.GetBook(ctx, idBook); err != nil {
+		return nil, err
+	}
+
+	covers, images, err := makeCovers(data)
+	if logger.CheckError(err, l.logger, "Failed processing cover", zap.String("book id", idBook), zap.Error(err)) {
+		return nil, err
+	}
+
+	for i := range covers {
+		covers[i].BookID = idBook
+		covers[i].Hash, err = l.fileStorage.SaveFile(ctx, images[i])
+		if logger.CheckError(err, l.logger, "Failed saving cover", zap.String("book id", idBook), zap.Error(err)) {
+			return nil, err
+		}
+	}
+
+	err = l.coverRepository.SetBookCover(ctx, idBook, covers)
+	if logger.CheckError(err, l.logger, "Failed setting cover", zap.String("book id", idBook), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Uploaded the book cover", zap.String("book id", idBook), zap.String("hash", covers[0].Hash))
+	}
+
+	return &library.UploadBookCoverResponse{
+		Hash:        covers[0].Hash,
+		ContentType: covers[0].ContentType,
+		Size:        int64(len(data)),
+		Width:       int32(covers[0].Width),
+		Height:      int32(covers[0].Height),
+	}, nil
+}
+
+func (l *libraryImpl) DownloadBookCover(ctx context.Context, idBook string, size library.CoverSize) (<-chan *library.DownloadBookCoverResponse, error) {
+	cover, err := l.coverRepository.GetBookCover(ctx, idBook, coverSizes[size])
+	if logger.CheckError(err, l.logger, "Failed get cover", zap.String("book id", idBook), zap.Error(err)) {
+		return nil, err
+	}
+
+	data, err := l.fileStorage.ReadFile(ctx, cover.Hash)
+	if logger.CheckError(err, l.logger, "Failed reading cover", zap.String("hash", cover.Hash), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Get the book cover", zap.String("book id", idBook), zap.String("size", string(cover.Size)))
+	}
+
+	ans := make(chan *library.DownloadBookCoverResponse)
+	go func() {
+		defer close(ans)
+		for offset := 0; offset < len(data); offset += coverChunkSize {
+			chunk := &library.DownloadBookCoverResponse{
+				Chunk: data[offset:min(offset+coverChunkSize, len(data))],
+			}
+			if offset == 0 {
+				chunk.ContentType = cover.ContentType
+			}
+
+			select {
+			case ans <- chunk:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ans, nil
+}
+
+// makeCovers returns the original cover and its thumbnails in the standard sizes with their images.
+// Thumbnail is the original image, if it already fits the size.
+func makeCovers(data []byte) ([]entity.Cover, [][]byte, error) {
+	contentType := http.DetectContentType(data)
+
+	var img image.Image
+	original := entity.Cover{
+		Size:        entity.CoverSizeOriginal,
+		ContentType: contentType,
+	}
+
+	switch contentType {
+	case "image/jpeg", "image/png":
+		config, _, err := image.DecodeConfig(bytes.NewReader(data))
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", err.Error(), entity.ErrInvalidCover)
+		}
+		if config.Width*config.Height > maxCoverPixels {
+			return nil, nil, fmt.Errorf("cover has more than %d pixels: %w", maxCoverPixels, entity.ErrInvalidCover)
+		}
+
+		if img, _, err = image.Decode(bytes.NewReader(data)); err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", err.Error(), entity.ErrInvalidCover)
+		}
+		original.Width, original.Height = config.Width, config.Height
+	default:
+		return nil, nil, fmt.Errorf("unsupported format %s, only JPEG and PNG are allowed: %w", contentType, entity.ErrInvalidCover)
+	}
+
+	covers := []entity.Cover{original}
+	images := [][]byte{data}
+	for _, t := range coverThumbnails {
+		cover, thumbnailData := original, data
+		cover.Size = t.size
+
+		if original.Width > t.side || original.Height > t.side {
+			thumbnailImg := thumbnail(img, t.side)
+			var buf bytes.Buffer
+			var err error
+			if contentType == "image/png" {
+				err = png.Encode(&buf, thumbnailImg)
+			} else {
+				err = jpeg.Encode(&buf, thumbnailImg, &jpeg.Options{Quality: thumbnailJPEGQuality})
+			}
+			if err != nil {
+				return nil, nil, err
+			}
+
+			cover.Width, cover.Height = thumbnailImg.Bounds().Dx(), thumbnailImg.Bounds().Dy()
+			thumbnailData = buf.Bytes()
+		}
+
+		covers = append(covers, cover)
+		images = append(images, thumbnailData)
+	}
+
+	return covers, images, nil
+}
+
+// thumbnail scales down the image to fit the square with the given side by averaging pixels.
+func thumbnail(img image.Image, side int) image.Image {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	newWidth, newHeight := side, side
+	if width > height {
+		newHeight = max(1, height*side/width)
+	} else {
+		newWidth = max(1, width*side/height)
+	}
+
+	result := image.NewRGBA64(image.Rect(0, 0, newWidth, newHeight))
+	for y := 0; y < newHeight; y++ {
+		minY, maxY := bounds.Min.Y+y*height/newHeight, bounds.Min.Y+(y+1)*height/newHeight
+		for x := 0; x < newWidth; x++ {
+			minX, maxX := bounds.Min.X+x*width/newWidth, bounds.Min.X+(x+1)*width/newWidth
+
+			var r, g, b, a, n uint64
+			for sy := minY; sy < maxY; sy++ {
+				for sx := minX; sx < maxX; sx++ {
+					pr, pg, pb, pa := img.At(sx, sy).RGBA()
+					r, g, b, a = r+uint64(pr), g+uint64(pg), b+uint64(pb), a+uint64(pa)
+					n++
+				}
+			}
+			result.SetRGBA64(x, y, color.RGBA64{R: uint16(r / n), G: uint16(g / n), B: uint16(b / n), A: uint16(a / n)})
+		}
+	}
+
+	return result
+}
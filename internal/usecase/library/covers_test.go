@@ -0,0 +1,232 @@
+package library
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+const testMaxCoverSize = 1 << 20
+
+var errInternalCover = errors.New("internal error")
+
+type coverMocks struct {
+	books   *mocks.MockBooksRepository
+	covers  *mocks.MockCoverRepository
+	storage *mocks.MockFileStorage
+}
+
+func initCoverTest(t *testing.T) (context.Context, coverMocks, *libraryImpl) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	m := coverMocks{
+		books:   mocks.NewMockBooksRepository(ctrl),
+		covers:  mocks.NewMockCoverRepository(ctrl),
+		storage: mocks.NewMockFileStorage(ctrl),
+	}
+	ctx := context.Background()
+	logger, e := zap.NewProduction()
+	require.NoError(t, e)
+
+	cuc := New(logger, Repositories{Books: m.books, Cover: m.covers, FileStorage: m.storage}, Options{MaxCoverSize: testMaxCoverSize})
+	return ctx, m, cuc
+}
+
+func makeTestImage(t *testing.T, width, height int, encode func(*bytes.Buffer, image.Image) error) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, encode(&buf, img))
+	return buf.Bytes()
+}
+
+func encodePNG(buf *bytes.Buffer, img image.Image) error {
+	return png.Encode(buf, img)
+}
+
+func encodeJPEG(buf *bytes.Buffer, img image.Image) error {
+	return jpeg.Encode(buf, img, nil)
+}
+
+func TestUploadBookCover(t *testing.T) {
+	t.Parallel()
+
+	const id = "123"
+	largePNG := makeTestImage(t, 800, 400, encodePNG)
+	smallJPEG := makeTestImage(t, 100, 120, encodeJPEG)
+
+	tests := []struct {
+		name        string
+		data        []byte
+		contentType string
+		sizes       [][2]int
+	}{
+		{name: "png with thumbnails",
+			data:        largePNG,
+			contentType: "image/png",
+			sizes:       [][2]int{{800, 400}, {160, 80}, {320, 160}, {640, 320}}},
+		{name: "small jpeg is its own thumbnail",
+			data:        smallJPEG,
+			contentType: "image/jpeg",
+			sizes:       [][2]int{{100, 120}, {100, 120}, {100, 120}, {100, 120}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, m, s := initCoverTest(t)
+			m.books.EXPECT().GetBook(ctx, id).Return(entity.Book{ID: id}, nil)
+
+			var saved [][]byte
+			m.storage.EXPECT().SaveFile(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, data []byte) (string, error) {
+				saved = append(saved, data)
+				return string(rune('a' + len(saved) - 1)), nil
+			}).Times(len(test.sizes))
+
+			m.covers.EXPECT().SetBookCover(ctx, id, gomock.Any()).DoAndReturn(func(ctx context.Context, idBook string, covers []entity.Cover) error {
+				require.Len(t, covers, len(test.sizes))
+				sizes := []entity.CoverSize{entity.CoverSizeOriginal, entity.CoverSizeSmall, entity.CoverSizeMedium, entity.CoverSizeLarge}
+				for i, cover := range covers {
+					require.Equal(t, sizes[i], cover.Size)
+					require.Equal(t, id, cover.BookID)
+					require.Equal(t, test.contentType, cover.ContentType)
+					require.Equal(t, test.sizes[i], [2]int{cover.Width, cover.Height})
+					require.Equal(t, string(rune('a'+i)), cover.Hash)
+
+					img, _, err := image.DecodeConfig(bytes.NewReader(saved[i]))
+					require.NoError(t, err)
+					require.Equal(t, test.sizes[i], [2]int{img.Width, img.Height})
+				}
+				return nil
+			})
+
+			response, err := s.UploadBookCover(ctx, id, bytes.NewReader(test.data))
+			require.NoError(t, err)
+			require.Equal(t, &library.UploadBookCoverResponse{
+				Hash:        "a",
+				ContentType: test.contentType,
+				Size:        int64(len(test.data)),
+				Width:       int32(test.sizes[0][0]),
+				Height:      int32(test.sizes[0][1]),
+			}, response)
+		})
+	}
+}
+
+func TestUploadInvalidBookCover(t *testing.T) {
+	t.Parallel()
+
+	const id = "123"
+	tests := []struct {
+		name       string
+		data       []byte
+		getBookErr error
+		requireErr error
+	}{
+		{name: "too large cover",
+			data:       bytes.Repeat([]byte{0}, testMaxCoverSize+1),
+			requireErr: entity.ErrInvalidCover},
+		{name: "unsupported format",
+			data:       []byte("GIF89a..."),
+			requireErr: entity.ErrInvalidCover},
+		{name: "webp",
+			data:       []byte("RIFF\x00\x00\x00\x00WEBPVP8 "),
+			requireErr: entity.ErrInvalidCover},
+		{name: "broken png",
+			data:       []byte("\x89PNG\r\n\x1a\n broken"),
+			requireErr: entity.ErrInvalidCover},
+		{name: "unknown book",
+			data:       []byte("\x89PNG\r\n\x1a\n broken"),
+			getBookErr: entity.ErrBookNotFound,
+			requireErr: entity.ErrBookNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, m, s := initCoverTest(t)
+			m.books.EXPECT().GetBook(ctx, id).Return(entity.Book{ID: id}, test.getBookErr).MaxTimes(1)
+
+			response, err := s.UploadBookCover(ctx, id, bytes.NewReader(test.data))
+			require.ErrorIs(t, err, test.requireErr)
+			require.Nil(t, response)
+		})
+	}
+}
+
+func TestDownloadBookCover(t *testing.T) {
+	t.Parallel()
+
+	const (
+		id   = "123"
+		hash = "hash"
+	)
+	data := bytes.Repeat([]byte{1}, coverChunkSize+10)
+
+	tests := []struct {
+		name       string
+		coverErr   error
+		readErr    error
+		requireErr error
+	}{
+		{name: "valid download"},
+		{name: "book without cover",
+			coverErr:   entity.ErrCoverNotFound,
+			requireErr: entity.ErrCoverNotFound},
+		{name: "lost file",
+			readErr:    errInternalCover,
+			requireErr: errInternalCover},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, m, s := initCoverTest(t)
+			m.covers.EXPECT().GetBookCover(ctx, id, entity.CoverSizeSmall).Return(entity.Cover{
+				BookID:      id,
+				Size:        entity.CoverSizeSmall,
+				Hash:        hash,
+				ContentType: "image/png",
+			}, test.coverErr)
+			if test.coverErr == nil {
+				m.storage.EXPECT().ReadFile(ctx, hash).Return(data, test.readErr)
+			}
+
+			chunks, err := s.DownloadBookCover(ctx, id, library.CoverSize_COVER_SIZE_SMALL)
+			require.Equal(t, test.requireErr, err)
+			if err != nil {
+				return
+			}
+
+			var result []*library.DownloadBookCoverResponse
+			for chunk := range chunks {
+				result = append(result, chunk)
+			}
+			require.Len(t, result, 2)
+			require.Equal(t, "image/png", result[0].GetContentType())
+			require.Empty(t, result[1].GetContentType())
+			require.Equal(t, data, append(result[0].GetChunk(), result[1].GetChunk()...))
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package library
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/project/library/pkg/logger"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"go.uber.org/zap"
+)
+
+const exportChunkSize = 64 * 1024
+
+var exportContentTypes = map[library.ExportFormat]string{
+	library.ExportFormat_EXPORT_FORMAT_CSV:    "text/csv; charset=utf-8",
+	library.ExportFormat_EXPORT_FORMAT_NDJSON: "application/x-ndjson",
+}
+
+var exportCSVHeader = []string{
+	"id", "title", "authors", "author_ids", "isbn", "publisher_id", "work_id", "created_at", "updated_at",
+}
+
+// exportEncoder writes books of the exported catalog in one of the export formats.
+type exportEncoder interface {
+	Encode(book entity.ExportedBook) error
+	Flush() error
+}
+
+type csvExportEncoder struct {
+	writer *csv.Writer
+}
+
+func (e *csvExportEncoder) Encode(book entity.ExportedBook) error {
+	return e.writer.Write([]string{
+		book.ID,
+		book.Name,
+		strings.Join(book.AuthorNames, importAuthorsSeparator),
+		strings.Join(book.AuthorIDs, importAuthorsSeparator),
+		book.ISBN,
+		book.PublisherID,
+		book.WorkID,
+		book.CreatedAt.UTC().Format(time.RFC3339Nano),
+		book.UpdatedAt.UTC().Format(time.RFC3339Nano),
+	})
+}
+
+func (e *csvExportEncoder) Flush() error {
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+type exportedAuthorJSON struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type exportedBookJSON struct {
+	ID          string               `json:"id"`
+	Title       string               `json:"title"`
+	Authors     []exportedAuthorJSON `json:"authors"`
+	ISBN        string               `json:"isbn,omitempty"`
+	PublisherID string               `json:"publisher_id,omitempty"`
+	WorkID      string               `json:"work_id,omitempty"`
+	CreatedAt   time.Time            `json:"created_at"`
+	UpdatedAt   time.Time            `json:"updated_at"`
+}
+
+type ndjsonExportEncoder struct {
+	encoder *json.Encoder
+}
+
+func (e *ndjsonExportEncoder) Encode(book entity.ExportedBook) error {
+	authors := make([]exportedAuthorJSON, len(book.AuthorIDs))
+	for i, id := range book.AuthorIDs {
+		authors[i] = exportedAuthorJSON{ID: id, Name: book.AuthorNames[i]}
+	}
+
+	return e.encoder.Encode(exportedBookJSON{
+		ID:          book.ID,
+		Title:       book.Name,
+		Authors:     authors,
+		ISBN:        book.ISBN,
+		PublisherID: book.PublisherID,
+		WorkID:      book.WorkID,
+		CreatedAt:   book.CreatedAt.UTC(),
+		UpdatedAt:   book.UpdatedAt.UTC(),
+	})
+}
+
+func (e *ndjsonExportEncoder) Flush() error {
+	return nil
+}
+
+func newExportEncoder(format library.ExportFormat, w io.Writer) (exportEncoder, error) {
+	if format == library.ExportFormat_EXPORT_FORMAT_NDJSON {
+		encoder := json.NewEncoder(w)
+		encoder.SetEscapeHTML(false)
+		return &ndjsonExportEncoder{encoder: encoder}, nil
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(exportCSVHeader); err != nil {
+		return nil, err
+	}
+	return &csvExportEncoder{writer: writer}, nil
+}
+
+// chunkWriter splits the written data into chunks of exportChunkSize made by newChunk,
+// the first chunk contains content type of the data.
+type chunkWriter[T any] struct {
+	ctx         context.Context
+	ans         chan<- T
+	contentType string
+	newChunk    func(contentType string, data []byte) T
+	buf         []byte
+}
+
+func (w *chunkWriter[T]) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for len(w.buf) >= exportChunkSize {
+		if err := w.send(w.buf[:exportChunkSize]); err != nil {
+			return 0, err
+		}
+		w.buf = w.buf[exportChunkSize:]
+	}
+	return len(p), nil
+}
+
+// Close sends the rest of the data, the content type is sent even if there is no data.
+func (w *chunkWriter[T]) Close() error {
+	if len(w.buf) == 0 && w.contentType == "" {
+		return nil
+	}
+	return w.send(w.buf)
+}
+
+func (w *chunkWriter[T]) send(data []byte) error {
+	chunk := w.newChunk(w.contentType, append([]byte(nil), data...))
+	w.contentType = ""
+
+	select {
+	case w.ans <- chunk:
+		return nil
+	case <-w.ctx.Done():
+		return w.ctx.Err()
+	}
+}
+
+// writeCatalog encodes books in the format to w. Books are read till the channel is closed,
+// then the error of their stream is checked, so a broken stream doesn't look like the whole catalog.
+func writeCatalog(format library.ExportFormat, w io.WriteCloser, books <-chan entity.ExportedBook, booksErr func() error) error {
+	encoder, err := newExportEncoder(format, w)
+	if err != nil {
+		return err
+	}
+	for book := range books {
+		if err = encoder.Encode(book); err != nil {
+			return fmt.Errorf("encode book %s: %w", book.ID, err)
+		}
+	}
+	if err = booksErr(); err != nil {
+		return err
+	}
+
+	if err = encoder.Flush(); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+// ExportCatalog streams the catalog in the format, the returned function reports the error
+// the stream was broken by, it must be called after the channel is closed.
+func (l *libraryImpl) ExportCatalog(
+	ctx context.Context,
+	format library.ExportFormat,
+	updatedFrom, updatedTo time.Time,
+) (<-chan *library.ExportCatalogResponse, func() error, error) {
+	books, booksErr, err := l.booksRepository.ExportBooks(ctx, updatedFrom, updatedTo)
+	if logger.CheckError(err, l.logger, "Failed exporting catalog", zap.Error(err)) {
+		return nil, nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Exporting catalog", zap.String("format", format.String()),
+			zap.Time("updated from", updatedFrom), zap.Time("updated to", updatedTo))
+	}
+
+	ans := make(chan *library.ExportCatalogResponse)
+	var streamErr error
+	go func() {
+		defer close(ans)
+		w := &chunkWriter[*library.ExportCatalogResponse]{
+			ctx:         ctx,
+			ans:         ans,
+			contentType: exportContentTypes[format],
+			newChunk: func(contentType string, data []byte) *library.ExportCatalogResponse {
+				return &library.ExportCatalogResponse{ContentType: contentType, Chunk: data}
+			},
+		}
+
+		streamErr = writeCatalog(format, w, books, booksErr)
+		logger.CheckError(streamErr, l.logger, "Failed encoding catalog", zap.Error(streamErr))
+	}()
+
+	return ans, func() error { return streamErr }, nil
+}
@@ -0,0 +1,154 @@
+package library
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func makeExportedBooks(books ...entity.ExportedBook) <-chan entity.ExportedBook {
+	ch := make(chan entity.ExportedBook, len(books))
+	for _, book := range books {
+		ch <- book
+	}
+	close(ch)
+	return ch
+}
+
+func readExport(t *testing.T, chunks <-chan *library.ExportCatalogResponse) (string, []byte) {
+	t.Helper()
+	var (
+		contentType string
+		data        []byte
+	)
+	for chunk := range chunks {
+		if contentType == "" {
+			contentType = chunk.GetContentType()
+		} else {
+			require.Empty(t, chunk.GetContentType())
+		}
+		data = append(data, chunk.GetChunk()...)
+	}
+	return contentType, data
+}
+
+func TestExportCatalog(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	book := entity.ExportedBook{
+		Book: entity.Book{
+			ID:        uuid.NewString(),
+			Name:      "Good Omens, \"nice\" edition",
+			AuthorIDs: []string{"a1", "a2"},
+			ISBN:      "9780306406157",
+			CreatedAt: created,
+			UpdatedAt: created.Add(time.Hour),
+		},
+		AuthorNames: []string{"Terry Pratchett", "Neil Gaiman"},
+	}
+
+	t.Run("csv", func(t *testing.T) {
+		t.Parallel()
+		ctx, booksRepo, s := initBookTest(t)
+
+		from := created.Add(-time.Hour)
+		booksRepo.EXPECT().ExportBooks(ctx, from, time.Time{}).Return(makeExportedBooks(book), noStreamErr, nil)
+
+		chunks, streamErr, err := s.ExportCatalog(ctx, library.ExportFormat_EXPORT_FORMAT_CSV, from, time.Time{})
+		require.NoError(t, err)
+
+		contentType, data := readExport(t, chunks)
+		require.NoError(t, streamErr())
+		require.Equal(t, "text/csv; charset=utf-8", contentType)
+		require.Equal(t, "id,title,authors,author_ids,isbn,publisher_id,work_id,created_at,updated_at\n"+
+			book.ID+`,"Good Omens, ""nice"" edition",Terry Pratchett;Neil Gaiman,a1;a2,9780306406157,,,`+
+			"2024-05-01T10:00:00Z,2024-05-01T11:00:00Z\n", string(data))
+	})
+
+	t.Run("ndjson", func(t *testing.T) {
+		t.Parallel()
+		ctx, booksRepo, s := initBookTest(t)
+
+		booksRepo.EXPECT().ExportBooks(ctx, time.Time{}, time.Time{}).Return(makeExportedBooks(book, book), noStreamErr, nil)
+
+		chunks, streamErr, err := s.ExportCatalog(ctx, library.ExportFormat_EXPORT_FORMAT_NDJSON, time.Time{}, time.Time{})
+		require.NoError(t, err)
+
+		contentType, data := readExport(t, chunks)
+		require.NoError(t, streamErr())
+		require.Equal(t, "application/x-ndjson", contentType)
+		line := `{"id":"` + book.ID + `","title":"Good Omens, \"nice\" edition",` +
+			`"authors":[{"id":"a1","name":"Terry Pratchett"},{"id":"a2","name":"Neil Gaiman"}],` +
+			`"isbn":"9780306406157","created_at":"2024-05-01T10:00:00Z","updated_at":"2024-05-01T11:00:00Z"}` + "\n"
+		require.Equal(t, line+line, string(data))
+	})
+
+	t.Run("empty export has content type", func(t *testing.T) {
+		t.Parallel()
+		ctx, booksRepo, s := initBookTest(t)
+
+		booksRepo.EXPECT().ExportBooks(ctx, time.Time{}, time.Time{}).Return(makeExportedBooks(), noStreamErr, nil)
+
+		chunks, streamErr, err := s.ExportCatalog(ctx, library.ExportFormat_EXPORT_FORMAT_NDJSON, time.Time{}, time.Time{})
+		require.NoError(t, err)
+
+		contentType, data := readExport(t, chunks)
+		require.NoError(t, streamErr())
+		require.Equal(t, "application/x-ndjson", contentType)
+		require.Empty(t, data)
+	})
+
+	t.Run("large export is split into chunks", func(t *testing.T) {
+		t.Parallel()
+		ctx, booksRepo, s := initBookTest(t)
+
+		large := book
+		large.Name = strings.Repeat("x", exportChunkSize)
+		booksRepo.EXPECT().ExportBooks(ctx, time.Time{}, time.Time{}).Return(makeExportedBooks(large, large), noStreamErr, nil)
+
+		chunks, streamErr, err := s.ExportCatalog(ctx, library.ExportFormat_EXPORT_FORMAT_CSV, time.Time{}, time.Time{})
+		require.NoError(t, err)
+
+		var sizes []int
+		var data []byte
+		for chunk := range chunks {
+			sizes = append(sizes, len(chunk.GetChunk()))
+			data = append(data, chunk.GetChunk()...)
+		}
+		require.NoError(t, streamErr())
+		require.Len(t, sizes, 3)
+		require.Equal(t, exportChunkSize, sizes[0])
+		require.Equal(t, 2, bytes.Count(data, []byte(large.Name)))
+	})
+
+	t.Run("broken stream of books", func(t *testing.T) {
+		t.Parallel()
+		ctx, booksRepo, s := initBookTest(t)
+
+		booksRepo.EXPECT().ExportBooks(ctx, time.Time{}, time.Time{}).
+			Return(makeExportedBooks(book), func() error { return errInternalBooks }, nil)
+
+		chunks, streamErr, err := s.ExportCatalog(ctx, library.ExportFormat_EXPORT_FORMAT_CSV, time.Time{}, time.Time{})
+		require.NoError(t, err)
+
+		readExport(t, chunks)
+		require.ErrorIs(t, streamErr(), errInternalBooks)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		t.Parallel()
+		ctx, booksRepo, s := initBookTest(t)
+
+		booksRepo.EXPECT().ExportBooks(ctx, time.Time{}, time.Time{}).Return(nil, nil, errInternalBooks)
+
+		_, _, err := s.ExportCatalog(ctx, library.ExportFormat_EXPORT_FORMAT_CSV, time.Time{}, time.Time{})
+		require.ErrorIs(t, err, errInternalBooks)
+	})
+}
@@ -0,0 +1,158 @@
+package library
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/project/library/pkg/logger"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var holdStatuses = map[library.HoldStatus]entity.HoldStatus{
+	library.HoldStatus_HOLD_STATUS_WAITING:          entity.HoldWaiting,
+	library.HoldStatus_HOLD_STATUS_READY_FOR_PICKUP: entity.HoldReadyForPickup,
+	library.HoldStatus_HOLD_STATUS_FULFILLED:        entity.HoldFulfilled,
+	library.HoldStatus_HOLD_STATUS_CANCELLED:        entity.HoldCancelled,
+	library.HoldStatus_HOLD_STATUS_EXPIRED:          entity.HoldExpired,
+}
+
+func convertHoldStatusToAPI(status entity.HoldStatus) library.HoldStatus {
+	for apiStatus, s := range holdStatuses {
+		if s == status {
+			return apiStatus
+		}
+	}
+	return library.HoldStatus_HOLD_STATUS_UNSPECIFIED
+}
+
+func convertHold(hold *entity.Hold) *library.Hold {
+	result := &library.Hold{
+		Id:       hold.ID,
+		BookId:   hold.BookID,
+		MemberId: hold.MemberID,
+		CopyId:   hold.CopyID,
+		Status:   convertHoldStatusToAPI(hold.Status),
+		Position: uint32(hold.Position),
+		PlacedAt: timestamppb.New(hold.PlacedAt),
+	}
+	if hold.ReadyAt != nil {
+		result.ReadyAt = timestamppb.New(*hold.ReadyAt)
+	}
+	if hold.ExpiresAt != nil {
+		result.ExpiresAt = timestamppb.New(*hold.ExpiresAt)
+	}
+	return result
+}
+
+func convertHolds(holds []entity.Hold) []*library.Hold {
+	result := make([]*library.Hold, 0, len(holds))
+	for i := range holds {
+		result = append(result, convertHold(&holds[i]))
+	}
+	return result
+}
+
+func (l *libraryImpl) PlaceHold(ctx context.Context, idMember, idBook string) (*library.PlaceHoldResponse, error) {
+	member, err := l.memberRepository.GetMember(ctx, idMember)
+
+	if logger.CheckError(err, l.logger, "Failed get member for hold", zap.String("id of member", idMember), zap.Error(err)) {
+		return nil, err
+	}
+
+	now := time.Now()
+	if !member.CanBorrow(now) {
+		if l.logger != nil {
+			l.logger.Info("Member can not place hold", zap.String("id of member", idMember), zap.String("status", string(member.Status)))
+		}
+		return nil, fmt.Errorf("member %s is %s until %s: %w",
+			idMember, member.Status, member.ExpiryDate.Format(time.DateOnly), entity.ErrMemberCannotBorrow)
+	}
+
+	availability, err := l.copyRepository.GetBookAvailability(ctx, idBook, "")
+
+	if logger.CheckError(err, l.logger, "Failed get book availability", zap.String("id of book", idBook), zap.Error(err)) {
+		return nil, err
+	}
+
+	// members queue only for books which can not be checked out right now
+	if availability.Available > 0 {
+		if l.logger != nil {
+			l.logger.Info("Book is available, hold is not placed", zap.String("id of book", idBook), zap.Int("available", availability.Available))
+		}
+		return nil, fmt.Errorf("book %s has %d available copies: %w", idBook, availability.Available, entity.ErrBookAvailable)
+	}
+
+	hold, err := l.holdRepository.PlaceHold(ctx, entity.Hold{
+		BookID:   idBook,
+		MemberID: idMember,
+		PlacedAt: now,
+	})
+
+	if logger.CheckError(err, l.logger, "Failed place hold", zap.String("id of book", idBook), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Placed the hold", zap.String("id of hold", hold.ID), zap.String("id of book", idBook),
+			zap.String("id of member", idMember), zap.Int("position", hold.Position))
+	}
+
+	return &library.PlaceHoldResponse{
+		Hold: convertHold(&hold),
+	}, nil
+}
+
+func (l *libraryImpl) CancelHold(ctx context.Context, idHold string) (*library.CancelHoldResponse, error) {
+	now := time.Now()
+	hold, err := l.holdRepository.CancelHold(ctx, idHold, now, now.Add(l.loanPolicy.PickupWindow))
+
+	if logger.CheckError(err, l.logger, "Failed cancel hold", zap.String("id of hold", idHold), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Cancelled the hold", zap.String("id of hold", idHold))
+	}
+
+	return &library.CancelHoldResponse{
+		Hold: convertHold(&hold),
+	}, nil
+}
+
+func (l *libraryImpl) ListHolds(ctx context.Context, idMember, idBook string) (*library.ListHoldsResponse, error) {
+	holds, err := l.holdRepository.ListHolds(ctx, entity.HoldFilter{
+		MemberID: idMember,
+		BookID:   idBook,
+	})
+
+	if logger.CheckError(err, l.logger, "Failed list holds", zap.String("id of member", idMember),
+		zap.String("id of book", idBook), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("List holds", zap.String("id of member", idMember),
+			zap.String("id of book", idBook), zap.Int("count", len(holds)))
+	}
+
+	return &library.ListHoldsResponse{
+		Holds: convertHolds(holds),
+	}, nil
+}
+
+// ExpireHolds closes holds which were not picked up in time and passes their copies on.
+func (l *libraryImpl) ExpireHolds(ctx context.Context) error {
+	now := time.Now()
+	expired, err := l.holdRepository.ExpireHolds(ctx, now, now.Add(l.loanPolicy.PickupWindow))
+
+	if logger.CheckError(err, l.logger, "Failed expire holds", zap.Error(err)) {
+		return err
+	}
+	if l.logger != nil && len(expired) > 0 {
+		l.logger.Info("Expired the holds", zap.Int("count", len(expired)))
+	}
+
+	return nil
+}
@@ -0,0 +1,218 @@
+package library
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/project/library/internal/usecase/library/mocks"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var errInternalHolds = errors.New("internal error")
+
+type holdMocks struct {
+	members *mocks.MockMemberRepository
+	copies  *mocks.MockCopyRepository
+	holds   *mocks.MockHoldRepository
+}
+
+func initHoldTest(t *testing.T) (context.Context, holdMocks, *libraryImpl) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	m := holdMocks{
+		members: mocks.NewMockMemberRepository(ctrl),
+		copies:  mocks.NewMockCopyRepository(ctrl),
+		holds:   mocks.NewMockHoldRepository(ctrl),
+	}
+	ctx := context.Background()
+	logger, e := zap.NewProduction()
+	require.NoError(t, e)
+
+	huc := New(logger, Repositories{Copy: m.copies, Member: m.members, Hold: m.holds}, Options{LoanPolicy: testLoanPolicy})
+	return ctx, m, huc
+}
+
+func TestPlaceHold(t *testing.T) {
+	t.Parallel()
+
+	const (
+		idMember = "123"
+		idBook   = "456"
+		idHold   = "789"
+	)
+	active := entity.Member{ID: idMember, Status: entity.MemberActive, ExpiryDate: time.Now().AddDate(1, 0, 0)}
+
+	tests := []struct {
+		name         string
+		member       entity.Member
+		memberErr    error
+		availability entity.Availability
+		holdErr      error
+		requireErr   error
+	}{
+		{name: "valid hold",
+			member:       active,
+			availability: entity.Availability{Total: 2}},
+
+		{name: "book without copies",
+			member: active},
+
+		{name: "unknown member",
+			memberErr:  entity.ErrMemberNotFound,
+			requireErr: entity.ErrMemberNotFound},
+
+		{name: "suspended member",
+			member:     entity.Member{ID: idMember, Status: entity.MemberSuspended, ExpiryDate: active.ExpiryDate},
+			requireErr: entity.ErrMemberCannotBorrow},
+
+		{name: "available book",
+			member:       active,
+			availability: entity.Availability{Total: 2, Available: 1},
+			requireErr:   entity.ErrBookAvailable},
+
+		{name: "second hold on book",
+			member:       active,
+			availability: entity.Availability{Total: 2},
+			holdErr:      entity.ErrHoldAlreadyExists,
+			requireErr:   entity.ErrHoldAlreadyExists},
+
+		{name: "internal error",
+			member:       active,
+			availability: entity.Availability{Total: 2},
+			holdErr:      errInternalHolds,
+			requireErr:   errInternalHolds},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, m, s := initHoldTest(t)
+
+			m.members.EXPECT().GetMember(ctx, idMember).Return(test.member, test.memberErr)
+			if test.memberErr == nil && test.member.Status == entity.MemberActive {
+				m.copies.EXPECT().GetBookAvailability(ctx, idBook, "").Return(test.availability, nil)
+			}
+			if test.availability.Available == 0 && test.member.Status == entity.MemberActive {
+				m.holds.EXPECT().PlaceHold(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, hold entity.Hold) (entity.Hold, error) {
+					require.Equal(t, idBook, hold.BookID)
+					require.Equal(t, idMember, hold.MemberID)
+					if test.holdErr != nil {
+						return entity.Hold{}, test.holdErr
+					}
+					hold.ID = idHold
+					hold.Status = entity.HoldWaiting
+					hold.Position = 3
+					return hold, nil
+				})
+			}
+
+			response, err := s.PlaceHold(ctx, idMember, idBook)
+			require.ErrorIs(t, err, test.requireErr)
+			if test.requireErr != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, idHold, response.GetHold().GetId())
+			require.Equal(t, library.HoldStatus_HOLD_STATUS_WAITING, response.GetHold().GetStatus())
+			require.Equal(t, uint32(3), response.GetHold().GetPosition())
+			require.Nil(t, response.GetHold().GetReadyAt())
+		})
+	}
+}
+
+func TestCancelHold(t *testing.T) {
+	t.Parallel()
+
+	const idHold = "789"
+
+	tests := []struct {
+		name       string
+		requireErr error
+	}{
+		{name: "valid cancel"},
+		{name: "cancel closed hold",
+			requireErr: entity.ErrHoldNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, m, s := initHoldTest(t)
+
+			m.holds.EXPECT().CancelHold(ctx, idHold, gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, id string, cancelledAt, pickupUntil time.Time) (entity.Hold, error) {
+					require.Equal(t, testLoanPolicy.PickupWindow, pickupUntil.Sub(cancelledAt))
+					if test.requireErr != nil {
+						return entity.Hold{}, test.requireErr
+					}
+					return entity.Hold{ID: id, Status: entity.HoldCancelled}, nil
+				})
+
+			response, err := s.CancelHold(ctx, idHold)
+			require.Equal(t, test.requireErr, err)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, library.HoldStatus_HOLD_STATUS_CANCELLED, response.GetHold().GetStatus())
+		})
+	}
+}
+
+func TestListHolds(t *testing.T) {
+	t.Parallel()
+
+	const (
+		idMember = "123"
+		idBook   = "456"
+	)
+	ready := time.Now()
+	expires := ready.Add(testLoanPolicy.PickupWindow)
+
+	ctx, m, s := initHoldTest(t)
+
+	m.holds.EXPECT().ListHolds(ctx, entity.HoldFilter{BookID: idBook}).Return([]entity.Hold{
+		{ID: "1", BookID: idBook, MemberID: idMember, CopyID: "copy", Status: entity.HoldReadyForPickup, ReadyAt: &ready, ExpiresAt: &expires},
+		{ID: "2", BookID: idBook, MemberID: "another", Status: entity.HoldWaiting, Position: 1},
+	}, nil)
+
+	response, err := s.ListHolds(ctx, "", idBook)
+	require.NoError(t, err)
+	require.Len(t, response.GetHolds(), 2)
+	require.Equal(t, library.HoldStatus_HOLD_STATUS_READY_FOR_PICKUP, response.GetHolds()[0].GetStatus())
+	require.Equal(t, "copy", response.GetHolds()[0].GetCopyId())
+	require.Equal(t, expires.Unix(), response.GetHolds()[0].GetExpiresAt().AsTime().Unix())
+	require.Equal(t, uint32(1), response.GetHolds()[1].GetPosition())
+
+	m.holds.EXPECT().ListHolds(ctx, entity.HoldFilter{MemberID: idMember}).Return(nil, errInternalHolds)
+
+	response, err = s.ListHolds(ctx, idMember, "")
+	require.ErrorIs(t, err, errInternalHolds)
+	require.Nil(t, response)
+}
+
+func TestExpireHolds(t *testing.T) {
+	t.Parallel()
+
+	ctx, m, s := initHoldTest(t)
+
+	m.holds.EXPECT().ExpireHolds(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, now, pickupUntil time.Time) ([]entity.Hold, error) {
+			require.Equal(t, testLoanPolicy.PickupWindow, pickupUntil.Sub(now))
+			return []entity.Hold{{ID: "1", Status: entity.HoldExpired}}, nil
+		})
+	require.NoError(t, s.ExpireHolds(ctx))
+
+	m.holds.EXPECT().ExpireHolds(ctx, gomock.Any(), gomock.Any()).Return(nil, errInternalHolds)
+	require.ErrorIs(t, s.ExpireHolds(ctx), errInternalHolds)
+}
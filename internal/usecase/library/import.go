@@ -0,0 +1,182 @@
+package library
+
+import (
+	"cmp"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/project/library/pkg/logger"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"go.uber.org/zap"
+	"golang.org/x/text/unicode/norm"
+)
+
+const importAuthorsSeparator = ";"
+
+// catalogImporter adds rows of the imported catalog in batches of entity.ImportBatchSize and
+// collects results of the rows, rows with ISBN of a previous row are skipped.
+type catalogImporter struct {
+	l       *libraryImpl
+	ctx     context.Context
+	results []entity.ImportResult
+	batch   []entity.ImportRow
+	isbns   map[string]bool
+	created int64
+}
+
+func (l *libraryImpl) newCatalogImporter(ctx context.Context) *catalogImporter {
+	return &catalogImporter{l: l, ctx: ctx, isbns: make(map[string]bool)}
+}
+
+func (i *catalogImporter) add(row entity.ImportRow) {
+	if row.ISBN != "" {
+		if i.isbns[row.ISBN] {
+			i.fail(row.Line, entity.ErrBookAlreadyExists)
+			return
+		}
+		i.isbns[row.ISBN] = true
+	}
+
+	i.batch = append(i.batch, row)
+	if len(i.batch) == entity.ImportBatchSize {
+		i.flush()
+	}
+}
+
+func (i *catalogImporter) fail(line int64, err error) {
+	i.results = append(i.results, entity.ImportResult{Line: line, Err: err})
+}
+
+// flush adds the batch, if it can not be added all its rows are failed.
+func (i *catalogImporter) flush() {
+	if len(i.batch) == 0 {
+		return
+	}
+
+	results, created, err := i.l.booksRepository.ImportBooks(i.ctx, i.batch)
+	if logger.CheckError(err, i.l.logger, "Failed importing books", zap.Int64("line", i.batch[0].Line), zap.Error(err)) {
+		for _, row := range i.batch {
+			i.fail(row.Line, err)
+		}
+	} else {
+		i.results = append(i.results, results...)
+		i.created += created
+	}
+	i.batch = i.batch[:0]
+}
+
+// finish adds the rest of rows and returns results of all rows ordered by their lines.
+func (i *catalogImporter) finish() []entity.ImportResult {
+	i.flush()
+	// rows skipped while reading are reported before the batch they were read with is imported
+	slices.SortStableFunc(i.results, func(a, b entity.ImportResult) int {
+		return cmp.Compare(a.Line, b.Line)
+	})
+	return i.results
+}
+
+// ImportCatalog adds books from CSV rows of title, author names separated by ';' and optional ISBN.
+// Invalid rows are reported in the results and don't stop the import, the first line is skipped
+// if it is the header.
+func (l *libraryImpl) ImportCatalog(ctx context.Context, catalog io.Reader) (*library.ImportCatalogResponse, error) {
+	reader := csv.NewReader(catalog)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	reader.ReuseRecord = true
+
+	importer := l.newCatalogImporter(ctx)
+	for first := true; ; first = false {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			importer.fail(int64(parseErr.StartLine), parseErr.Err)
+			continue
+		}
+		if logger.CheckError(err, l.logger, "Failed reading catalog", zap.Error(err)) {
+			return nil, err
+		}
+
+		if first && strings.EqualFold(strings.TrimSpace(record[0]), "title") {
+			continue
+		}
+
+		line, _ := reader.FieldPos(0)
+		row, err := parseImportRow(record)
+		if err != nil {
+			importer.fail(int64(line), err)
+			continue
+		}
+		row.Line = int64(line)
+		importer.add(row)
+	}
+
+	results := importer.finish()
+	response := &library.ImportCatalogResponse{AuthorsCreated: uint32(importer.created)}
+	for _, r := range results {
+		result := &library.ImportRowResult{Line: uint32(r.Line), BookId: r.BookID}
+		if r.Err != nil {
+			result.Error = r.Err.Error()
+			response.Failed++
+		} else {
+			response.Imported++
+		}
+		response.Results = append(response.Results, result)
+	}
+
+	if l.logger != nil {
+		l.logger.Info("Imported catalog", zap.Uint32("imported", response.Imported),
+			zap.Uint32("failed", response.Failed), zap.Uint32("authors created", response.AuthorsCreated))
+	}
+
+	return response, nil
+}
+
+// parseImportRow validates the CSV record of the imported catalog, repeated author names are skipped.
+func parseImportRow(record []string) (entity.ImportRow, error) {
+	if len(record) < 2 || len(record) > 3 {
+		return entity.ImportRow{}, fmt.Errorf("expected 2 or 3 fields, got %d: %w", len(record), entity.ErrInvalidImport)
+	}
+
+	row := entity.ImportRow{Title: norm.NFC.String(strings.TrimSpace(record[0]))}
+	if row.Title == "" {
+		return entity.ImportRow{}, fmt.Errorf("empty title: %w", entity.ErrInvalidImport)
+	}
+
+	// Names are stored in NFC, so the same name in other normal forms is a duplicate too.
+	seen := make(map[string]bool)
+	for _, name := range strings.Split(record[1], importAuthorsSeparator) {
+		name = norm.NFC.String(strings.TrimSpace(name))
+		if name == "" || seen[name] {
+			continue
+		}
+		if err := (&library.RegisterAuthorRequest{Name: name}).Validate(); err != nil {
+			return entity.ImportRow{}, fmt.Errorf("invalid author name %q: %w", name, entity.ErrInvalidImport)
+		}
+		seen[name] = true
+		row.AuthorNames = append(row.AuthorNames, name)
+	}
+	if len(row.AuthorNames) == 0 {
+		return entity.ImportRow{}, fmt.Errorf("no authors: %w", entity.ErrInvalidImport)
+	}
+
+	if len(record) == 3 && strings.TrimSpace(record[2]) != "" {
+		isbn, err := entity.NormalizeISBN(record[2])
+		if err != nil {
+			return entity.ImportRow{}, err
+		}
+		row.ISBN = isbn
+	}
+
+	return row, nil
+}
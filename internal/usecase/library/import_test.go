@@ -0,0 +1,125 @@
+package library
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func importedResults(rows []entity.ImportRow) []entity.ImportResult {
+	results := make([]entity.ImportResult, len(rows))
+	for i, row := range rows {
+		results[i] = entity.ImportResult{Line: row.Line, BookID: uuid.NewString()}
+	}
+	return results
+}
+
+func TestImportCatalog(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid rows with header", func(t *testing.T) {
+		t.Parallel()
+		ctx, booksRepo, s := initBookTest(t)
+
+		catalog := "title,authors,isbn\n" +
+			"Dune,Frank Herbert,0-306-40615-2\n" +
+			"\"Good Omens\", Terry Pratchett; Neil Gaiman ;Terry Pratchett\n"
+		booksRepo.EXPECT().ImportBooks(ctx, []entity.ImportRow{
+			{Line: 2, Title: "Dune", AuthorNames: []string{"Frank Herbert"}, ISBN: "9780306406157"},
+			{Line: 3, Title: "Good Omens", AuthorNames: []string{"Terry Pratchett", "Neil Gaiman"}},
+		}).DoAndReturn(func(_ context.Context, rows []entity.ImportRow) ([]entity.ImportResult, int64, error) {
+			return importedResults(rows), 3, nil
+		})
+
+		response, err := s.ImportCatalog(ctx, strings.NewReader(catalog))
+		require.NoError(t, err)
+		require.Equal(t, uint32(2), response.GetImported())
+		require.Equal(t, uint32(0), response.GetFailed())
+		require.Equal(t, uint32(3), response.GetAuthorsCreated())
+		require.Len(t, response.GetResults(), 2)
+		require.Equal(t, uint32(2), response.GetResults()[0].GetLine())
+		require.NotEmpty(t, response.GetResults()[0].GetBookId())
+	})
+
+	t.Run("names are deduplicated in NFC", func(t *testing.T) {
+		t.Parallel()
+		ctx, booksRepo, s := initBookTest(t)
+
+		catalog := "Me\u0301moires,Ce\u0301line;C\u00e9line\n"
+		booksRepo.EXPECT().ImportBooks(ctx, []entity.ImportRow{
+			{Line: 1, Title: "M\u00e9moires", AuthorNames: []string{"C\u00e9line"}},
+		}).DoAndReturn(func(_ context.Context, rows []entity.ImportRow) ([]entity.ImportResult, int64, error) {
+			return importedResults(rows), 1, nil
+		})
+
+		response, err := s.ImportCatalog(ctx, strings.NewReader(catalog))
+		require.NoError(t, err)
+		require.Equal(t, uint32(1), response.GetImported())
+	})
+
+	t.Run("invalid rows are reported", func(t *testing.T) {
+		t.Parallel()
+		ctx, booksRepo, s := initBookTest(t)
+
+		catalog := "Dune,Frank Herbert,9780306406157\n" +
+			",Frank Herbert\n" +
+			"Dune\n" +
+			"Dune,Frank Herbert,123\n" +
+			"Dune,Frank Herbert,978-0-306-40615-7\n" +
+			"Dune,\"Frank\" Herbert\n" +
+			"Emma,Jane Austen\n"
+		booksRepo.EXPECT().ImportBooks(ctx, []entity.ImportRow{
+			{Line: 1, Title: "Dune", AuthorNames: []string{"Frank Herbert"}, ISBN: "9780306406157"},
+			{Line: 7, Title: "Emma", AuthorNames: []string{"Jane Austen"}},
+		}).DoAndReturn(func(_ context.Context, rows []entity.ImportRow) ([]entity.ImportResult, int64, error) {
+			return importedResults(rows), 0, nil
+		})
+
+		response, err := s.ImportCatalog(ctx, strings.NewReader(catalog))
+		require.NoError(t, err)
+		require.Equal(t, uint32(2), response.GetImported())
+		require.Equal(t, uint32(5), response.GetFailed())
+
+		lines := make([]uint32, 0, len(response.GetResults()))
+		for _, r := range response.GetResults() {
+			lines = append(lines, r.GetLine())
+			if r.GetLine() != 1 && r.GetLine() != 7 {
+				require.NotEmpty(t, r.GetError())
+				require.Empty(t, r.GetBookId())
+			}
+		}
+		require.Equal(t, []uint32{1, 2, 3, 4, 5, 6, 7}, lines)
+		require.Equal(t, entity.ErrBookAlreadyExists.Error(), response.GetResults()[4].GetError())
+	})
+
+	t.Run("rows are imported in batches", func(t *testing.T) {
+		t.Parallel()
+		ctx, booksRepo, s := initBookTest(t)
+
+		var catalog strings.Builder
+		for range entity.ImportBatchSize + 1 {
+			catalog.WriteString("Book,Author\n")
+		}
+		gomock.InOrder(
+			booksRepo.EXPECT().ImportBooks(ctx, gomock.Len(entity.ImportBatchSize)).
+				Return(nil, int64(0), errInternalBooks),
+			booksRepo.EXPECT().ImportBooks(ctx, gomock.Len(1)).
+				DoAndReturn(func(_ context.Context, rows []entity.ImportRow) ([]entity.ImportResult, int64, error) {
+					return importedResults(rows), 1, nil
+				}),
+		)
+
+		response, err := s.ImportCatalog(ctx, strings.NewReader(catalog.String()))
+		require.NoError(t, err)
+		require.Equal(t, &library.ImportRowResult{Line: entity.ImportBatchSize, Error: errInternalBooks.Error()},
+			response.GetResults()[entity.ImportBatchSize-1])
+		require.Equal(t, uint32(1), response.GetImported())
+		require.Equal(t, uint32(entity.ImportBatchSize), response.GetFailed())
+	})
+}
@@ -0,0 +1,236 @@
+package library
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/project/library/pkg/logger"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func convertLoan(loan *entity.Loan) *library.Loan {
+	result := &library.Loan{
+		Id:           loan.ID,
+		CopyId:       loan.CopyID,
+		BookId:       loan.BookID,
+		MemberId:     loan.MemberID,
+		CheckedOutAt: timestamppb.New(loan.CheckedOutAt),
+		DueAt:        timestamppb.New(loan.DueAt),
+		Fine:         loan.Fine,
+		FinePaid:     loan.FinePaid,
+		Renewals:     uint32(loan.Renewals),
+	}
+	if loan.ReturnedAt != nil {
+		result.ReturnedAt = timestamppb.New(*loan.ReturnedAt)
+	}
+	if loan.OverdueAt != nil {
+		result.OverdueAt = timestamppb.New(*loan.OverdueAt)
+	}
+	return result
+}
+
+func convertLoans(loans []entity.Loan) []*library.Loan {
+	result := make([]*library.Loan, 0, len(loans))
+	for i := range loans {
+		result = append(result, convertLoan(&loans[i]))
+	}
+	return result
+}
+
+func (l *libraryImpl) CheckoutBook(ctx context.Context, idMember, idCopy string) (*library.CheckoutBookResponse, error) {
+	member, err := l.memberRepository.GetMember(ctx, idMember)
+
+	if logger.CheckError(err, l.logger, "Failed get member for checkout", zap.String("id of member", idMember), zap.Error(err)) {
+		return nil, err
+	}
+
+	now := time.Now()
+	if !member.CanBorrow(now) {
+		if l.logger != nil {
+			l.logger.Info("Member can not borrow", zap.String("id of member", idMember), zap.String("status", string(member.Status)))
+		}
+		return nil, fmt.Errorf("member %s is %s until %s: %w",
+			idMember, member.Status, member.ExpiryDate.Format(time.DateOnly), entity.ErrMemberCannotBorrow)
+	}
+
+	loan, err := l.loanRepository.CheckoutBook(ctx, entity.Loan{
+		CopyID:       idCopy,
+		MemberID:     idMember,
+		CheckedOutAt: now,
+		DueAt:        now.Add(l.loanPolicy.LoanPeriod(member.Type)),
+	}, now.Add(l.loanPolicy.PickupWindow))
+
+	if logger.CheckError(err, l.logger, "Failed checkout book", zap.String("id of copy", idCopy), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Checked out the copy", zap.String("id of loan", loan.ID),
+			zap.String("id of copy", idCopy), zap.String("id of member", idMember))
+	}
+
+	return &library.CheckoutBookResponse{
+		Loan: convertLoan(&loan),
+	}, nil
+}
+
+func (l *libraryImpl) ReturnBook(ctx context.Context, idCopy string) (*library.ReturnBookResponse, error) {
+	now := time.Now()
+	loan, err := l.loanRepository.ReturnBook(ctx, idCopy, now, now.Add(l.loanPolicy.PickupWindow))
+
+	if logger.CheckError(err, l.logger, "Failed return book", zap.String("id of copy", idCopy), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Returned the copy", zap.String("id of loan", loan.ID), zap.String("id of copy", idCopy))
+	}
+
+	return &library.ReturnBookResponse{
+		Loan: convertLoan(&loan),
+	}, nil
+}
+
+func (l *libraryImpl) ListMemberLoans(ctx context.Context, idMember string) (*library.ListMemberLoansResponse, error) {
+	loans, err := l.loanRepository.GetMemberLoans(ctx, idMember)
+
+	if logger.CheckError(err, l.logger, "Failed get member's loans", zap.String("id of member", idMember), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Get member's loans", zap.String("id of member", idMember), zap.Int("count", len(loans)))
+	}
+
+	return &library.ListMemberLoansResponse{
+		Loans: convertLoans(loans),
+	}, nil
+}
+
+func (l *libraryImpl) ListBookLoans(ctx context.Context, idBook string) (*library.ListBookLoansResponse, error) {
+	loans, err := l.loanRepository.GetBookLoans(ctx, idBook)
+
+	if logger.CheckError(err, l.logger, "Failed get book's loans", zap.String("id of book", idBook), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Get book's loans", zap.String("id of book", idBook), zap.Int("count", len(loans)))
+	}
+
+	return &library.ListBookLoansResponse{
+		Loans: convertLoans(loans),
+	}, nil
+}
+
+func (l *libraryImpl) GetMemberBalance(ctx context.Context, idMember string) (*library.GetMemberBalanceResponse, error) {
+	_, err := l.memberRepository.GetMember(ctx, idMember)
+
+	if logger.CheckError(err, l.logger, "Failed get member for balance", zap.String("id of member", idMember), zap.Error(err)) {
+		return nil, err
+	}
+
+	balance, err := l.loanRepository.GetMemberBalance(ctx, idMember, time.Now())
+
+	if logger.CheckError(err, l.logger, "Failed get member's balance", zap.String("id of member", idMember), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Get member's balance", zap.String("id of member", idMember),
+			zap.Int64("outstanding fines", balance.OutstandingFines), zap.Int("overdue loans", len(balance.OverdueLoans)))
+	}
+
+	return &library.GetMemberBalanceResponse{
+		OutstandingFines: balance.OutstandingFines,
+		OverdueLoans:     convertLoans(balance.OverdueLoans),
+	}, nil
+}
+
+func (l *libraryImpl) RenewLoan(ctx context.Context, idLoan string) (*library.RenewLoanResponse, error) {
+	loan, err := l.loanRepository.RenewLoan(ctx, idLoan, time.Now(), l.loanPolicy.Renewals)
+
+	if logger.CheckError(err, l.logger, "Failed renew loan", zap.String("id of loan", idLoan), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Renewed the loan", zap.String("id of loan", idLoan),
+			zap.Time("due at", loan.DueAt), zap.Int64("renewals", loan.Renewals))
+	}
+
+	return &library.RenewLoanResponse{
+		Loan: convertLoan(&loan),
+	}, nil
+}
+
+func (l *libraryImpl) ListLoanRenewals(ctx context.Context, idLoan string) (*library.ListLoanRenewalsResponse, error) {
+	renewals, err := l.loanRepository.GetLoanRenewals(ctx, idLoan)
+
+	if logger.CheckError(err, l.logger, "Failed get loan's renewals", zap.String("id of loan", idLoan), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Get loan's renewals", zap.String("id of loan", idLoan), zap.Int("count", len(renewals)))
+	}
+
+	result := make([]*library.LoanRenewal, 0, len(renewals))
+	for _, renewal := range renewals {
+		result = append(result, &library.LoanRenewal{
+			Id:            renewal.ID,
+			LoanId:        renewal.LoanID,
+			RenewedAt:     timestamppb.New(renewal.RenewedAt),
+			PreviousDueAt: timestamppb.New(renewal.PreviousDueAt),
+			DueAt:         timestamppb.New(renewal.DueAt),
+		})
+	}
+
+	return &library.ListLoanRenewalsResponse{
+		Renewals: result,
+	}, nil
+}
+
+var finePaymentTypes = map[library.FinePaymentType]entity.FinePaymentType{
+	library.FinePaymentType_FINE_PAYMENT_TYPE_PAID:   entity.PaymentPaid,
+	library.FinePaymentType_FINE_PAYMENT_TYPE_WAIVED: entity.PaymentWaived,
+}
+
+// PayFine settles the amount of the fine of the loan, it is paid by the member or waived by the library.
+func (l *libraryImpl) PayFine(
+	ctx context.Context,
+	idLoan string,
+	amount int64,
+	paymentType library.FinePaymentType,
+) (*library.PayFineResponse, error) {
+	loan, err := l.loanRepository.PayFine(ctx, entity.FinePayment{
+		LoanID: idLoan,
+		Type:   finePaymentTypes[paymentType],
+		Amount: amount,
+		PaidAt: time.Now(),
+	})
+
+	if logger.CheckError(err, l.logger, "Failed pay fine", zap.String("id of loan", idLoan), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Paid the fine", zap.String("id of loan", idLoan),
+			zap.Int64("amount", amount), zap.String("type", paymentType.String()))
+	}
+
+	return &library.PayFineResponse{
+		Loan: convertLoan(&loan),
+	}, nil
+}
+
+// AccrueFines marks loans past due as overdue and accrues their fines by the fine policy.
+func (l *libraryImpl) AccrueFines(ctx context.Context) error {
+	updated, err := l.loanRepository.AccrueFines(ctx, time.Now(), l.loanPolicy.Fines)
+
+	if logger.CheckError(err, l.logger, "Failed accrue fines", zap.Error(err)) {
+		return err
+	}
+	if l.logger != nil && updated > 0 {
+		l.logger.Info("Accrued fines", zap.Int64("overdue loans", updated))
+	}
+
+	return nil
+}
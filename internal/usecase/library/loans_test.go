@@ -0,0 +1,385 @@
+package library
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/project/library/internal/usecase/library/mocks"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var errInternalLoans = errors.New("internal error")
+
+var testLoanPolicy = entity.LoanPolicy{
+	Period: 21 * 24 * time.Hour,
+	PeriodByType: map[entity.MembershipType]time.Duration{
+		entity.MembershipStaff: 60 * 24 * time.Hour,
+	},
+	PickupWindow: 3 * 24 * time.Hour,
+	Fines: entity.FinePolicy{
+		PerDay:     10,
+		GraceDays:  2,
+		MaxPerItem: 500,
+	},
+	Renewals: entity.RenewalPolicy{
+		MaxRenewals: 2,
+		MaxRenewalsByType: map[entity.MembershipType]int64{
+			entity.MembershipChild: 0,
+		},
+		Period:   14 * 24 * time.Hour,
+		MaxFines: 100,
+	},
+}
+
+func initLoanTest(t *testing.T) (context.Context, *mocks.MockMemberRepository, *mocks.MockLoanRepository, *libraryImpl) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockMemberRepo := mocks.NewMockMemberRepository(ctrl)
+	mockLoanRepo := mocks.NewMockLoanRepository(ctrl)
+	ctx := context.Background()
+	logger, e := zap.NewProduction()
+	require.NoError(t, e)
+
+	luc := New(logger, Repositories{Member: mockMemberRepo, Loan: mockLoanRepo}, Options{LoanPolicy: testLoanPolicy})
+	return ctx, mockMemberRepo, mockLoanRepo, luc
+}
+
+func TestCheckoutBook(t *testing.T) {
+	t.Parallel()
+
+	const (
+		idMember = "123"
+		idCopy   = "456"
+		idLoan   = "789"
+	)
+	nextYear := time.Now().AddDate(1, 0, 0)
+	yesterday := time.Now().AddDate(0, 0, -2)
+
+	tests := []struct {
+		name          string
+		member        entity.Member
+		memberErr     error
+		loanErr       error
+		requirePeriod time.Duration
+		requireErr    error
+	}{
+		{name: "valid checkout",
+			member:        entity.Member{ID: idMember, Type: entity.MembershipAdult, Status: entity.MemberActive, ExpiryDate: nextYear},
+			requirePeriod: testLoanPolicy.Period},
+		{name: "valid checkout with period of membership type",
+			member:        entity.Member{ID: idMember, Type: entity.MembershipStaff, Status: entity.MemberActive, ExpiryDate: nextYear},
+			requirePeriod: 60 * 24 * time.Hour},
+		{name: "checkout on the expiry date",
+			member:        entity.Member{ID: idMember, Type: entity.MembershipAdult, Status: entity.MemberActive, ExpiryDate: time.Now()},
+			requirePeriod: testLoanPolicy.Period},
+		{name: "checkout by unknown member",
+			memberErr:  entity.ErrMemberNotFound,
+			requireErr: entity.ErrMemberNotFound},
+		{name: "checkout by suspended member",
+			member:     entity.Member{ID: idMember, Type: entity.MembershipAdult, Status: entity.MemberSuspended, ExpiryDate: nextYear},
+			requireErr: entity.ErrMemberCannotBorrow},
+		{name: "checkout by expired member",
+			member:     entity.Member{ID: idMember, Type: entity.MembershipAdult, Status: entity.MemberActive, ExpiryDate: yesterday},
+			requireErr: entity.ErrMemberCannotBorrow},
+		{name: "checkout not available copy",
+			member:        entity.Member{ID: idMember, Type: entity.MembershipAdult, Status: entity.MemberActive, ExpiryDate: nextYear},
+			loanErr:       entity.ErrCopyNotAvailable,
+			requirePeriod: testLoanPolicy.Period,
+			requireErr:    entity.ErrCopyNotAvailable},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, mockMemberRepo, mockLoanRepo, s := initLoanTest(t)
+
+			mockMemberRepo.EXPECT().GetMember(ctx, idMember).Return(test.member, test.memberErr)
+			if test.requirePeriod != 0 {
+				mockLoanRepo.EXPECT().CheckoutBook(ctx, gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, loan entity.Loan, pickupUntil time.Time) (entity.Loan, error) {
+					require.Equal(t, idCopy, loan.CopyID)
+					require.Equal(t, testLoanPolicy.PickupWindow, pickupUntil.Sub(loan.CheckedOutAt))
+					require.Equal(t, idMember, loan.MemberID)
+					require.Equal(t, test.requirePeriod, loan.DueAt.Sub(loan.CheckedOutAt))
+					if test.loanErr != nil {
+						return entity.Loan{}, test.loanErr
+					}
+					loan.ID = idLoan
+					return loan, nil
+				})
+			}
+
+			response, err := s.CheckoutBook(ctx, idMember, idCopy)
+			require.ErrorIs(t, err, test.requireErr)
+			if test.requireErr != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, idLoan, response.GetLoan().GetId())
+			require.Nil(t, response.GetLoan().GetReturnedAt())
+		})
+	}
+}
+
+func TestReturnBook(t *testing.T) {
+	t.Parallel()
+
+	const idCopy = "456"
+	returned := time.Now()
+
+	tests := []struct {
+		name       string
+		requireErr error
+	}{
+		{name: "valid return"},
+		{name: "return not lent copy",
+			requireErr: entity.ErrLoanNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, _, mockLoanRepo, s := initLoanTest(t)
+
+			mockLoanRepo.EXPECT().ReturnBook(ctx, idCopy, gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, id string, returnedAt, pickupUntil time.Time) (entity.Loan, error) {
+					require.Equal(t, testLoanPolicy.PickupWindow, pickupUntil.Sub(returnedAt))
+					if test.requireErr != nil {
+						return entity.Loan{}, test.requireErr
+					}
+					return entity.Loan{ID: "789", CopyID: id, ReturnedAt: &returned}, nil
+				})
+
+			response, err := s.ReturnBook(ctx, idCopy)
+			require.Equal(t, test.requireErr, err)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, idCopy, response.GetLoan().GetCopyId())
+			require.True(t, returned.Equal(response.GetLoan().GetReturnedAt().AsTime()))
+		})
+	}
+}
+
+func TestListLoans(t *testing.T) {
+	t.Parallel()
+
+	const (
+		idMember = "123"
+		idBook   = "456"
+	)
+	loans := []entity.Loan{
+		{ID: "1", CopyID: "10", BookID: idBook, MemberID: idMember},
+		{ID: "2", CopyID: "20", BookID: idBook, MemberID: idMember},
+	}
+
+	ctx, _, mockLoanRepo, s := initLoanTest(t)
+	mockLoanRepo.EXPECT().GetMemberLoans(ctx, idMember).Return(loans, nil)
+	mockLoanRepo.EXPECT().GetBookLoans(ctx, idBook).Return(nil, errInternalLoans)
+
+	memberLoans, err := s.ListMemberLoans(ctx, idMember)
+	require.NoError(t, err)
+	require.Len(t, memberLoans.GetLoans(), 2)
+	require.Equal(t, "20", memberLoans.GetLoans()[1].GetCopyId())
+
+	bookLoans, err := s.ListBookLoans(ctx, idBook)
+	require.Equal(t, errInternalLoans, err)
+	require.Nil(t, bookLoans)
+}
+
+func TestGetMemberBalance(t *testing.T) {
+	t.Parallel()
+
+	const idMember = "123"
+	overdue := time.Now().AddDate(0, 0, -1)
+
+	tests := []struct {
+		name       string
+		memberErr  error
+		balanceErr error
+		requireErr error
+	}{
+		{name: "valid balance"},
+		{name: "unknown member",
+			memberErr:  entity.ErrMemberNotFound,
+			requireErr: entity.ErrMemberNotFound},
+		{name: "internal error",
+			balanceErr: errInternalLoans,
+			requireErr: errInternalLoans},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, mockMemberRepo, mockLoanRepo, s := initLoanTest(t)
+
+			mockMemberRepo.EXPECT().GetMember(ctx, idMember).Return(entity.Member{ID: idMember}, test.memberErr)
+			if test.memberErr == nil {
+				mockLoanRepo.EXPECT().GetMemberBalance(ctx, idMember, gomock.Any()).Return(entity.Balance{
+					OutstandingFines: 150,
+					OverdueLoans:     []entity.Loan{{ID: "789", MemberID: idMember, OverdueAt: &overdue, Fine: 30}},
+				}, test.balanceErr)
+			}
+
+			response, err := s.GetMemberBalance(ctx, idMember)
+			require.ErrorIs(t, err, test.requireErr)
+			if test.requireErr != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, int64(150), response.GetOutstandingFines())
+			require.Len(t, response.GetOverdueLoans(), 1)
+			require.Equal(t, int64(30), response.GetOverdueLoans()[0].GetFine())
+			require.NotNil(t, response.GetOverdueLoans()[0].GetOverdueAt())
+		})
+	}
+}
+
+func TestAccrueFines(t *testing.T) {
+	t.Parallel()
+
+	ctx, _, mockLoanRepo, s := initLoanTest(t)
+
+	mockLoanRepo.EXPECT().AccrueFines(ctx, gomock.Any(), testLoanPolicy.Fines).Return(int64(2), nil)
+	require.NoError(t, s.AccrueFines(ctx))
+
+	mockLoanRepo.EXPECT().AccrueFines(ctx, gomock.Any(), testLoanPolicy.Fines).Return(int64(0), errInternalLoans)
+	require.ErrorIs(t, s.AccrueFines(ctx), errInternalLoans)
+}
+
+func TestPayFine(t *testing.T) {
+	t.Parallel()
+
+	const idLoan = "123"
+
+	tests := []struct {
+		name        string
+		paymentType library.FinePaymentType
+		requireType entity.FinePaymentType
+		requireErr  error
+	}{
+		{name: "paid fine",
+			paymentType: library.FinePaymentType_FINE_PAYMENT_TYPE_PAID,
+			requireType: entity.PaymentPaid},
+		{name: "waived fine",
+			paymentType: library.FinePaymentType_FINE_PAYMENT_TYPE_WAIVED,
+			requireType: entity.PaymentWaived},
+		{name: "amount exceeds fine",
+			paymentType: library.FinePaymentType_FINE_PAYMENT_TYPE_PAID,
+			requireType: entity.PaymentPaid,
+			requireErr:  entity.ErrFineOverpaid},
+		{name: "internal error",
+			paymentType: library.FinePaymentType_FINE_PAYMENT_TYPE_PAID,
+			requireType: entity.PaymentPaid,
+			requireErr:  errInternalLoans},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, _, mockLoanRepo, s := initLoanTest(t)
+
+			mockLoanRepo.EXPECT().PayFine(ctx, gomock.Any()).
+				DoAndReturn(func(_ context.Context, payment entity.FinePayment) (entity.Loan, error) {
+					require.Equal(t, idLoan, payment.LoanID)
+					require.Equal(t, test.requireType, payment.Type)
+					require.Equal(t, int64(40), payment.Amount)
+					if test.requireErr != nil {
+						return entity.Loan{}, test.requireErr
+					}
+					return entity.Loan{ID: idLoan, Fine: 100, FinePaid: 40}, nil
+				})
+
+			response, err := s.PayFine(ctx, idLoan, 40, test.paymentType)
+			require.ErrorIs(t, err, test.requireErr)
+			if test.requireErr != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, int64(100), response.GetLoan().GetFine())
+			require.Equal(t, int64(40), response.GetLoan().GetFinePaid())
+		})
+	}
+}
+
+func TestRenewLoan(t *testing.T) {
+	t.Parallel()
+
+	const idLoan = "123"
+	due := time.Now().AddDate(0, 0, 14)
+
+	tests := []struct {
+		name       string
+		requireErr error
+	}{
+		{name: "valid renewal"},
+		{name: "returned loan",
+			requireErr: entity.ErrLoanNotFound},
+		{name: "renewal limit",
+			requireErr: entity.ErrRenewalNotAllowed},
+		{name: "internal error",
+			requireErr: errInternalLoans},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, _, mockLoanRepo, s := initLoanTest(t)
+
+			mockLoanRepo.EXPECT().RenewLoan(ctx, idLoan, gomock.Any(), testLoanPolicy.Renewals).
+				DoAndReturn(func(context.Context, string, time.Time, entity.RenewalPolicy) (entity.Loan, error) {
+					if test.requireErr != nil {
+						return entity.Loan{}, test.requireErr
+					}
+					return entity.Loan{ID: idLoan, DueAt: due, Renewals: 1}, nil
+				})
+
+			response, err := s.RenewLoan(ctx, idLoan)
+			require.ErrorIs(t, err, test.requireErr)
+			if test.requireErr != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, uint32(1), response.GetLoan().GetRenewals())
+			require.Equal(t, due.Unix(), response.GetLoan().GetDueAt().AsTime().Unix())
+		})
+	}
+}
+
+func TestListLoanRenewals(t *testing.T) {
+	t.Parallel()
+
+	const idLoan = "123"
+	renewed := time.Now()
+	due := renewed.AddDate(0, 0, 7)
+
+	ctx, _, mockLoanRepo, s := initLoanTest(t)
+
+	mockLoanRepo.EXPECT().GetLoanRenewals(ctx, idLoan).Return([]entity.LoanRenewal{
+		{ID: "1", LoanID: idLoan, RenewedAt: renewed, PreviousDueAt: due, DueAt: due.AddDate(0, 0, 14)},
+	}, nil)
+
+	response, err := s.ListLoanRenewals(ctx, idLoan)
+	require.NoError(t, err)
+	require.Len(t, response.GetRenewals(), 1)
+	require.Equal(t, due.Unix(), response.GetRenewals()[0].GetPreviousDueAt().AsTime().Unix())
+
+	mockLoanRepo.EXPECT().GetLoanRenewals(ctx, idLoan).Return(nil, errInternalLoans)
+
+	response, err = s.ListLoanRenewals(ctx, idLoan)
+	require.ErrorIs(t, err, errInternalLoans)
+	require.Nil(t, response)
+}
@@ -0,0 +1,351 @@
+package library
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/project/library/pkg/logger"
+	"github.com/project/library/pkg/marc"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"go.uber.org/zap"
+)
+
+var marcContentTypes = map[library.MarcFormat]string{
+	library.MarcFormat_MARC_FORMAT_MARC21:  "application/marc",
+	library.MarcFormat_MARC_FORMAT_MARCXML: "application/marcxml+xml",
+}
+
+// marcMappedTags are tags of fields imported into books, 001 and 005 are made from id and updated_at on export.
+var marcMappedTags = map[string]bool{
+	"001": true, "005": true, "008": true, "020": true, "100": true, "245": true, "700": true,
+}
+
+const (
+	marcRelatorAuthor = "aut"
+	// marcDateLayout is the layout of 005 field
+	marcDateLayout = "20060102150405.0"
+	// marcEnteredLayout is the layout of date entered in 008 field
+	marcEnteredLayout = "060102"
+)
+
+type marcReader interface {
+	Read() (marc.Record, error)
+}
+
+type marcWriter interface {
+	Write(record marc.Record) error
+}
+
+func newMarcReader(format library.MarcFormat, r io.Reader) marcReader {
+	if format == library.MarcFormat_MARC_FORMAT_MARCXML {
+		return marc.NewXMLReader(r)
+	}
+	return marc.NewReader(r)
+}
+
+func newMarcWriter(format library.MarcFormat, w io.Writer) marcWriter {
+	if format == library.MarcFormat_MARC_FORMAT_MARCXML {
+		return marc.NewXMLWriter(w)
+	}
+	return marc.NewWriter(w)
+}
+
+// ImportMarc adds books from MARC records, 245 is mapped to title, 100 and 700 of authors to names of authors,
+// 020 to ISBN and 008 to publication year. Invalid records are reported in the results and don't stop the import,
+// unlike malformed MARCXML document.
+func (l *libraryImpl) ImportMarc(ctx context.Context, format library.MarcFormat, records io.Reader) (*library.ImportMarcResponse, error) {
+	reader := newMarcReader(format, records)
+	importer := l.newCatalogImporter(ctx)
+	unmapped := make(map[int64][]string)
+
+	for n := int64(1); ; n++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if errors.Is(err, marc.ErrInvalidRecord) {
+			importer.fail(n, err)
+			continue
+		}
+
+		var syntaxErr *xml.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			err = fmt.Errorf("%s: %w", err.Error(), entity.ErrInvalidImport)
+		}
+		if logger.CheckError(err, l.logger, "Failed reading MARC records", zap.Int64("record", n), zap.Error(err)) {
+			return nil, err
+		}
+
+		row, fields, err := importRowFromMarc(record)
+		unmapped[n] = fields
+		if err != nil {
+			importer.fail(n, err)
+			continue
+		}
+		row.Line = n
+		importer.add(row)
+	}
+
+	results := importer.finish()
+	response := &library.ImportMarcResponse{AuthorsCreated: uint32(importer.created)}
+	for _, r := range results {
+		result := &library.MarcRecordResult{Record: uint32(r.Line), BookId: r.BookID, UnmappedFields: unmapped[r.Line]}
+		if r.Err != nil {
+			result.Error = r.Err.Error()
+			response.Failed++
+		} else {
+			response.Imported++
+		}
+		response.Results = append(response.Results, result)
+	}
+
+	if l.logger != nil {
+		l.logger.Info("Imported MARC records", zap.Uint32("imported", response.Imported),
+			zap.Uint32("failed", response.Failed), zap.Uint32("authors created", response.AuthorsCreated))
+	}
+
+	return response, nil
+}
+
+// importRowFromMarc maps the record to the book and returns tags of fields which are not mapped.
+// Added entries (700) with relators other than author are not mapped.
+func importRowFromMarc(record marc.Record) (entity.ImportRow, []string, error) {
+	var (
+		row      entity.ImportRow
+		unmapped []string
+	)
+	for _, f := range record.Fields {
+		if !marcMappedTags[f.Tag] || (f.Tag == "700" && !isMarcAuthor(f)) {
+			if !slices.Contains(unmapped, f.Tag) {
+				unmapped = append(unmapped, f.Tag)
+			}
+		}
+	}
+	slices.Sort(unmapped)
+
+	titles := record.FieldsByTag("245")
+	if len(titles) == 0 {
+		return entity.ImportRow{}, unmapped, fmt.Errorf("no title (245): %w", entity.ErrInvalidImport)
+	}
+	row.Title = trimMarcPunctuation(titles[0].Subfield('a'))
+	if subtitle := trimMarcPunctuation(titles[0].Subfield('b')); subtitle != "" {
+		row.Title += ": " + subtitle
+	}
+	if row.Title == "" {
+		return entity.ImportRow{}, unmapped, fmt.Errorf("empty title (245): %w", entity.ErrInvalidImport)
+	}
+
+	for _, f := range append(record.FieldsByTag("100"), record.FieldsByTag("700")...) {
+		if f.Tag == "700" && !isMarcAuthor(f) {
+			continue
+		}
+		name := marcName(f)
+		if err := (&library.RegisterAuthorRequest{Name: name}).Validate(); err != nil {
+			return entity.ImportRow{}, unmapped, fmt.Errorf("invalid author name %q (%s): %w", name, f.Tag, entity.ErrInvalidImport)
+		}
+		if !slices.Contains(row.AuthorNames, name) {
+			row.AuthorNames = append(row.AuthorNames, name)
+		}
+	}
+
+	for _, f := range record.FieldsByTag("020") {
+		// ISBN may be followed by qualifiers, e.g. "9780306406157 (pbk.)"
+		if isbn := strings.Fields(f.Subfield('a')); len(isbn) > 0 {
+			normalized, err := entity.NormalizeISBN(isbn[0])
+			if err != nil {
+				return entity.ImportRow{}, unmapped, fmt.Errorf("%s (020): %w", isbn[0], err)
+			}
+			row.ISBN = normalized
+			break
+		}
+	}
+
+	if fields := record.FieldsByTag("008"); len(fields) > 0 && len(fields[0].Value) >= 11 {
+		// Date 1 at positions 07-10, unknown digits are 'u'
+		if year, err := strconv.ParseInt(fields[0].Value[7:11], 10, 16); err == nil && year > 0 {
+			row.PublicationYear = int16(year)
+		}
+	}
+
+	return row, unmapped, nil
+}
+
+// isMarcAuthor reports whether the added entry is an author: relator code (4) is "aut"
+// or relator term (e) is "author" or the entry has no relators.
+func isMarcAuthor(f marc.Field) bool {
+	hasRelator := false
+	for _, s := range f.Subfields {
+		switch s.Code {
+		case '4':
+			hasRelator = true
+			if strings.TrimSpace(s.Value) == marcRelatorAuthor {
+				return true
+			}
+		case 'e':
+			hasRelator = true
+			if strings.EqualFold(trimMarcPunctuation(s.Value), "author") {
+				return true
+			}
+		}
+	}
+	return !hasRelator
+}
+
+// trimMarcPunctuation removes ISBD punctuation ending the subfield.
+func trimMarcPunctuation(value string) string {
+	return strings.TrimRight(strings.TrimSpace(value), " /:;,=")
+}
+
+// marcName returns the personal name of the field in direct order, inverted names
+// (first indicator is 1) like "Herbert, Frank" are turned into "Frank Herbert".
+func marcName(f marc.Field) string {
+	name := trimMarcPunctuation(f.Subfield('a'))
+	// the ending period is punctuation unless it ends an initial like "Tolkien, J. R. R."
+	if before, ok := strings.CutSuffix(name, "."); ok {
+		if i := strings.LastIndexAny(before, " ."); len(before)-i-1 > 1 {
+			name = before
+		}
+	}
+
+	if f.Indicators[0] == '1' {
+		if surname, forename, ok := strings.Cut(name, ", "); ok {
+			name = strings.TrimSpace(forename) + " " + strings.TrimSpace(surname)
+		}
+	}
+	return name
+}
+
+// marcRecord maps the book to MARC record with 001 of its id and 005 of its updated_at,
+// names of authors are in direct order.
+func marcRecord(book entity.ExportedBook) marc.Record {
+	date := "nuuuu"
+	if book.PublicationYear != 0 {
+		date = fmt.Sprintf("s%04d", book.PublicationYear)
+	}
+
+	record := marc.Record{
+		Leader: marc.DefaultLeader,
+		Fields: []marc.Field{
+			{Tag: "001", Value: book.ID},
+			{Tag: "005", Value: book.UpdatedAt.UTC().Format(marcDateLayout)},
+			// date entered, type of date and Date 1, place, undefined book positions, language, source
+			{Tag: "008", Value: book.CreatedAt.UTC().Format(marcEnteredLayout) + date + "    xx " + strings.Repeat(" ", 17) + "und d"},
+		},
+	}
+
+	if book.ISBN != "" {
+		record.Fields = append(record.Fields, marc.Field{
+			Tag:        "020",
+			Indicators: [2]byte{' ', ' '},
+			Subfields:  []marc.Subfield{{Code: 'a', Value: book.ISBN}},
+		})
+	}
+
+	author := func(tag, name string) marc.Field {
+		return marc.Field{
+			Tag:        tag,
+			Indicators: [2]byte{'0', ' '},
+			Subfields:  []marc.Subfield{{Code: 'a', Value: name}, {Code: '4', Value: marcRelatorAuthor}},
+		}
+	}
+
+	// the first indicator of 245 tells whether the title is an added entry, it is if there is the main entry (100)
+	titleIndicator := byte('0')
+	if len(book.AuthorNames) > 0 {
+		record.Fields = append(record.Fields, author("100", book.AuthorNames[0]))
+		titleIndicator = '1'
+	}
+
+	record.Fields = append(record.Fields, marc.Field{
+		Tag:        "245",
+		Indicators: [2]byte{titleIndicator, '0'},
+		Subfields:  []marc.Subfield{{Code: 'a', Value: book.Name}},
+	})
+
+	for i := 1; i < len(book.AuthorNames); i++ {
+		record.Fields = append(record.Fields, author("700", book.AuthorNames[i]))
+	}
+
+	return record
+}
+
+// writeMarc writes books as MARC records in the format to w. Books are read till the channel is closed,
+// then the error of their stream is checked.
+func writeMarc(format library.MarcFormat, w io.WriteCloser, books <-chan entity.ExportedBook, booksErr func() error) error {
+	writer := newMarcWriter(format, w)
+	for book := range books {
+		if err := writer.Write(marcRecord(book)); err != nil {
+			return fmt.Errorf("write MARC record of book %s: %w", book.ID, err)
+		}
+	}
+	if err := booksErr(); err != nil {
+		return err
+	}
+
+	if closer, ok := writer.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			return err
+		}
+	}
+	return w.Close()
+}
+
+// ExportMarc streams the book with idBook or the whole catalog if it is empty as MARC records,
+// the returned function reports the error the stream was broken by, it must be called after the channel is closed.
+func (l *libraryImpl) ExportMarc(
+	ctx context.Context,
+	format library.MarcFormat,
+	idBook string,
+) (<-chan *library.ExportMarcResponse, func() error, error) {
+	var (
+		books    <-chan entity.ExportedBook
+		booksErr func() error
+	)
+	if idBook != "" {
+		book, err := l.booksRepository.GetExportedBook(ctx, idBook)
+		if logger.CheckError(err, l.logger, "Failed get book", zap.String("book id", idBook), zap.Error(err)) {
+			return nil, nil, err
+		}
+		one := make(chan entity.ExportedBook, 1)
+		one <- book
+		close(one)
+		books, booksErr = one, func() error { return nil }
+	} else {
+		var err error
+		books, booksErr, err = l.booksRepository.ExportBooks(ctx, time.Time{}, time.Time{})
+		if logger.CheckError(err, l.logger, "Failed exporting catalog", zap.Error(err)) {
+			return nil, nil, err
+		}
+	}
+	if l.logger != nil {
+		l.logger.Info("Exporting MARC records", zap.String("format", format.String()), zap.String("book id", idBook))
+	}
+
+	ans := make(chan *library.ExportMarcResponse)
+	var streamErr error
+	go func() {
+		defer close(ans)
+		w := &chunkWriter[*library.ExportMarcResponse]{
+			ctx:         ctx,
+			ans:         ans,
+			contentType: marcContentTypes[format],
+			newChunk: func(contentType string, data []byte) *library.ExportMarcResponse {
+				return &library.ExportMarcResponse{ContentType: contentType, Chunk: data}
+			},
+		}
+
+		streamErr = writeMarc(format, w, books, booksErr)
+		logger.CheckError(streamErr, l.logger, "Failed encoding MARC records", zap.Error(streamErr))
+	}()
+
+	return ans, func() error { return streamErr }, nil
+}
@@ -0,0 +1,190 @@
+package library
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/pkg/marc"
+	"github.com/stretchr/testify/require"
+)
+
+func readMarcExport(t *testing.T, chunks <-chan *library.ExportMarcResponse) (string, []byte) {
+	t.Helper()
+	var (
+		contentType string
+		data        []byte
+	)
+	for chunk := range chunks {
+		if contentType == "" {
+			contentType = chunk.GetContentType()
+		}
+		data = append(data, chunk.GetChunk()...)
+	}
+	return contentType, data
+}
+
+func TestImportMarc(t *testing.T) {
+	t.Parallel()
+
+	const records = `<?xml version="1.0" encoding="UTF-8"?>
+<collection xmlns="http://www.loc.gov/MARC21/slim">
+  <record>
+    <leader>00000nam a2200000 i 4500</leader>
+    <controlfield tag="001">ocm1</controlfield>
+    <controlfield tag="008">650101s1965    nyu           000 1 eng d</controlfield>
+    <datafield tag="020" ind1=" " ind2=" "><subfield code="a">0-306-40615-2 (pbk.)</subfield></datafield>
+    <datafield tag="100" ind1="1" ind2=" "><subfield code="a">Herbert, Frank,</subfield><subfield code="e">author.</subfield></datafield>
+    <datafield tag="245" ind1="1" ind2="0"><subfield code="a">Dune :</subfield><subfield code="b">a novel /</subfield><subfield code="c">Frank Herbert.</subfield></datafield>
+    <datafield tag="650" ind1=" " ind2="0"><subfield code="a">Science fiction.</subfield></datafield>
+    <datafield tag="700" ind1="1" ind2=" "><subfield code="a">Tolkien, J. R. R.</subfield></datafield>
+    <datafield tag="700" ind1="1" ind2=" "><subfield code="a">Schoenherr, John,</subfield><subfield code="4">ill</subfield></datafield>
+  </record>
+  <record>
+    <leader>00000nam a2200000 i 4500</leader>
+    <datafield tag="100" ind1="1" ind2=" "><subfield code="a">Austen, Jane</subfield></datafield>
+  </record>
+  <record>
+    <leader>bad</leader>
+  </record>
+  <record>
+    <leader>00000nam a2200000 i 4500</leader>
+    <controlfield tag="008">650101nuuuu    xx            000 1 und d</controlfield>
+    <datafield tag="245" ind1="0" ind2="0"><subfield code="a">Beowulf.</subfield></datafield>
+  </record>
+</collection>`
+
+	t.Run("marcxml", func(t *testing.T) {
+		t.Parallel()
+		ctx, booksRepo, s := initBookTest(t)
+
+		booksRepo.EXPECT().ImportBooks(ctx, []entity.ImportRow{
+			{Line: 1, Title: "Dune: a novel", AuthorNames: []string{"Frank Herbert", "J. R. R. Tolkien"},
+				ISBN: "9780306406157", PublicationYear: 1965},
+			{Line: 4, Title: "Beowulf."},
+		}).DoAndReturn(func(_ context.Context, rows []entity.ImportRow) ([]entity.ImportResult, int64, error) {
+			return importedResults(rows), 2, nil
+		})
+
+		response, err := s.ImportMarc(ctx, library.MarcFormat_MARC_FORMAT_MARCXML, strings.NewReader(records))
+		require.NoError(t, err)
+		require.Equal(t, uint32(2), response.GetImported())
+		require.Equal(t, uint32(2), response.GetFailed())
+		require.Equal(t, uint32(2), response.GetAuthorsCreated())
+
+		results := response.GetResults()
+		require.Len(t, results, 4)
+		require.Equal(t, []string{"650", "700"}, results[0].GetUnmappedFields())
+		require.NotEmpty(t, results[0].GetBookId())
+		require.Contains(t, results[1].GetError(), "245")
+		require.Contains(t, results[2].GetError(), marc.ErrInvalidRecord.Error())
+		require.Empty(t, results[3].GetUnmappedFields())
+	})
+
+	t.Run("malformed marcxml", func(t *testing.T) {
+		t.Parallel()
+		ctx, _, s := initBookTest(t)
+
+		_, err := s.ImportMarc(ctx, library.MarcFormat_MARC_FORMAT_MARCXML, strings.NewReader("<collection><record>"))
+		require.ErrorIs(t, err, entity.ErrInvalidImport)
+	})
+
+	t.Run("invalid isbn", func(t *testing.T) {
+		t.Parallel()
+		ctx, _, s := initBookTest(t)
+
+		var buf bytes.Buffer
+		require.NoError(t, marc.NewWriter(&buf).Write(marc.Record{Fields: []marc.Field{
+			{Tag: "020", Subfields: []marc.Subfield{{Code: 'a', Value: "123"}}},
+			{Tag: "245", Subfields: []marc.Subfield{{Code: 'a', Value: "Emma"}}},
+		}}))
+
+		response, err := s.ImportMarc(ctx, library.MarcFormat_MARC_FORMAT_MARC21, &buf)
+		require.NoError(t, err)
+		require.Equal(t, uint32(1), response.GetFailed())
+		require.Contains(t, response.GetResults()[0].GetError(), entity.ErrInvalidISBN.Error())
+	})
+}
+
+func TestExportMarc(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	book := entity.ExportedBook{
+		Book: entity.Book{
+			ID:              uuid.NewString(),
+			Name:            "Good Omens",
+			AuthorIDs:       []string{"a1", "a2"},
+			ISBN:            "9780306406157",
+			PublicationYear: 1990,
+			CreatedAt:       created,
+			UpdatedAt:       created.Add(time.Hour),
+		},
+		AuthorNames: []string{"Terry Pratchett", "Neil Gaiman"},
+	}
+
+	t.Run("single book round trip", func(t *testing.T) {
+		t.Parallel()
+		ctx, booksRepo, s := initBookTest(t)
+
+		booksRepo.EXPECT().GetExportedBook(ctx, book.ID).Return(book, nil)
+
+		chunks, streamErr, err := s.ExportMarc(ctx, library.MarcFormat_MARC_FORMAT_MARC21, book.ID)
+		require.NoError(t, err)
+
+		contentType, data := readMarcExport(t, chunks)
+		require.NoError(t, streamErr())
+		require.Equal(t, "application/marc", contentType)
+
+		record, err := marc.NewReader(bytes.NewReader(data)).Read()
+		require.NoError(t, err)
+		tags := make([]string, len(record.Fields))
+		for i, f := range record.Fields {
+			tags[i] = f.Tag
+		}
+		require.Equal(t, []string{"001", "005", "008", "020", "100", "245", "700"}, tags)
+		require.Len(t, record.FieldsByTag("008")[0].Value, 40)
+		require.Equal(t, "20240501110000.0", record.FieldsByTag("005")[0].Value)
+
+		row, unmapped, err := importRowFromMarc(record)
+		require.NoError(t, err)
+		require.Empty(t, unmapped)
+		require.Equal(t, entity.ImportRow{
+			Title:           book.Name,
+			AuthorNames:     book.AuthorNames,
+			ISBN:            book.ISBN,
+			PublicationYear: book.PublicationYear,
+		}, row)
+	})
+
+	t.Run("catalog in marcxml", func(t *testing.T) {
+		t.Parallel()
+		ctx, booksRepo, s := initBookTest(t)
+
+		booksRepo.EXPECT().ExportBooks(ctx, time.Time{}, time.Time{}).Return(makeExportedBooks(book, book), noStreamErr, nil)
+
+		chunks, streamErr, err := s.ExportMarc(ctx, library.MarcFormat_MARC_FORMAT_MARCXML, "")
+		require.NoError(t, err)
+
+		contentType, data := readMarcExport(t, chunks)
+		require.NoError(t, streamErr())
+		require.Equal(t, "application/marcxml+xml", contentType)
+		require.Equal(t, 2, bytes.Count(data, []byte("<record>")))
+		require.True(t, bytes.HasSuffix(data, []byte("</collection>")))
+	})
+
+	t.Run("unknown book", func(t *testing.T) {
+		t.Parallel()
+		ctx, booksRepo, s := initBookTest(t)
+
+		booksRepo.EXPECT().GetExportedBook(ctx, book.ID).Return(entity.ExportedBook{}, entity.ErrBookNotFound)
+
+		_, _, err := s.ExportMarc(ctx, library.MarcFormat_MARC_FORMAT_MARC21, book.ID)
+		require.ErrorIs(t, err, entity.ErrBookNotFound)
+	})
+}
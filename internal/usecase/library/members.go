@@ -0,0 +1,164 @@
+package library
+
+import (
+	"context"
+
+	"github.com/project/library/pkg/logger"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const defaultMembersPageSize = 50
+
+var membershipTypes = map[library.MembershipType]entity.MembershipType{
+	library.MembershipType_MEMBERSHIP_TYPE_ADULT:   entity.MembershipAdult,
+	library.MembershipType_MEMBERSHIP_TYPE_CHILD:   entity.MembershipChild,
+	library.MembershipType_MEMBERSHIP_TYPE_STUDENT: entity.MembershipStudent,
+	library.MembershipType_MEMBERSHIP_TYPE_SENIOR:  entity.MembershipSenior,
+	library.MembershipType_MEMBERSHIP_TYPE_STAFF:   entity.MembershipStaff,
+}
+
+var memberStatuses = map[library.MemberStatus]entity.MemberStatus{
+	library.MemberStatus_MEMBER_STATUS_ACTIVE:    entity.MemberActive,
+	library.MemberStatus_MEMBER_STATUS_SUSPENDED: entity.MemberSuspended,
+}
+
+func convertMembershipTypeToAPI(membershipType entity.MembershipType) library.MembershipType {
+	for apiType, t := range membershipTypes {
+		if t == membershipType {
+			return apiType
+		}
+	}
+	return library.MembershipType_MEMBERSHIP_TYPE_UNSPECIFIED
+}
+
+func convertMemberStatusToAPI(status entity.MemberStatus) library.MemberStatus {
+	for apiStatus, s := range memberStatuses {
+		if s == status {
+			return apiStatus
+		}
+	}
+	return library.MemberStatus_MEMBER_STATUS_UNSPECIFIED
+}
+
+func convertMember(member *entity.Member) *library.Member {
+	return &library.Member{
+		Id:             member.ID,
+		CardNumber:     member.CardNumber,
+		Name:           member.Name,
+		Email:          member.Email,
+		Phone:          member.Phone,
+		Address:        member.Address,
+		MembershipType: convertMembershipTypeToAPI(member.Type),
+		ExpiryDate:     timestamppb.New(member.ExpiryDate),
+		Status:         convertMemberStatusToAPI(member.Status),
+		CreatedAt:      timestamppb.New(member.CreatedAt),
+		UpdatedAt:      timestamppb.New(member.UpdatedAt),
+	}
+}
+
+func (l *libraryImpl) RegisterMember(ctx context.Context, newMember *library.Member) (*library.RegisterMemberResponse, error) {
+	member, err := l.memberRepository.RegisterMember(ctx, entity.Member{
+		CardNumber: newMember.GetCardNumber(),
+		Name:       newMember.GetName(),
+		Email:      newMember.GetEmail(),
+		Phone:      newMember.GetPhone(),
+		Address:    newMember.GetAddress(),
+		Type:       membershipTypes[newMember.GetMembershipType()],
+		ExpiryDate: newMember.GetExpiryDate().AsTime(),
+	})
+
+	if logger.CheckError(err, l.logger, "Failed register member", zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Registered the member", zap.String("member's id", member.ID))
+	}
+
+	return &library.RegisterMemberResponse{
+		Member: convertMember(&member),
+	}, nil
+}
+
+func (l *libraryImpl) UpdateMember(ctx context.Context, updMember *library.Member) error {
+	err := l.memberRepository.UpdateMember(ctx, entity.Member{
+		ID:         updMember.GetId(),
+		CardNumber: updMember.GetCardNumber(),
+		Name:       updMember.GetName(),
+		Email:      updMember.GetEmail(),
+		Phone:      updMember.GetPhone(),
+		Address:    updMember.GetAddress(),
+		Type:       membershipTypes[updMember.GetMembershipType()],
+		ExpiryDate: updMember.GetExpiryDate().AsTime(),
+		Status:     memberStatuses[updMember.GetStatus()],
+	})
+
+	if !logger.CheckError(err, l.logger, "Failed updating member", zap.Error(err)) {
+		if l.logger != nil {
+			l.logger.Info("Updated the member", zap.String("id of member", updMember.GetId()))
+		}
+	}
+
+	return err
+}
+
+func (l *libraryImpl) GetMember(ctx context.Context, idMember string) (*library.GetMemberResponse, error) {
+	member, err := l.memberRepository.GetMember(ctx, idMember)
+
+	if logger.CheckError(err, l.logger, "Failed get member", zap.String("id of member", idMember), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Get the member", zap.String("id of member", idMember))
+	}
+
+	return &library.GetMemberResponse{
+		Member: convertMember(&member),
+	}, nil
+}
+
+func (l *libraryImpl) ListMembers(
+	ctx context.Context,
+	status library.MemberStatus,
+	membershipType library.MembershipType,
+	pageSize int,
+	pageToken string,
+) (*library.ListMembersResponse, error) {
+	if pageSize == 0 {
+		pageSize = defaultMembersPageSize
+	}
+
+	// one more member is requested to know whether there is the next page
+	members, err := l.memberRepository.ListMembers(ctx, entity.MemberFilter{
+		Status:          memberStatuses[status],
+		Type:            membershipTypes[membershipType],
+		AfterCardNumber: pageToken,
+		Limit:           pageSize + 1,
+	})
+
+	if logger.CheckError(err, l.logger, "Failed list members", zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Listed members", zap.Int("count", len(members)))
+	}
+
+	var nextPageToken string
+	if len(members) > pageSize {
+		members = members[:pageSize]
+		nextPageToken = members[pageSize-1].CardNumber
+	}
+
+	result := make([]*library.Member, 0, len(members))
+	for i := range members {
+		result = append(result, convertMember(&members[i]))
+	}
+
+	return &library.ListMembersResponse{
+		Members:       result,
+		NextPageToken: nextPageToken,
+	}, nil
+}
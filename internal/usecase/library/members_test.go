@@ -0,0 +1,251 @@
+package library
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/usecase/library/mocks"
+
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var errInternalMembers = errors.New("internal error")
+
+func initMemberTest(t *testing.T) (context.Context, *mocks.MockMemberRepository, *libraryImpl) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockMemberRepo := mocks.NewMockMemberRepository(ctrl)
+	ctx := context.Background()
+	logger, e := zap.NewProduction()
+	require.NoError(t, e)
+
+	muc := New(logger, Repositories{Member: mockMemberRepo}, Options{})
+	return ctx, mockMemberRepo, muc
+}
+
+func TestRegisterMember(t *testing.T) {
+	t.Parallel()
+
+	const (
+		id         = "123"
+		cardNumber = "C-0001"
+		name       = "Ivan Petrov"
+	)
+	expiry := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		requireErr error
+	}{
+		{name: "valid register member"},
+		{name: "register member with existing card number",
+			requireErr: entity.ErrMemberAlreadyExists},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, mockMemberRepo, s := initMemberTest(t)
+
+			mockMemberRepo.EXPECT().RegisterMember(ctx, entity.Member{
+				CardNumber: cardNumber,
+				Name:       name,
+				Email:      "ivan@example.com",
+				Type:       entity.MembershipStudent,
+				ExpiryDate: expiry,
+			}).DoAndReturn(func(_ context.Context, member entity.Member) (entity.Member, error) {
+				if test.requireErr != nil {
+					return entity.Member{}, test.requireErr
+				}
+				member.ID = id
+				member.Status = entity.MemberActive
+				return member, nil
+			})
+
+			response, err := s.RegisterMember(ctx, &library.Member{
+				CardNumber:     cardNumber,
+				Name:           name,
+				Email:          "ivan@example.com",
+				MembershipType: library.MembershipType_MEMBERSHIP_TYPE_STUDENT,
+				ExpiryDate:     timestamppb.New(expiry),
+			})
+			require.Equal(t, test.requireErr, err)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, id, response.GetMember().GetId())
+			require.Equal(t, library.MemberStatus_MEMBER_STATUS_ACTIVE, response.GetMember().GetStatus())
+			require.Equal(t, library.MembershipType_MEMBERSHIP_TYPE_STUDENT, response.GetMember().GetMembershipType())
+			require.True(t, expiry.Equal(response.GetMember().GetExpiryDate().AsTime()))
+		})
+	}
+}
+
+func TestUpdateMember(t *testing.T) {
+	t.Parallel()
+
+	const id = "123"
+	expiry := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		requireErr error
+	}{
+		{name: "valid update member"},
+		{name: "update unknown member",
+			requireErr: entity.ErrMemberNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, mockMemberRepo, s := initMemberTest(t)
+
+			mockMemberRepo.EXPECT().UpdateMember(ctx, entity.Member{
+				ID:         id,
+				CardNumber: "C-0002",
+				Name:       "Anna",
+				Type:       entity.MembershipAdult,
+				ExpiryDate: expiry,
+				Status:     entity.MemberSuspended,
+			}).Return(test.requireErr)
+
+			err := s.UpdateMember(ctx, &library.Member{
+				Id:             id,
+				CardNumber:     "C-0002",
+				Name:           "Anna",
+				MembershipType: library.MembershipType_MEMBERSHIP_TYPE_ADULT,
+				ExpiryDate:     timestamppb.New(expiry),
+				Status:         library.MemberStatus_MEMBER_STATUS_SUSPENDED,
+			})
+			require.Equal(t, test.requireErr, err)
+		})
+	}
+}
+
+func TestGetMember(t *testing.T) {
+	t.Parallel()
+
+	const id = "123"
+
+	tests := []struct {
+		name       string
+		requireErr error
+	}{
+		{name: "valid get member"},
+		{name: "get unknown member",
+			requireErr: entity.ErrMemberNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, mockMemberRepo, s := initMemberTest(t)
+
+			mockMemberRepo.EXPECT().GetMember(ctx, id).DoAndReturn(func(_ context.Context, id string) (entity.Member, error) {
+				if test.requireErr != nil {
+					return entity.Member{}, test.requireErr
+				}
+				return entity.Member{ID: id, Name: "Anna", Type: entity.MembershipChild, Status: entity.MemberActive}, nil
+			})
+
+			response, err := s.GetMember(ctx, id)
+			require.Equal(t, test.requireErr, err)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, id, response.GetMember().GetId())
+			require.Equal(t, library.MembershipType_MEMBERSHIP_TYPE_CHILD, response.GetMember().GetMembershipType())
+		})
+	}
+}
+
+func generateMembers(n int) []entity.Member {
+	members := make([]entity.Member, n)
+	for i := range members {
+		members[i] = entity.Member{
+			ID:         strconv.Itoa(i),
+			CardNumber: "C-" + strconv.Itoa(1000+i),
+			Status:     entity.MemberActive,
+		}
+	}
+	return members
+}
+
+func TestListMembers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		pageSize       int
+		pageToken      string
+		requireLimit   int
+		found          int
+		requireCount   int
+		requireNextTok string
+		requireErr     error
+	}{
+		{name: "default page size",
+			requireLimit: defaultMembersPageSize + 1,
+			found:        3,
+			requireCount: 3},
+		{name: "not last page",
+			pageSize:       2,
+			requireLimit:   3,
+			found:          3,
+			requireCount:   2,
+			requireNextTok: "C-1001"},
+		{name: "last page with token",
+			pageSize:     2,
+			pageToken:    "C-0999",
+			requireLimit: 3,
+			found:        2,
+			requireCount: 2},
+		{name: "list with internal error",
+			requireLimit: defaultMembersPageSize + 1,
+			requireErr:   errInternalMembers},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, mockMemberRepo, s := initMemberTest(t)
+
+			mockMemberRepo.EXPECT().ListMembers(ctx, entity.MemberFilter{
+				Status:          entity.MemberActive,
+				AfterCardNumber: test.pageToken,
+				Limit:           test.requireLimit,
+			}).DoAndReturn(func(_ context.Context, _ entity.MemberFilter) ([]entity.Member, error) {
+				if test.requireErr != nil {
+					return nil, test.requireErr
+				}
+				return generateMembers(test.found), nil
+			})
+
+			response, err := s.ListMembers(ctx, library.MemberStatus_MEMBER_STATUS_ACTIVE,
+				library.MembershipType_MEMBERSHIP_TYPE_UNSPECIFIED, test.pageSize, test.pageToken)
+			require.Equal(t, test.requireErr, err)
+			if err != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Len(t, response.GetMembers(), test.requireCount)
+			require.Equal(t, test.requireNextTok, response.GetNextPageToken())
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package library
+
+import (
+	"context"
+
+	"github.com/project/library/pkg/logger"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"go.uber.org/zap"
+)
+
+func (l *libraryImpl) RegisterPublisher(ctx context.Context, name, country, parentID string) (*library.RegisterPublisherResponse, error) {
+	publisher, err := l.publisherRepository.RegisterPublisher(ctx, entity.Publisher{
+		Name:     name,
+		Country:  country,
+		ParentID: parentID,
+	})
+
+	if logger.CheckError(err, l.logger, "Failed register publisher", zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Registered the publisher", zap.String("publisher's id", publisher.ID))
+	}
+
+	return &library.RegisterPublisherResponse{
+		Id: publisher.ID,
+	}, nil
+}
+
+func (l *libraryImpl) ChangePublisherInfo(ctx context.Context, idPublisher, newName, newCountry, newParentID string) error {
+	err := l.publisherRepository.ChangePublisherInfo(ctx, entity.Publisher{
+		ID:       idPublisher,
+		Name:     newName,
+		Country:  newCountry,
+		ParentID: newParentID,
+	})
+
+	if !logger.CheckError(err, l.logger, "Failed changing publisher", zap.Error(err)) {
+		if l.logger != nil {
+			l.logger.Info("Changed the publisher with id", zap.String("id of publisher", idPublisher))
+		}
+	}
+	return err
+}
+
+func (l *libraryImpl) GetPublisherInfo(ctx context.Context, idPublisher string) (*library.GetPublisherInfoResponse, error) {
+	publisher, err := l.publisherRepository.GetPublisherInfo(ctx, idPublisher)
+
+	if logger.CheckError(err, l.logger, "Failed get publisher info", zap.String("publisher id", idPublisher), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Get the publisher info", zap.String("publisher id", idPublisher))
+	}
+
+	return &library.GetPublisherInfoResponse{
+		Id:       publisher.ID,
+		Name:     publisher.Name,
+		Country:  publisher.Country,
+		ParentId: publisher.ParentID,
+	}, nil
+}
@@ -0,0 +1,174 @@
+package library
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/usecase/library/mocks"
+
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var errInternalPublisher = errors.New("internal error")
+
+func initPublisherTest(t *testing.T) (context.Context, *mocks.MockPublisherRepository, *libraryImpl) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockPublisherRepo := mocks.NewMockPublisherRepository(ctrl)
+	ctx := context.Background()
+	logger, e := zap.NewProduction()
+	require.NoError(t, e)
+
+	puc := New(logger, Repositories{Publisher: mockPublisherRepo}, Options{})
+	return ctx, mockPublisherRepo, puc
+}
+
+func TestRegisterPublisher(t *testing.T) {
+	t.Parallel()
+
+	const (
+		id       = "123"
+		name     = "Test publisher"
+		country  = "GB"
+		parentID = "456"
+	)
+
+	tests := []struct {
+		name             string
+		errDBRepoRequire error
+	}{
+		{name: "valid registration"},
+		{name: "register with internal error in data base repo",
+			errDBRepoRequire: errInternalPublisher},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, mockPublisherRepo, s := initPublisherTest(t)
+			tDBErr := test.errDBRepoRequire
+
+			mockPublisherRepo.EXPECT().RegisterPublisher(ctx, entity.Publisher{
+				Name:     name,
+				Country:  country,
+				ParentID: parentID,
+			}).DoAndReturn(func(ctx context.Context, input entity.Publisher) (entity.Publisher, error) {
+				if tDBErr != nil {
+					return entity.Publisher{}, tDBErr
+				}
+				input.ID = id
+				return input, nil
+			})
+			response, err := s.RegisterPublisher(ctx, name, country, parentID)
+			if tDBErr != nil {
+				require.Equal(t, tDBErr, err)
+				require.Nil(t, response)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, id, response.GetId())
+		})
+	}
+}
+
+func TestChangePublisherInfo(t *testing.T) {
+	t.Parallel()
+
+	const (
+		id      = "123"
+		name    = "Test publisher"
+		country = "US"
+	)
+
+	tests := []struct {
+		name       string
+		errRequire error
+	}{
+		{name: "valid change publisher",
+			errRequire: nil},
+		{name: "change unknown publisher",
+			errRequire: entity.ErrPublisherNotFound},
+		{name: "change with internal error",
+			errRequire: errInternalPublisher},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, mockPublisherRepo, s := initPublisherTest(t)
+			tErr := test.errRequire
+			mockPublisherRepo.EXPECT().ChangePublisherInfo(ctx, entity.Publisher{
+				ID:      id,
+				Name:    name,
+				Country: country,
+			}).Return(tErr)
+			err := s.ChangePublisherInfo(ctx, id, name, country, "")
+			require.Equal(t, tErr, err)
+		})
+	}
+}
+
+func TestGetPublisherInfo(t *testing.T) {
+	t.Parallel()
+
+	const (
+		id       = "123"
+		name     = "testName"
+		country  = "FR"
+		parentID = "456"
+	)
+
+	tests := []struct {
+		name            string
+		requireResponse *library.GetPublisherInfoResponse
+		requireErr      error
+	}{
+		{
+			name: "valid getting info",
+			requireResponse: &library.GetPublisherInfoResponse{
+				Id:       id,
+				Name:     name,
+				Country:  country,
+				ParentId: parentID,
+			},
+			requireErr: nil},
+
+		{
+			name:            "Get info with internal error",
+			requireResponse: nil,
+			requireErr:      errInternalPublisher},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, mockPublisherRepo, s := initPublisherTest(t)
+			tResp := test.requireResponse
+			tErr := test.requireErr
+
+			mockPublisherRepo.EXPECT().GetPublisherInfo(ctx, id).DoAndReturn(func(ctx context.Context, idPublisher string) (entity.Publisher, error) {
+				if tErr != nil {
+					return entity.Publisher{}, tErr
+				}
+				return entity.Publisher{
+					ID:       id,
+					Name:     name,
+					Country:  country,
+					ParentID: parentID,
+				}, nil
+			})
+			response, err := s.GetPublisherInfo(ctx, id)
+			require.Equal(t, tErr, err)
+			require.Equal(t, tResp, response)
+		})
+	}
+}
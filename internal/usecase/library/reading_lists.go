@@ -0,0 +1,181 @@
+package library
+
+import (
+	"context"
+
+	"github.com/project/library/pkg/logger"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var readingListKinds = map[library.ReadingListKind]entity.ReadingListKind{
+	library.ReadingListKind_READING_LIST_KIND_TO_READ: entity.ReadingListToRead,
+	library.ReadingListKind_READING_LIST_KIND_READING: entity.ReadingListReading,
+	library.ReadingListKind_READING_LIST_KIND_READ:    entity.ReadingListRead,
+	library.ReadingListKind_READING_LIST_KIND_CUSTOM:  entity.ReadingListCustom,
+}
+
+// defaultReadingListNames are the names of lists created without a name.
+var defaultReadingListNames = map[entity.ReadingListKind]string{
+	entity.ReadingListToRead:  "To read",
+	entity.ReadingListReading: "Reading",
+	entity.ReadingListRead:    "Read",
+}
+
+func convertReadingListKindToAPI(kind entity.ReadingListKind) library.ReadingListKind {
+	for apiKind, k := range readingListKinds {
+		if k == kind {
+			return apiKind
+		}
+	}
+	return library.ReadingListKind_READING_LIST_KIND_UNSPECIFIED
+}
+
+func convertReadingList(list *entity.ReadingList) *library.ReadingList {
+	return &library.ReadingList{
+		Id:        list.ID,
+		OwnerId:   list.OwnerID,
+		Name:      list.Name,
+		Kind:      convertReadingListKindToAPI(list.Kind),
+		Public:    list.Public,
+		BookCount: uint32(list.BookCount),
+		CreatedAt: timestamppb.New(list.CreatedAt),
+		UpdatedAt: timestamppb.New(list.UpdatedAt),
+	}
+}
+
+func (l *libraryImpl) CreateReadingList(
+	ctx context.Context,
+	idOwner, name string,
+	kind library.ReadingListKind,
+	public bool,
+) (*library.CreateReadingListResponse, error) {
+	list := entity.ReadingList{
+		OwnerID: idOwner,
+		Name:    name,
+		Kind:    readingListKinds[kind],
+		Public:  public,
+	}
+	if list.Name == "" {
+		list.Name = defaultReadingListNames[list.Kind]
+	}
+
+	list, err := l.readingListRepository.CreateReadingList(ctx, list)
+
+	if logger.CheckError(err, l.logger, "Failed create reading list", zap.String("id of owner", idOwner), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Created reading list", zap.String("id", list.ID), zap.String("id of owner", idOwner))
+	}
+
+	return &library.CreateReadingListResponse{
+		ReadingList: convertReadingList(&list),
+	}, nil
+}
+
+func (l *libraryImpl) ListReadingLists(ctx context.Context, idOwner, idViewer string) (*library.ListReadingListsResponse, error) {
+	lists, err := l.readingListRepository.GetOwnerReadingLists(ctx, idOwner, idOwner == idViewer)
+
+	if logger.CheckError(err, l.logger, "Failed get reading lists", zap.String("id of owner", idOwner), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Get reading lists", zap.String("id of owner", idOwner), zap.Int("count", len(lists)))
+	}
+
+	result := make([]*library.ReadingList, 0, len(lists))
+	for i := range lists {
+		result = append(result, convertReadingList(&lists[i]))
+	}
+
+	return &library.ListReadingListsResponse{
+		ReadingLists: result,
+	}, nil
+}
+
+func (l *libraryImpl) AddReadingListBook(ctx context.Context, idList, idOwner, idBook string) error {
+	err := l.readingListRepository.AddReadingListBook(ctx, idList, idOwner, idBook)
+
+	if !logger.CheckError(err, l.logger, "Failed add book to reading list", zap.String("id of list", idList),
+		zap.String("id of book", idBook), zap.Error(err)) {
+		if l.logger != nil {
+			l.logger.Info("Added book to reading list", zap.String("id of list", idList), zap.String("id of book", idBook))
+		}
+	}
+
+	return err
+}
+
+func (l *libraryImpl) RemoveReadingListBook(ctx context.Context, idList, idOwner, idBook string) error {
+	err := l.readingListRepository.RemoveReadingListBook(ctx, idList, idOwner, idBook)
+
+	if !logger.CheckError(err, l.logger, "Failed remove book from reading list", zap.String("id of list", idList),
+		zap.String("id of book", idBook), zap.Error(err)) {
+		if l.logger != nil {
+			l.logger.Info("Removed book from reading list", zap.String("id of list", idList), zap.String("id of book", idBook))
+		}
+	}
+
+	return err
+}
+
+func (l *libraryImpl) ReorderReadingListBook(ctx context.Context, idList, idOwner, idBook string, position uint32) error {
+	err := l.readingListRepository.ReorderReadingListBook(ctx, idList, idOwner, idBook, int64(position))
+
+	if !logger.CheckError(err, l.logger, "Failed reorder reading list", zap.String("id of list", idList),
+		zap.String("id of book", idBook), zap.Error(err)) {
+		if l.logger != nil {
+			l.logger.Info("Reordered reading list", zap.String("id of list", idList), zap.String("id of book", idBook),
+				zap.Uint32("position", position))
+		}
+	}
+
+	return err
+}
+
+func (l *libraryImpl) ShareReadingList(
+	ctx context.Context,
+	idList, idOwner string,
+	public bool,
+) (*library.ShareReadingListResponse, error) {
+	list, err := l.readingListRepository.ShareReadingList(ctx, idList, idOwner, public)
+
+	if logger.CheckError(err, l.logger, "Failed share reading list", zap.String("id of list", idList), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Shared reading list", zap.String("id of list", idList), zap.Bool("public", public))
+	}
+
+	return &library.ShareReadingListResponse{
+		ReadingList: convertReadingList(&list),
+	}, nil
+}
+
+// GetReadingListBooks streams books of the list in their order. Private lists are
+// reported as not found to anyone except their owner.
+func (l *libraryImpl) GetReadingListBooks(ctx context.Context, idList, idViewer string) (<-chan *library.Book, func() error, error) {
+	list, err := l.readingListRepository.GetReadingList(ctx, idList)
+	if err == nil && !list.VisibleTo(idViewer) {
+		err = entity.ErrReadingListNotFound
+	}
+
+	if logger.CheckError(err, l.logger, "Failed get reading list", zap.String("id of list", idList), zap.Error(err)) {
+		return nil, nil, err
+	}
+
+	books, streamErr, err := l.readingListRepository.GetReadingListBooks(ctx, idList)
+
+	if logger.CheckError(err, l.logger, "Failed get reading list books", zap.String("id of list", idList), zap.Error(err)) {
+		return nil, nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Got the reading list's books", zap.String("id of list", idList))
+	}
+
+	return convertBooks(ctx, books, nil), streamErr, nil
+}
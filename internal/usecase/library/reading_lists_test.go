@@ -0,0 +1,230 @@
+package library
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/usecase/library/mocks"
+
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var errInternalReadingLists = errors.New("internal error")
+
+func initReadingListTest(t *testing.T) (context.Context, *mocks.MockReadingListRepository, *libraryImpl) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockReadingListRepo := mocks.NewMockReadingListRepository(ctrl)
+	ctx := context.Background()
+	logger, e := zap.NewProduction()
+	require.NoError(t, e)
+
+	ruc := New(logger, Repositories{ReadingList: mockReadingListRepo}, Options{LoanPolicy: testLoanPolicy})
+	return ctx, mockReadingListRepo, ruc
+}
+
+func TestCreateReadingList(t *testing.T) {
+	t.Parallel()
+
+	const idOwner = "reader"
+
+	tests := []struct {
+		name        string
+		listName    string
+		kind        library.ReadingListKind
+		requireList entity.ReadingList
+		requireErr  error
+	}{
+		{name: "to read list with default name",
+			kind:        library.ReadingListKind_READING_LIST_KIND_TO_READ,
+			requireList: entity.ReadingList{OwnerID: idOwner, Name: "To read", Kind: entity.ReadingListToRead}},
+		{name: "read list with own name",
+			listName:    "Finished",
+			kind:        library.ReadingListKind_READING_LIST_KIND_READ,
+			requireList: entity.ReadingList{OwnerID: idOwner, Name: "Finished", Kind: entity.ReadingListRead}},
+		{name: "custom list",
+			listName:    "Favourites",
+			kind:        library.ReadingListKind_READING_LIST_KIND_CUSTOM,
+			requireList: entity.ReadingList{OwnerID: idOwner, Name: "Favourites", Kind: entity.ReadingListCustom}},
+		{name: "second list of the kind",
+			kind:        library.ReadingListKind_READING_LIST_KIND_READING,
+			requireList: entity.ReadingList{OwnerID: idOwner, Name: "Reading", Kind: entity.ReadingListReading},
+			requireErr:  entity.ErrReadingListAlreadyExists},
+		{name: "internal error",
+			kind:        library.ReadingListKind_READING_LIST_KIND_READING,
+			requireList: entity.ReadingList{OwnerID: idOwner, Name: "Reading", Kind: entity.ReadingListReading},
+			requireErr:  errInternalReadingLists},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, mockReadingListRepo, s := initReadingListTest(t)
+
+			mockReadingListRepo.EXPECT().CreateReadingList(ctx, test.requireList).
+				DoAndReturn(func(_ context.Context, list entity.ReadingList) (entity.ReadingList, error) {
+					if test.requireErr != nil {
+						return entity.ReadingList{}, test.requireErr
+					}
+					list.ID = "1"
+					return list, nil
+				})
+
+			response, err := s.CreateReadingList(ctx, idOwner, test.listName, test.kind, false)
+			require.ErrorIs(t, err, test.requireErr)
+			if test.requireErr != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, "1", response.GetReadingList().GetId())
+			require.Equal(t, test.requireList.Name, response.GetReadingList().GetName())
+			require.Equal(t, test.kind, response.GetReadingList().GetKind())
+		})
+	}
+}
+
+func TestListReadingLists(t *testing.T) {
+	t.Parallel()
+
+	const idOwner = "reader"
+
+	ctx, mockReadingListRepo, s := initReadingListTest(t)
+
+	mockReadingListRepo.EXPECT().GetOwnerReadingLists(ctx, idOwner, true).Return([]entity.ReadingList{
+		{ID: "1", OwnerID: idOwner, Kind: entity.ReadingListToRead},
+		{ID: "2", OwnerID: idOwner, Kind: entity.ReadingListCustom, Public: true, BookCount: 3},
+	}, nil)
+
+	response, err := s.ListReadingLists(ctx, idOwner, idOwner)
+	require.NoError(t, err)
+	require.Len(t, response.GetReadingLists(), 2)
+	require.Equal(t, uint32(3), response.GetReadingLists()[1].GetBookCount())
+
+	mockReadingListRepo.EXPECT().GetOwnerReadingLists(ctx, idOwner, false).Return([]entity.ReadingList{
+		{ID: "2", OwnerID: idOwner, Kind: entity.ReadingListCustom, Public: true},
+	}, nil)
+
+	response, err = s.ListReadingLists(ctx, idOwner, "other")
+	require.NoError(t, err)
+	require.Len(t, response.GetReadingLists(), 1)
+
+	mockReadingListRepo.EXPECT().GetOwnerReadingLists(ctx, idOwner, false).Return(nil, errInternalReadingLists)
+
+	response, err = s.ListReadingLists(ctx, idOwner, "")
+	require.ErrorIs(t, err, errInternalReadingLists)
+	require.Nil(t, response)
+}
+
+func TestChangeReadingListBooks(t *testing.T) {
+	t.Parallel()
+
+	const (
+		idList  = "123"
+		idBook  = "456"
+		idOwner = "member-1"
+	)
+
+	ctx, mockReadingListRepo, s := initReadingListTest(t)
+
+	mockReadingListRepo.EXPECT().AddReadingListBook(ctx, idList, idOwner, idBook).Return(nil)
+	require.NoError(t, s.AddReadingListBook(ctx, idList, idOwner, idBook))
+
+	mockReadingListRepo.EXPECT().AddReadingListBook(ctx, idList, idOwner, idBook).Return(entity.ErrReadingListBookExists)
+	require.ErrorIs(t, s.AddReadingListBook(ctx, idList, idOwner, idBook), entity.ErrReadingListBookExists)
+
+	mockReadingListRepo.EXPECT().ReorderReadingListBook(ctx, idList, idOwner, idBook, int64(2)).Return(nil)
+	require.NoError(t, s.ReorderReadingListBook(ctx, idList, idOwner, idBook, 2))
+
+	mockReadingListRepo.EXPECT().RemoveReadingListBook(ctx, idList, idOwner, idBook).Return(nil)
+	require.NoError(t, s.RemoveReadingListBook(ctx, idList, idOwner, idBook))
+
+	mockReadingListRepo.EXPECT().RemoveReadingListBook(ctx, idList, idOwner, idBook).Return(entity.ErrReadingListBookNotFound)
+	require.ErrorIs(t, s.RemoveReadingListBook(ctx, idList, idOwner, idBook), entity.ErrReadingListBookNotFound)
+}
+
+func TestShareReadingList(t *testing.T) {
+	t.Parallel()
+
+	const (
+		idList  = "123"
+		idOwner = "member-1"
+	)
+
+	ctx, mockReadingListRepo, s := initReadingListTest(t)
+
+	mockReadingListRepo.EXPECT().ShareReadingList(ctx, idList, idOwner, true).
+		Return(entity.ReadingList{ID: idList, Kind: entity.ReadingListRead, Public: true}, nil)
+	response, err := s.ShareReadingList(ctx, idList, idOwner, true)
+	require.NoError(t, err)
+	require.True(t, response.GetReadingList().GetPublic())
+
+	mockReadingListRepo.EXPECT().ShareReadingList(ctx, idList, idOwner, false).Return(entity.ReadingList{}, entity.ErrReadingListNotFound)
+	response, err = s.ShareReadingList(ctx, idList, idOwner, false)
+	require.ErrorIs(t, err, entity.ErrReadingListNotFound)
+	require.Nil(t, response)
+}
+
+func TestGetReadingListBooks(t *testing.T) {
+	t.Parallel()
+
+	const (
+		idList  = "123"
+		idOwner = "reader"
+	)
+
+	tests := []struct {
+		name         string
+		public       bool
+		viewer       string
+		requireBooks []entity.Book
+		requireErr   error
+	}{
+		{name: "private list of the owner",
+			viewer:       idOwner,
+			requireBooks: generateBooks(3, "456")},
+		{name: "public list of other user",
+			public:       true,
+			viewer:       "other",
+			requireBooks: generateBooks(2, "456")},
+		{name: "private list of other user",
+			viewer:     "other",
+			requireErr: entity.ErrReadingListNotFound},
+		{name: "internal error",
+			viewer:     idOwner,
+			requireErr: errInternalReadingLists},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, mockReadingListRepo, s := initReadingListTest(t)
+
+			mockReadingListRepo.EXPECT().GetReadingList(ctx, idList).
+				Return(entity.ReadingList{ID: idList, OwnerID: idOwner, Public: test.public}, nil)
+			if test.requireBooks != nil || errors.Is(test.requireErr, errInternalReadingLists) {
+				var returnChan <-chan entity.Book
+				if test.requireErr == nil {
+					returnChan = makeFilledChan(test.requireBooks)
+				}
+				mockReadingListRepo.EXPECT().GetReadingListBooks(ctx, idList).Return(returnChan, noStreamErr, test.requireErr)
+			}
+
+			bks, streamErr, err := s.GetReadingListBooks(ctx, idList, test.viewer)
+			require.ErrorIs(t, err, test.requireErr)
+			if test.requireErr != nil {
+				require.Nil(t, bks)
+				return
+			}
+			readFilledChan(t, test.requireBooks, bks)
+			require.NoError(t, streamErr())
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package library
+
+import (
+	"context"
+
+	"github.com/project/library/pkg/logger"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"go.uber.org/zap"
+)
+
+const defaultRecommendationsLimit = 10
+
+func convertScoreComponent(count, weight int64) *library.ScoreComponent {
+	return &library.ScoreComponent{
+		Count: uint32(count),
+		Score: uint64(count * weight),
+	}
+}
+
+func convertRecommendation(recommendation *entity.Recommendation, weights entity.RecommendationWeights) *library.Recommendation {
+	return &library.Recommendation{
+		Book:  convertBook(&recommendation.Book),
+		Score: uint64(recommendation.Score),
+		Breakdown: &library.ScoreBreakdown{
+			SharedAuthors:  convertScoreComponent(recommendation.SharedAuthors, weights.SharedAuthor),
+			SharedSubjects: convertScoreComponent(recommendation.SharedSubjects, weights.SharedSubject),
+			Relations:      convertScoreComponent(recommendation.Relations, weights.Relation),
+			ReadingLists:   convertScoreComponent(recommendation.ReadingLists, weights.ReadingList),
+			CoBorrowers:    convertScoreComponent(recommendation.CoBorrowers, weights.CoBorrower),
+		},
+	}
+}
+
+func (l *libraryImpl) RecommendBooks(ctx context.Context, idBook string, limit uint32) (*library.RecommendBooksResponse, error) {
+	if limit == 0 {
+		limit = defaultRecommendationsLimit
+	}
+
+	recommendations, err := l.recommendationRepository.GetRecommendations(ctx, idBook, int64(limit))
+
+	if logger.CheckError(err, l.logger, "Failed get recommendations", zap.String("id of book", idBook), zap.Error(err)) {
+		return nil, err
+	}
+
+	// Books without recommendations are rare, so the book is checked only then.
+	if len(recommendations) == 0 {
+		if _, err = l.booksRepository.GetBook(ctx, idBook); err != nil {
+			return nil, err
+		}
+	}
+	if l.logger != nil {
+		l.logger.Info("Got recommendations", zap.String("id of book", idBook), zap.Int("count", len(recommendations)))
+	}
+
+	result := make([]*library.Recommendation, 0, len(recommendations))
+	for i := range recommendations {
+		result = append(result, convertRecommendation(&recommendations[i], entity.DefaultRecommendationWeights))
+	}
+
+	return &library.RecommendBooksResponse{
+		Recommendations: result,
+	}, nil
+}
+
+// RefreshRecommendations recomputes recommendations of all books, responses of RecommendBooks
+// are served from the last computed ones.
+func (l *libraryImpl) RefreshRecommendations(ctx context.Context) error {
+	inserted, err := l.recommendationRepository.RefreshRecommendations(ctx, entity.DefaultRecommendationWeights,
+		entity.MaxRecommendations)
+
+	if logger.CheckError(err, l.logger, "Failed refresh recommendations", zap.Error(err)) {
+		return err
+	}
+	if l.logger != nil {
+		l.logger.Info("Refreshed recommendations", zap.Int64("recommendations", inserted))
+	}
+
+	return nil
+}
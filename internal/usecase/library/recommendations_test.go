@@ -0,0 +1,139 @@
+package library
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/project/library/internal/usecase/library/mocks"
+
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var errInternalRecommendations = errors.New("internal error")
+
+func initRecommendationTest(t *testing.T) (context.Context, *mocks.MockRecommendationRepository, *mocks.MockBooksRepository, *libraryImpl) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockRecommendationRepo := mocks.NewMockRecommendationRepository(ctrl)
+	mockBooksRepo := mocks.NewMockBooksRepository(ctrl)
+	ctx := context.Background()
+	logger, e := zap.NewProduction()
+	require.NoError(t, e)
+
+	ruc := New(logger, Repositories{Books: mockBooksRepo, Recommendation: mockRecommendationRepo}, Options{LoanPolicy: testLoanPolicy})
+	return ctx, mockRecommendationRepo, mockBooksRepo, ruc
+}
+
+func TestRecommendBooks(t *testing.T) {
+	t.Parallel()
+
+	const idBook = "123"
+
+	tests := []struct {
+		name         string
+		limit        uint32
+		requireLimit int64
+		requireErr   error
+	}{
+		{name: "default limit",
+			requireLimit: defaultRecommendationsLimit},
+		{name: "given limit",
+			limit:        3,
+			requireLimit: 3},
+		{name: "internal error",
+			requireLimit: defaultRecommendationsLimit,
+			requireErr:   errInternalRecommendations},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, mockRecommendationRepo, _, s := initRecommendationTest(t)
+
+			var recommendations []entity.Recommendation
+			if test.requireErr == nil {
+				recommendations = []entity.Recommendation{
+					{Book: entity.Book{ID: "1"}, SharedAuthors: 2, ReadingLists: 1, Score: 12},
+					{Book: entity.Book{ID: "2"}, SharedSubjects: 2, Relations: 1, CoBorrowers: 3, Score: 13},
+				}
+			}
+			mockRecommendationRepo.EXPECT().GetRecommendations(ctx, idBook, test.requireLimit).Return(recommendations, test.requireErr)
+
+			response, err := s.RecommendBooks(ctx, idBook, test.limit)
+			require.ErrorIs(t, err, test.requireErr)
+			if test.requireErr != nil {
+				require.Nil(t, response)
+				return
+			}
+
+			require.Len(t, response.GetRecommendations(), 2)
+			first := response.GetRecommendations()[0]
+			require.Equal(t, "1", first.GetBook().GetId())
+			require.Equal(t, uint64(12), first.GetScore())
+			require.Equal(t, uint32(2), first.GetBreakdown().GetSharedAuthors().GetCount())
+			require.Equal(t, uint64(10), first.GetBreakdown().GetSharedAuthors().GetScore())
+			require.Equal(t, uint64(2), first.GetBreakdown().GetReadingLists().GetScore())
+			require.Zero(t, first.GetBreakdown().GetRelations().GetCount())
+
+			second := response.GetRecommendations()[1]
+			require.Equal(t, uint32(2), second.GetBreakdown().GetSharedSubjects().GetCount())
+			require.Equal(t, uint64(6), second.GetBreakdown().GetSharedSubjects().GetScore())
+			require.Equal(t, uint64(4), second.GetBreakdown().GetRelations().GetScore())
+			require.Equal(t, uint64(3), second.GetBreakdown().GetCoBorrowers().GetScore())
+		})
+	}
+}
+
+func TestRecommendBooksWithoutRecommendations(t *testing.T) {
+	t.Parallel()
+
+	const idBook = "123"
+
+	tests := []struct {
+		name       string
+		getBookErr error
+	}{
+		{name: "book without recommendations"},
+		{name: "unknown book",
+			getBookErr: entity.ErrBookNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, mockRecommendationRepo, mockBooksRepo, s := initRecommendationTest(t)
+			mockRecommendationRepo.EXPECT().GetRecommendations(ctx, idBook, int64(defaultRecommendationsLimit)).Return(nil, nil)
+			mockBooksRepo.EXPECT().GetBook(ctx, idBook).Return(entity.Book{ID: idBook}, test.getBookErr)
+
+			response, err := s.RecommendBooks(ctx, idBook, 0)
+			require.ErrorIs(t, err, test.getBookErr)
+			if test.getBookErr != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Empty(t, response.GetRecommendations())
+		})
+	}
+}
+
+func TestRefreshRecommendations(t *testing.T) {
+	t.Parallel()
+
+	ctx, mockRecommendationRepo, _, s := initRecommendationTest(t)
+
+	mockRecommendationRepo.EXPECT().
+		RefreshRecommendations(ctx, entity.DefaultRecommendationWeights, int64(entity.MaxRecommendations)).
+		Return(int64(10), nil)
+	require.NoError(t, s.RefreshRecommendations(ctx))
+
+	mockRecommendationRepo.EXPECT().RefreshRecommendations(ctx, gomock.Any(), gomock.Any()).
+		Return(int64(0), errInternalRecommendations)
+	require.ErrorIs(t, s.RefreshRecommendations(ctx), errInternalRecommendations)
+}
@@ -0,0 +1,98 @@
+package library
+
+import (
+	"context"
+
+	"github.com/project/library/pkg/logger"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"go.uber.org/zap"
+)
+
+const defaultRelationDepth = 1
+
+var relationTypes = map[library.BookRelationType]entity.RelationType{
+	library.BookRelationType_BOOK_RELATION_TYPE_SEQUEL_OF:      entity.RelationSequelOf,
+	library.BookRelationType_BOOK_RELATION_TYPE_PREQUEL_OF:     entity.RelationPrequelOf,
+	library.BookRelationType_BOOK_RELATION_TYPE_ADAPTATION_OF:  entity.RelationAdaptationOf,
+	library.BookRelationType_BOOK_RELATION_TYPE_ADAPTED_AS:     entity.RelationAdaptedAs,
+	library.BookRelationType_BOOK_RELATION_TYPE_TRANSLATION_OF: entity.RelationTranslationOf,
+	library.BookRelationType_BOOK_RELATION_TYPE_TRANSLATED_AS:  entity.RelationTranslatedAs,
+	library.BookRelationType_BOOK_RELATION_TYPE_PART_OF:        entity.RelationPartOf,
+	library.BookRelationType_BOOK_RELATION_TYPE_CONTAINS:       entity.RelationContains,
+	library.BookRelationType_BOOK_RELATION_TYPE_COMMENTARY_ON:  entity.RelationCommentaryOn,
+	library.BookRelationType_BOOK_RELATION_TYPE_COMMENTED_BY:   entity.RelationCommentedBy,
+}
+
+func convertRelationTypeToAPI(relationType entity.RelationType) library.BookRelationType {
+	for apiType, t := range relationTypes {
+		if t == relationType {
+			return apiType
+		}
+	}
+	return library.BookRelationType_BOOK_RELATION_TYPE_UNSPECIFIED
+}
+
+func (l *libraryImpl) LinkBooks(ctx context.Context, idBook, idRelatedBook string, relationType library.BookRelationType) error {
+	err := l.relationRepository.LinkBooks(ctx, entity.Relation{
+		BookID:        idBook,
+		RelatedBookID: idRelatedBook,
+		Type:          relationTypes[relationType],
+	})
+
+	if !logger.CheckError(err, l.logger, "Failed link books", zap.Error(err)) {
+		if l.logger != nil {
+			l.logger.Info("Linked the books", zap.String("id of book", idBook),
+				zap.String("id of related book", idRelatedBook), zap.Stringer("type", relationType))
+		}
+	}
+
+	return err
+}
+
+func (l *libraryImpl) UnlinkBooks(ctx context.Context, idBook, idRelatedBook string, relationType library.BookRelationType) error {
+	err := l.relationRepository.UnlinkBooks(ctx, entity.Relation{
+		BookID:        idBook,
+		RelatedBookID: idRelatedBook,
+		Type:          relationTypes[relationType],
+	})
+
+	if !logger.CheckError(err, l.logger, "Failed unlink books", zap.Error(err)) {
+		if l.logger != nil {
+			l.logger.Info("Unlinked the books", zap.String("id of book", idBook),
+				zap.String("id of related book", idRelatedBook), zap.Stringer("type", relationType))
+		}
+	}
+
+	return err
+}
+
+func (l *libraryImpl) GetRelatedBooks(ctx context.Context, idBook string, depth int) (*library.GetRelatedBooksResponse, error) {
+	if depth == 0 {
+		depth = defaultRelationDepth
+	}
+
+	related, err := l.relationRepository.GetRelatedBooks(ctx, idBook, depth)
+
+	if logger.CheckError(err, l.logger, "Failed get related books", zap.String("id of book", idBook), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Get related books", zap.String("id of book", idBook), zap.Int("count", len(related)))
+	}
+
+	books := make([]*library.RelatedBook, 0, len(related))
+	for i := range related {
+		books = append(books, &library.RelatedBook{
+			Book:      convertBook(&related[i].Book),
+			Type:      convertRelationTypeToAPI(related[i].Type),
+			ViaBookId: related[i].ViaBookID,
+			Depth:     uint32(related[i].Depth),
+		})
+	}
+
+	return &library.GetRelatedBooksResponse{
+		Books: books,
+	}, nil
+}
@@ -0,0 +1,157 @@
+package library
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/usecase/library/mocks"
+
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var errInternalRelations = errors.New("internal error")
+
+func initRelationTest(t *testing.T) (context.Context, *mocks.MockRelationRepository, *libraryImpl) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockRelationRepo := mocks.NewMockRelationRepository(ctrl)
+	ctx := context.Background()
+	logger, e := zap.NewProduction()
+	require.NoError(t, e)
+
+	ruc := New(logger, Repositories{Relation: mockRelationRepo}, Options{})
+	return ctx, mockRelationRepo, ruc
+}
+
+func TestLinkUnlinkBooks(t *testing.T) {
+	t.Parallel()
+
+	const (
+		idBook    = "123"
+		idRelated = "456"
+	)
+
+	tests := []struct {
+		name       string
+		unlink     bool
+		apiType    library.BookRelationType
+		entityType entity.RelationType
+		requireErr error
+	}{
+		{name: "valid link books",
+			apiType:    library.BookRelationType_BOOK_RELATION_TYPE_TRANSLATION_OF,
+			entityType: entity.RelationTranslationOf},
+		{name: "link books with cycle",
+			apiType:    library.BookRelationType_BOOK_RELATION_TYPE_CONTAINS,
+			entityType: entity.RelationContains,
+			requireErr: entity.ErrRelationCycle},
+		{name: "link books with internal error",
+			apiType:    library.BookRelationType_BOOK_RELATION_TYPE_SEQUEL_OF,
+			entityType: entity.RelationSequelOf,
+			requireErr: errInternalRelations},
+		{name: "valid unlink books",
+			unlink:     true,
+			apiType:    library.BookRelationType_BOOK_RELATION_TYPE_COMMENTED_BY,
+			entityType: entity.RelationCommentedBy},
+		{name: "unlink not linked books",
+			unlink:     true,
+			apiType:    library.BookRelationType_BOOK_RELATION_TYPE_ADAPTED_AS,
+			entityType: entity.RelationAdaptedAs,
+			requireErr: entity.ErrRelationNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, mockRelationRepo, s := initRelationTest(t)
+			relation := entity.Relation{
+				BookID:        idBook,
+				RelatedBookID: idRelated,
+				Type:          test.entityType,
+			}
+
+			var err error
+			if test.unlink {
+				mockRelationRepo.EXPECT().UnlinkBooks(ctx, relation).Return(test.requireErr)
+				err = s.UnlinkBooks(ctx, idBook, idRelated, test.apiType)
+			} else {
+				mockRelationRepo.EXPECT().LinkBooks(ctx, relation).Return(test.requireErr)
+				err = s.LinkBooks(ctx, idBook, idRelated, test.apiType)
+			}
+			require.Equal(t, test.requireErr, err)
+		})
+	}
+}
+
+func TestGetRelatedBooks(t *testing.T) {
+	t.Parallel()
+
+	const idBook = "123"
+
+	related := []entity.RelatedBook{
+		{
+			Book:      entity.Book{ID: "456", Name: "Translation"},
+			Type:      entity.RelationTranslatedAs,
+			ViaBookID: idBook,
+			Depth:     1,
+		},
+		{
+			Book:      entity.Book{ID: "789", Name: "Anthology"},
+			Type:      entity.RelationPartOf,
+			ViaBookID: "456",
+			Depth:     2,
+		},
+	}
+
+	tests := []struct {
+		name         string
+		depth        int
+		requireDepth int
+		requireErr   error
+	}{
+		{name: "valid get related books with default depth",
+			requireDepth: defaultRelationDepth},
+		{name: "valid get related books with depth",
+			depth:        2,
+			requireDepth: 2},
+		{name: "get related books of unknown book",
+			requireDepth: defaultRelationDepth,
+			requireErr:   entity.ErrBookNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, mockRelationRepo, s := initRelationTest(t)
+
+			mockRelationRepo.EXPECT().GetRelatedBooks(ctx, idBook, test.requireDepth).DoAndReturn(
+				func(_ context.Context, _ string, _ int) ([]entity.RelatedBook, error) {
+					if test.requireErr != nil {
+						return nil, test.requireErr
+					}
+					return related, nil
+				})
+
+			response, err := s.GetRelatedBooks(ctx, idBook, test.depth)
+			require.Equal(t, test.requireErr, err)
+			if err != nil {
+				return
+			}
+
+			require.Len(t, response.GetBooks(), len(related))
+			require.Equal(t, "456", response.GetBooks()[0].GetBook().GetId())
+			require.Equal(t, library.BookRelationType_BOOK_RELATION_TYPE_TRANSLATED_AS, response.GetBooks()[0].GetType())
+			require.Equal(t, library.BookRelationType_BOOK_RELATION_TYPE_PART_OF, response.GetBooks()[1].GetType())
+			require.Equal(t, "456", response.GetBooks()[1].GetViaBookId())
+			require.Equal(t, uint32(2), response.GetBooks()[1].GetDepth())
+		})
+	}
+}
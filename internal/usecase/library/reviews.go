@@ -0,0 +1,118 @@
+package library
+
+import (
+	"context"
+
+	"github.com/project/library/pkg/logger"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func convertReview(review *entity.Review) *library.Review {
+	return &library.Review{
+		Id:        review.ID,
+		BookId:    review.BookID,
+		MemberId:  review.MemberID,
+		Rating:    uint32(review.Rating),
+		Text:      review.Text,
+		CreatedAt: timestamppb.New(review.CreatedAt),
+		UpdatedAt: timestamppb.New(review.UpdatedAt),
+	}
+}
+
+func convertRating(rating entity.Rating) *library.BookRating {
+	return &library.BookRating{
+		Average: rating.Average(),
+		Count:   uint32(rating.Count),
+	}
+}
+
+func (l *libraryImpl) CreateReview(ctx context.Context, idBook, idMember string, rating uint32, text string) (*library.CreateReviewResponse, error) {
+	review, err := l.reviewRepository.CreateReview(ctx, entity.Review{
+		BookID:   idBook,
+		MemberID: idMember,
+		Rating:   int64(rating),
+		Text:     text,
+	})
+
+	if logger.CheckError(err, l.logger, "Failed create review", zap.String("id of book", idBook),
+		zap.String("id of member", idMember), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Created review", zap.String("id", review.ID), zap.String("id of book", idBook))
+	}
+
+	return &library.CreateReviewResponse{
+		Review: convertReview(&review),
+	}, nil
+}
+
+// UpdateReview changes the review of the member, reviews of other members are not found.
+func (l *libraryImpl) UpdateReview(
+	ctx context.Context,
+	idReview, idMember string,
+	rating uint32,
+	text string,
+) (*library.UpdateReviewResponse, error) {
+	review, err := l.reviewRepository.UpdateReview(ctx, entity.Review{
+		ID:       idReview,
+		MemberID: idMember,
+		Rating:   int64(rating),
+		Text:     text,
+	})
+
+	if logger.CheckError(err, l.logger, "Failed update review", zap.String("id of review", idReview), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Updated review", zap.String("id of review", idReview))
+	}
+
+	return &library.UpdateReviewResponse{
+		Review: convertReview(&review),
+	}, nil
+}
+
+// DeleteReview deletes the review of the member, reviews of other members are not found.
+func (l *libraryImpl) DeleteReview(ctx context.Context, idReview, idMember string) error {
+	err := l.reviewRepository.DeleteReview(ctx, idReview, idMember)
+
+	if !logger.CheckError(err, l.logger, "Failed delete review", zap.String("id of review", idReview), zap.Error(err)) {
+		if l.logger != nil {
+			l.logger.Info("Deleted review", zap.String("id of review", idReview))
+		}
+	}
+
+	return err
+}
+
+func (l *libraryImpl) ListBookReviews(ctx context.Context, idBook string) (*library.ListBookReviewsResponse, error) {
+	reviews, err := l.reviewRepository.GetBookReviews(ctx, idBook)
+
+	if logger.CheckError(err, l.logger, "Failed get book's reviews", zap.String("id of book", idBook), zap.Error(err)) {
+		return nil, err
+	}
+
+	rating, err := l.reviewRepository.GetBookRating(ctx, idBook)
+
+	if logger.CheckError(err, l.logger, "Failed get book rating", zap.String("id of book", idBook), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Get book's reviews", zap.String("id of book", idBook), zap.Int("count", len(reviews)))
+	}
+
+	result := make([]*library.Review, 0, len(reviews))
+	for i := range reviews {
+		result = append(result, convertReview(&reviews[i]))
+	}
+
+	return &library.ListBookReviewsResponse{
+		Reviews: result,
+		Rating:  convertRating(rating),
+	}, nil
+}
@@ -0,0 +1,176 @@
+package library
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/project/library/internal/usecase/library/mocks"
+
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var errInternalReviews = errors.New("internal error")
+
+type reviewMocks struct {
+	books   *mocks.MockBooksRepository
+	copies  *mocks.MockCopyRepository
+	reviews *mocks.MockReviewRepository
+}
+
+func initReviewTest(t *testing.T) (context.Context, reviewMocks, *libraryImpl) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	m := reviewMocks{
+		books:   mocks.NewMockBooksRepository(ctrl),
+		copies:  mocks.NewMockCopyRepository(ctrl),
+		reviews: mocks.NewMockReviewRepository(ctrl),
+	}
+	ctx := context.Background()
+	logger, e := zap.NewProduction()
+	require.NoError(t, e)
+
+	ruc := New(logger, Repositories{Books: m.books, Copy: m.copies, Review: m.reviews}, Options{LoanPolicy: testLoanPolicy})
+	return ctx, m, ruc
+}
+
+func TestCreateReview(t *testing.T) {
+	t.Parallel()
+
+	const (
+		idBook   = "123"
+		idMember = "456"
+	)
+
+	tests := []struct {
+		name       string
+		requireErr error
+	}{
+		{name: "valid review"},
+		{name: "second review of member",
+			requireErr: entity.ErrReviewAlreadyExists},
+		{name: "unknown book",
+			requireErr: entity.ErrBookNotFound},
+		{name: "internal error",
+			requireErr: errInternalReviews},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, m, s := initReviewTest(t)
+
+			m.reviews.EXPECT().CreateReview(ctx, entity.Review{BookID: idBook, MemberID: idMember, Rating: 4, Text: "Good"}).
+				DoAndReturn(func(_ context.Context, review entity.Review) (entity.Review, error) {
+					if test.requireErr != nil {
+						return entity.Review{}, test.requireErr
+					}
+					review.ID = "1"
+					return review, nil
+				})
+
+			response, err := s.CreateReview(ctx, idBook, idMember, 4, "Good")
+			require.ErrorIs(t, err, test.requireErr)
+			if test.requireErr != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, "1", response.GetReview().GetId())
+			require.Equal(t, uint32(4), response.GetReview().GetRating())
+		})
+	}
+}
+
+func TestUpdateReview(t *testing.T) {
+	t.Parallel()
+
+	const (
+		idReview = "123"
+		idMember = "789"
+	)
+
+	ctx, m, s := initReviewTest(t)
+
+	m.reviews.EXPECT().UpdateReview(ctx, entity.Review{ID: idReview, MemberID: idMember, Rating: 2, Text: "Boring"}).
+		Return(entity.Review{ID: idReview, BookID: "456", MemberID: idMember, Rating: 2, Text: "Boring"}, nil)
+	response, err := s.UpdateReview(ctx, idReview, idMember, 2, "Boring")
+	require.NoError(t, err)
+	require.Equal(t, "Boring", response.GetReview().GetText())
+
+	m.reviews.EXPECT().UpdateReview(ctx, gomock.Any()).Return(entity.Review{}, entity.ErrReviewNotFound)
+	response, err = s.UpdateReview(ctx, idReview, idMember, 2, "Boring")
+	require.ErrorIs(t, err, entity.ErrReviewNotFound)
+	require.Nil(t, response)
+}
+
+func TestDeleteReview(t *testing.T) {
+	t.Parallel()
+
+	const (
+		idReview = "123"
+		idMember = "789"
+	)
+
+	ctx, m, s := initReviewTest(t)
+
+	m.reviews.EXPECT().DeleteReview(ctx, idReview, idMember).Return(nil)
+	require.NoError(t, s.DeleteReview(ctx, idReview, idMember))
+
+	m.reviews.EXPECT().DeleteReview(ctx, idReview, idMember).Return(entity.ErrReviewNotFound)
+	require.ErrorIs(t, s.DeleteReview(ctx, idReview, idMember), entity.ErrReviewNotFound)
+}
+
+func TestListBookReviews(t *testing.T) {
+	t.Parallel()
+
+	const idBook = "123"
+
+	ctx, m, s := initReviewTest(t)
+
+	m.reviews.EXPECT().GetBookReviews(ctx, idBook).Return([]entity.Review{
+		{ID: "1", BookID: idBook, Rating: 5},
+		{ID: "2", BookID: idBook, Rating: 2},
+	}, nil)
+	m.reviews.EXPECT().GetBookRating(ctx, idBook).Return(entity.Rating{Count: 2, Total: 7}, nil)
+
+	response, err := s.ListBookReviews(ctx, idBook)
+	require.NoError(t, err)
+	require.Len(t, response.GetReviews(), 2)
+	require.Equal(t, uint32(2), response.GetRating().GetCount())
+	require.InDelta(t, 3.5, response.GetRating().GetAverage(), 1e-9)
+
+	m.reviews.EXPECT().GetBookReviews(ctx, idBook).Return(nil, errInternalReviews)
+
+	response, err = s.ListBookReviews(ctx, idBook)
+	require.ErrorIs(t, err, errInternalReviews)
+	require.Nil(t, response)
+}
+
+func TestGetBookInfoRating(t *testing.T) {
+	t.Parallel()
+
+	const idBook = "123"
+
+	ctx, m, s := initReviewTest(t)
+
+	m.books.EXPECT().GetBook(ctx, idBook).Return(entity.Book{ID: idBook}, nil).Times(2)
+	m.copies.EXPECT().GetBookAvailability(ctx, idBook, "").Return(entity.Availability{}, nil).Times(2)
+	m.reviews.EXPECT().GetBookRating(ctx, idBook).Return(entity.Rating{}, nil)
+
+	response, err := s.GetBookInfo(ctx, idBook, "", "")
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), response.GetRating().GetCount())
+	require.Zero(t, response.GetRating().GetAverage())
+
+	m.reviews.EXPECT().GetBookRating(ctx, idBook).Return(entity.Rating{Count: 3, Total: 13}, nil)
+
+	response, err = s.GetBookInfo(ctx, idBook, "", "")
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), response.GetRating().GetCount())
+	require.InDelta(t, 13.0/3, response.GetRating().GetAverage(), 1e-9)
+}
@@ -0,0 +1,104 @@
+package library
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/pkg/logger"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultStatsRange      = 30 * 24 * time.Hour
+	defaultStatsTopAuthors = 10
+)
+
+var statsIntervals = map[library.StatsInterval]entity.StatsInterval{
+	library.StatsInterval_STATS_INTERVAL_UNSPECIFIED: entity.StatsDay,
+	library.StatsInterval_STATS_INTERVAL_DAY:         entity.StatsDay,
+	library.StatsInterval_STATS_INTERVAL_WEEK:        entity.StatsWeek,
+	library.StatsInterval_STATS_INTERVAL_MONTH:       entity.StatsMonth,
+}
+
+// GetLibraryStats returns the statistics computed by the last RefreshLibraryStats,
+// books are counted in buckets of the interval over [from; to).
+func (l *libraryImpl) GetLibraryStats(
+	ctx context.Context,
+	from, to time.Time,
+	interval library.StatsInterval,
+	topAuthors uint32,
+) (*library.GetLibraryStatsResponse, error) {
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if from.IsZero() {
+		from = to.Add(-defaultStatsRange)
+	}
+	if topAuthors == 0 {
+		topAuthors = defaultStatsTopAuthors
+	}
+
+	stats, err := l.statsRepository.GetLibraryStats(ctx)
+
+	if logger.CheckError(err, l.logger, "Failed get library stats", zap.Error(err)) {
+		return nil, err
+	}
+
+	added, err := l.statsRepository.GetBooksAdded(ctx, from, to, statsIntervals[interval])
+
+	if logger.CheckError(err, l.logger, "Failed get books added", zap.Time("from", from), zap.Time("to", to), zap.Error(err)) {
+		return nil, err
+	}
+
+	authors, err := l.statsRepository.GetTopAuthors(ctx, int64(topAuthors))
+
+	if logger.CheckError(err, l.logger, "Failed get top authors", zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Got library stats", zap.Time("refreshed at", stats.RefreshedAt))
+	}
+
+	response := &library.GetLibraryStatsResponse{
+		BookCount:             uint64(stats.BookCount),
+		AuthorCount:           uint64(stats.AuthorCount),
+		BooksWithoutAuthors:   uint64(stats.BooksWithoutAuthors),
+		AverageAuthorsPerBook: stats.AverageAuthorsPerBook,
+		BooksAdded:            make([]*library.BooksAddedBucket, len(added)),
+		TopAuthors:            make([]*library.AuthorBookCount, len(authors)),
+		RefreshedAt:           timestamppb.New(stats.RefreshedAt),
+	}
+	for i := range added {
+		response.BooksAdded[i] = &library.BooksAddedBucket{
+			Start: timestamppb.New(added[i].Start),
+			Count: uint64(added[i].Count),
+		}
+	}
+	for i := range authors {
+		response.TopAuthors[i] = &library.AuthorBookCount{
+			AuthorId:  authors[i].AuthorID,
+			Name:      authors[i].Name,
+			BookCount: uint64(authors[i].BookCount),
+		}
+	}
+
+	return response, nil
+}
+
+// RefreshLibraryStats recomputes the statistics returned by GetLibraryStats.
+func (l *libraryImpl) RefreshLibraryStats(ctx context.Context) error {
+	err := l.statsRepository.RefreshLibraryStats(ctx)
+
+	if logger.CheckError(err, l.logger, "Failed refresh library stats", zap.Error(err)) {
+		return err
+	}
+	if l.logger != nil {
+		l.logger.Info("Refreshed library stats")
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package library
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/usecase/library/mocks"
+
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var errInternalStats = errors.New("internal error")
+
+func initStatsTest(t *testing.T) (context.Context, *mocks.MockStatsRepository, *libraryImpl) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockStatsRepo := mocks.NewMockStatsRepository(ctrl)
+	ctx := context.Background()
+	logger, e := zap.NewProduction()
+	require.NoError(t, e)
+
+	suc := New(logger, Repositories{Stats: mockStatsRepo}, Options{LoanPolicy: testLoanPolicy})
+	return ctx, mockStatsRepo, suc
+}
+
+func TestGetLibraryStats(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)
+
+	ctx, mockStatsRepo, s := initStatsTest(t)
+
+	mockStatsRepo.EXPECT().GetLibraryStats(ctx).Return(entity.LibraryStats{
+		BookCount:             10,
+		AuthorCount:           4,
+		BooksWithoutAuthors:   1,
+		AverageAuthorsPerBook: 1.2,
+		RefreshedAt:           to,
+	}, nil)
+	mockStatsRepo.EXPECT().GetBooksAdded(ctx, from, to, entity.StatsMonth).Return([]entity.BooksAdded{
+		{Start: from, Count: 3},
+		{Start: from.AddDate(0, 1, 0), Count: 0},
+		{Start: from.AddDate(0, 2, 0), Count: 7},
+	}, nil)
+	mockStatsRepo.EXPECT().GetTopAuthors(ctx, int64(2)).Return([]entity.AuthorBookCount{
+		{AuthorID: "1", Name: "First", BookCount: 6},
+		{AuthorID: "2", Name: "Second", BookCount: 5},
+	}, nil)
+
+	response, err := s.GetLibraryStats(ctx, from, to, library.StatsInterval_STATS_INTERVAL_MONTH, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), response.GetBookCount())
+	require.Equal(t, uint64(1), response.GetBooksWithoutAuthors())
+	require.InDelta(t, 1.2, response.GetAverageAuthorsPerBook(), 1e-9)
+	require.Len(t, response.GetBooksAdded(), 3)
+	require.Equal(t, uint64(7), response.GetBooksAdded()[2].GetCount())
+	require.Len(t, response.GetTopAuthors(), 2)
+	require.Equal(t, "First", response.GetTopAuthors()[0].GetName())
+	require.Equal(t, to, response.GetRefreshedAt().AsTime())
+}
+
+func TestGetLibraryStatsDefaults(t *testing.T) {
+	t.Parallel()
+
+	ctx, mockStatsRepo, s := initStatsTest(t)
+
+	mockStatsRepo.EXPECT().GetLibraryStats(ctx).Return(entity.LibraryStats{}, nil)
+	mockStatsRepo.EXPECT().GetBooksAdded(ctx, gomock.Any(), gomock.Any(), entity.StatsDay).
+		DoAndReturn(func(_ context.Context, from, to time.Time, _ entity.StatsInterval) ([]entity.BooksAdded, error) {
+			require.Equal(t, defaultStatsRange, to.Sub(from))
+			require.WithinDuration(t, time.Now(), to, time.Minute)
+			return nil, nil
+		})
+	mockStatsRepo.EXPECT().GetTopAuthors(ctx, int64(defaultStatsTopAuthors)).Return(nil, nil)
+
+	response, err := s.GetLibraryStats(ctx, time.Time{}, time.Time{}, library.StatsInterval_STATS_INTERVAL_UNSPECIFIED, 0)
+	require.NoError(t, err)
+	require.Empty(t, response.GetBooksAdded())
+
+	mockStatsRepo.EXPECT().GetLibraryStats(ctx).Return(entity.LibraryStats{}, errInternalStats)
+
+	response, err = s.GetLibraryStats(ctx, time.Time{}, time.Time{}, library.StatsInterval_STATS_INTERVAL_DAY, 0)
+	require.ErrorIs(t, err, errInternalStats)
+	require.Nil(t, response)
+}
+
+func TestRefreshLibraryStats(t *testing.T) {
+	t.Parallel()
+
+	ctx, mockStatsRepo, s := initStatsTest(t)
+
+	mockStatsRepo.EXPECT().RefreshLibraryStats(ctx).Return(nil)
+	require.NoError(t, s.RefreshLibraryStats(ctx))
+
+	mockStatsRepo.EXPECT().RefreshLibraryStats(ctx).Return(errInternalStats)
+	require.ErrorIs(t, s.RefreshLibraryStats(ctx), errInternalStats)
+}
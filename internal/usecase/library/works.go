@@ -0,0 +1,94 @@
+package library
+
+import (
+	"context"
+
+	"github.com/project/library/pkg/logger"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/entity"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func convertWork(work *entity.Work) *library.Work {
+	return &library.Work{
+		Id:        work.ID,
+		Name:      work.Name,
+		AuthorId:  work.AuthorIDs,
+		CreatedAt: timestamppb.New(work.CreatedAt),
+		UpdatedAt: timestamppb.New(work.UpdatedAt),
+	}
+}
+
+func (l *libraryImpl) CreateWork(ctx context.Context, name string, authorIDs []string) (*library.CreateWorkResponse, error) {
+	work, err := l.workRepository.CreateWork(ctx, entity.Work{
+		Name:      name,
+		AuthorIDs: authorIDs,
+	})
+
+	if logger.CheckError(err, l.logger, "Failed creating work", zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Created work", zap.String("id", work.ID))
+	}
+
+	return &library.CreateWorkResponse{
+		Work: convertWork(&work),
+	}, nil
+}
+
+func (l *libraryImpl) ChangeWorkInfo(ctx context.Context, idWork, newName string, newAuthorIDs []string) error {
+	err := l.workRepository.ChangeWorkInfo(ctx, entity.Work{
+		ID:        idWork,
+		Name:      newName,
+		AuthorIDs: newAuthorIDs,
+	})
+
+	if !logger.CheckError(err, l.logger, "Failed changing work", zap.Error(err)) {
+		if l.logger != nil {
+			l.logger.Info("Changed the work with id", zap.String("id of work", idWork))
+		}
+	}
+	return err
+}
+
+func (l *libraryImpl) GetWorkInfo(ctx context.Context, idWork string) (*library.GetWorkInfoResponse, error) {
+	work, err := l.workRepository.GetWork(ctx, idWork)
+
+	if logger.CheckError(err, l.logger, "Failed get work info", zap.String("id of work", idWork), zap.Error(err)) {
+		return nil, err
+	}
+	if l.logger != nil {
+		l.logger.Info("Get the work", zap.String("id of work", idWork))
+	}
+
+	return &library.GetWorkInfoResponse{
+		Work: convertWork(&work),
+	}, nil
+}
+
+func (l *libraryImpl) AttachEdition(ctx context.Context, idWork, idBook string) error {
+	err := l.workRepository.AttachEdition(ctx, idWork, idBook)
+
+	if !logger.CheckError(err, l.logger, "Failed attach edition", zap.Error(err)) {
+		if l.logger != nil {
+			l.logger.Info("Attached the edition to work", zap.String("id of work", idWork), zap.String("id of book", idBook))
+		}
+	}
+
+	return err
+}
+
+func (l *libraryImpl) DetachEdition(ctx context.Context, idWork, idBook string) error {
+	err := l.workRepository.DetachEdition(ctx, idWork, idBook)
+
+	if !logger.CheckError(err, l.logger, "Failed detach edition", zap.Error(err)) {
+		if l.logger != nil {
+			l.logger.Info("Detached the edition from work", zap.String("id of work", idWork), zap.String("id of book", idBook))
+		}
+	}
+
+	return err
+}
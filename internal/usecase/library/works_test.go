@@ -0,0 +1,210 @@
+package library
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/project/library/internal/usecase/library/mocks"
+
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var errInternalWorks = errors.New("internal error")
+
+func initWorkTest(t *testing.T) (context.Context, *mocks.MockWorkRepository, *libraryImpl) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockWorkRepo := mocks.NewMockWorkRepository(ctrl)
+	ctx := context.Background()
+	logger, e := zap.NewProduction()
+	require.NoError(t, e)
+
+	wuc := New(logger, Repositories{Work: mockWorkRepo}, Options{})
+	return ctx, mockWorkRepo, wuc
+}
+
+func TestCreateWork(t *testing.T) {
+	t.Parallel()
+
+	const (
+		id   = "123"
+		name = "TestWork"
+	)
+	authors := []string{"1", "2", "3"}
+
+	tests := []struct {
+		name             string
+		errDBRepoRequire error
+	}{
+		{name: "valid create work"},
+
+		{name: "create with internal error in data base repo",
+			errDBRepoRequire: errInternalWorks},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, mockWorkRepo, s := initWorkTest(t)
+			tDBErr := test.errDBRepoRequire
+
+			mockWorkRepo.EXPECT().CreateWork(ctx, entity.Work{
+				Name:      name,
+				AuthorIDs: authors,
+			}).DoAndReturn(func(ctx context.Context, input entity.Work) (entity.Work, error) {
+				if tDBErr != nil {
+					return entity.Work{}, tDBErr
+				}
+				input.ID = id
+				return input, nil
+			})
+			response, err := s.CreateWork(ctx, name, authors)
+			if tDBErr != nil {
+				require.Equal(t, tDBErr, err)
+				require.Nil(t, response)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, id, response.GetWork().GetId())
+			require.Equal(t, name, response.GetWork().GetName())
+			require.Equal(t, authors, response.GetWork().GetAuthorId())
+		})
+	}
+}
+
+func TestChangeWorkInfo(t *testing.T) {
+	t.Parallel()
+
+	const (
+		id   = "123"
+		name = "TestWork"
+	)
+	authors := []string{"2", "1"}
+
+	tests := []struct {
+		name       string
+		requireErr error
+	}{
+		{name: "valid change work info"},
+
+		{name: "change unknown work",
+			requireErr: entity.ErrWorkNotFound},
+
+		{name: "change with unknown author",
+			requireErr: entity.ErrAuthorNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, mockWorkRepo, s := initWorkTest(t)
+
+			mockWorkRepo.EXPECT().ChangeWorkInfo(ctx, entity.Work{
+				ID:        id,
+				Name:      name,
+				AuthorIDs: authors,
+			}).Return(test.requireErr)
+
+			require.Equal(t, test.requireErr, s.ChangeWorkInfo(ctx, id, name, authors))
+		})
+	}
+}
+
+func TestGetWorkInfo(t *testing.T) {
+	t.Parallel()
+
+	const (
+		id   = "123"
+		name = "TestWork"
+	)
+	authors := []string{"1", "2"}
+
+	tests := []struct {
+		name       string
+		requireErr error
+	}{
+		{name: "valid get work info"},
+
+		{name: "get unknown work",
+			requireErr: entity.ErrWorkNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, mockWorkRepo, s := initWorkTest(t)
+			tErr := test.requireErr
+
+			mockWorkRepo.EXPECT().GetWork(ctx, id).DoAndReturn(func(ctx context.Context, id string) (entity.Work, error) {
+				if tErr != nil {
+					return entity.Work{}, tErr
+				}
+				return entity.Work{
+					ID:        id,
+					Name:      name,
+					AuthorIDs: authors,
+				}, nil
+			})
+
+			response, err := s.GetWorkInfo(ctx, id)
+			require.Equal(t, tErr, err)
+			if tErr != nil {
+				require.Nil(t, response)
+				return
+			}
+			require.Equal(t, id, response.GetWork().GetId())
+			require.Equal(t, name, response.GetWork().GetName())
+			require.Equal(t, authors, response.GetWork().GetAuthorId())
+		})
+	}
+}
+
+func TestAttachDetachEdition(t *testing.T) {
+	t.Parallel()
+
+	const (
+		idWork = "123"
+		idBook = "456"
+	)
+
+	tests := []struct {
+		name       string
+		detach     bool
+		requireErr error
+	}{
+		{name: "valid attach edition"},
+		{name: "attach edition with internal error",
+			requireErr: errInternalWorks},
+		{name: "valid detach edition",
+			detach: true},
+		{name: "detach not attached edition",
+			detach:     true,
+			requireErr: entity.ErrBookNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, mockWorkRepo, s := initWorkTest(t)
+
+			var err error
+			if test.detach {
+				mockWorkRepo.EXPECT().DetachEdition(ctx, idWork, idBook).Return(test.requireErr)
+				err = s.DetachEdition(ctx, idWork, idBook)
+			} else {
+				mockWorkRepo.EXPECT().AttachEdition(ctx, idWork, idBook).Return(test.requireErr)
+				err = s.AttachEdition(ctx, idWork, idBook)
+			}
+			require.Equal(t, test.requireErr, err)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/project/library/internal/entity"
+)
+
+const (
+	dirPermissions  = 0o755
+	filePermissions = 0o644
+)
+
+var _ FileStorage = (*fileStorage)(nil)
+
+var hashRegexp = regexp.MustCompile("^[0-9a-f]{64}$")
+
+// fileStorage is a content-addressed storage on local disk,
+// file is stored as dir/<first two symbols of hash>/<hash>, where hash is sha256 of its content.
+type fileStorage struct {
+	dir string
+}
+
+func NewFileStorage(dir string) *fileStorage {
+	return &fileStorage{
+		dir: dir,
+	}
+}
+
+func (f *fileStorage) path(hash string) string {
+	return filepath.Join(f.dir, hash[:2], hash)
+}
+
+func (f *fileStorage) SaveFile(_ context.Context, data []byte) (string, error) {
+	sum := sha256.Sum256(data)
+	hash := hex.EncodeToString(sum[:])
+	path := f.path(hash)
+
+	if _, err := os.Stat(path); err == nil {
+		return hash, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), dirPermissions); err != nil {
+		return "", err
+	}
+
+	// file is renamed after writing, so readers never see a partially written file
+	tmp, err := os.CreateTemp(filepath.Dir(path), hash+".*.tmp")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return "", err
+	}
+	if err = tmp.Close(); err != nil {
+		return "", err
+	}
+	if err = os.Chmod(tmp.Name(), filePermissions); err != nil {
+		return "", err
+	}
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return "", err
+	}
+
+	return hash, nil
+}
+
+func (f *fileStorage) ReadFile(_ context.Context, hash string) ([]byte, error) {
+	if !hashRegexp.MatchString(hash) {
+		return nil, fmt.Errorf("invalid hash %q: %w", hash, entity.ErrCoverNotFound)
+	}
+
+	data, err := os.ReadFile(f.path(hash))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("no file with hash %s: %w", hash, entity.ErrCoverNotFound)
+	}
+
+	return data, err
+}
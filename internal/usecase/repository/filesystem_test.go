@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/project/library/internal/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileStorage(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	storage := NewFileStorage(t.TempDir())
+	data := []byte("cover")
+
+	hash, err := storage.SaveFile(ctx, data)
+	require.NoError(t, err)
+	require.Equal(t, "3fa405a8301ace34d11cf44a816080b8f0e49a48fbd048b8aef1543a8c58bdb6", hash)
+
+	sameHash, err := storage.SaveFile(ctx, data)
+	require.NoError(t, err)
+	require.Equal(t, hash, sameHash)
+
+	otherHash, err := storage.SaveFile(ctx, []byte("other cover"))
+	require.NoError(t, err)
+	require.NotEqual(t, hash, otherHash)
+
+	read, err := storage.ReadFile(ctx, hash)
+	require.NoError(t, err)
+	require.Equal(t, data, read)
+
+	// no temporary files are left after saving
+	entries, err := os.ReadDir(filepath.Dir(storage.path(hash)))
+	require.NoError(t, err)
+	for _, entry := range entries {
+		require.NotEqual(t, ".tmp", filepath.Ext(entry.Name()))
+	}
+}
+
+func TestFileStorageReadUnknown(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	storage := NewFileStorage(t.TempDir())
+
+	tests := []struct {
+		name string
+		hash string
+	}{
+		{name: "unknown hash",
+			hash: "6b86b273ff34fce19d6b804eff5a3f5747ada4eaa22f1d49c01e52ddb7875b4b"},
+		{name: "path traversal",
+			hash: "../../etc/passwd"},
+		{name: "empty hash",
+			hash: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := storage.ReadFile(ctx, test.hash)
+			require.ErrorIs(t, err, entity.ErrCoverNotFound)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/project/library/internal/entity"
 )
@@ -9,14 +10,135 @@ import (
 type (
 	AuthorRepository interface {
 		RegisterAuthor(ctx context.Context, author entity.Author) (entity.Author, error)
-		ChangeAuthorInfo(ctx context.Context, updAuthor entity.Author) error
+		ChangeAuthorInfo(ctx context.Context, updAuthor entity.Author, fields []string) error
 		GetAuthorInfo(ctx context.Context, idAuthor string) (entity.Author, error)
+		FindAuthors(ctx context.Context, name string) ([]entity.Author, error)
+		GetCollaborators(ctx context.Context, idAuthor string, maxDepth int64) ([]entity.Collaborator, error)
+		GetCollaborationPath(ctx context.Context, idFrom, idTo string, maxDepth int64) ([]entity.CollaborationStep, error)
 	}
 
 	BooksRepository interface {
 		AddBook(ctx context.Context, book entity.Book) (entity.Book, error)
 		UpdateBook(ctx context.Context, updBook entity.Book) error
 		GetBook(ctx context.Context, idBook string) (entity.Book, error)
-		GetAuthorBooks(ctx context.Context, idAuthor string) (<-chan entity.Book, error)
+		GetAuthorBooks(ctx context.Context, idAuthor string, role entity.ContributorRole, idBranch string) (<-chan entity.Book, func() error, error)
+		GetPublisherBooks(ctx context.Context, idPublisher, idBranch string) (<-chan entity.Book, func() error, error)
+		GetWorkEditions(ctx context.Context, idWork, idBranch string) (<-chan entity.Book, func() error, error)
+		SetBookLocalization(ctx context.Context, localization entity.Localization) error
+		RemoveBookLocalization(ctx context.Context, idBook, language string) error
+		SetBookSubjects(ctx context.Context, idBook string, subjects []string) error
+		ImportBooks(ctx context.Context, rows []entity.ImportRow) ([]entity.ImportResult, int64, error)
+		ExportBooks(ctx context.Context, updatedFrom, updatedTo time.Time) (<-chan entity.ExportedBook, func() error, error)
+		GetExportedBook(ctx context.Context, idBook string) (entity.ExportedBook, error)
+		GetExportedBooks(ctx context.Context, ids []string) ([]entity.ExportedBook, error)
+		SaveCiteKeys(ctx context.Context, keys map[string]string) (map[string]string, error)
+	}
+
+	PublisherRepository interface {
+		RegisterPublisher(ctx context.Context, publisher entity.Publisher) (entity.Publisher, error)
+		ChangePublisherInfo(ctx context.Context, updPublisher entity.Publisher) error
+		GetPublisherInfo(ctx context.Context, idPublisher string) (entity.Publisher, error)
+	}
+
+	WorkRepository interface {
+		CreateWork(ctx context.Context, work entity.Work) (entity.Work, error)
+		ChangeWorkInfo(ctx context.Context, updWork entity.Work) error
+		GetWork(ctx context.Context, idWork string) (entity.Work, error)
+		AttachEdition(ctx context.Context, idWork, idBook string) error
+		DetachEdition(ctx context.Context, idWork, idBook string) error
+	}
+
+	CoverRepository interface {
+		SetBookCover(ctx context.Context, idBook string, covers []entity.Cover) error
+		GetBookCover(ctx context.Context, idBook string, size entity.CoverSize) (entity.Cover, error)
+	}
+
+	FileStorage interface {
+		SaveFile(ctx context.Context, data []byte) (string, error)
+		ReadFile(ctx context.Context, hash string) ([]byte, error)
+	}
+
+	RelationRepository interface {
+		LinkBooks(ctx context.Context, relation entity.Relation) error
+		UnlinkBooks(ctx context.Context, relation entity.Relation) error
+		GetRelatedBooks(ctx context.Context, idBook string, depth int) ([]entity.RelatedBook, error)
+	}
+
+	CopyRepository interface {
+		AddCopy(ctx context.Context, newCopy entity.Copy) (entity.Copy, error)
+		UpdateCopy(ctx context.Context, updCopy entity.Copy) error
+		GetCopy(ctx context.Context, idCopy string) (entity.Copy, error)
+		GetCopyByBarcode(ctx context.Context, barcode string) (entity.Copy, error)
+		DeleteCopy(ctx context.Context, idCopy string) error
+		GetBookCopies(ctx context.Context, idBook, idBranch string) ([]entity.Copy, error)
+		GetBookAvailability(ctx context.Context, idBook, idBranch string) (entity.Availability, error)
+	}
+
+	MemberRepository interface {
+		RegisterMember(ctx context.Context, member entity.Member) (entity.Member, error)
+		UpdateMember(ctx context.Context, updMember entity.Member) error
+		GetMember(ctx context.Context, idMember string) (entity.Member, error)
+		ListMembers(ctx context.Context, filter entity.MemberFilter) ([]entity.Member, error)
+	}
+
+	LoanRepository interface {
+		CheckoutBook(ctx context.Context, loan entity.Loan, pickupUntil time.Time) (entity.Loan, error)
+		ReturnBook(ctx context.Context, idCopy string, returnedAt, pickupUntil time.Time) (entity.Loan, error)
+		GetMemberLoans(ctx context.Context, idMember string) ([]entity.Loan, error)
+		GetBookLoans(ctx context.Context, idBook string) ([]entity.Loan, error)
+		AccrueFines(ctx context.Context, now time.Time, policy entity.FinePolicy) (int64, error)
+		GetMemberBalance(ctx context.Context, idMember string, now time.Time) (entity.Balance, error)
+		PayFine(ctx context.Context, payment entity.FinePayment) (entity.Loan, error)
+		RenewLoan(ctx context.Context, idLoan string, renewedAt time.Time, policy entity.RenewalPolicy) (entity.Loan, error)
+		GetLoanRenewals(ctx context.Context, idLoan string) ([]entity.LoanRenewal, error)
+	}
+
+	HoldRepository interface {
+		PlaceHold(ctx context.Context, hold entity.Hold) (entity.Hold, error)
+		CancelHold(ctx context.Context, idHold string, cancelledAt, pickupUntil time.Time) (entity.Hold, error)
+		ExpireHolds(ctx context.Context, now, pickupUntil time.Time) ([]entity.Hold, error)
+		ListHolds(ctx context.Context, filter entity.HoldFilter) ([]entity.Hold, error)
+	}
+
+	BranchRepository interface {
+		CreateBranch(ctx context.Context, branch entity.Branch) (entity.Branch, error)
+		GetBranch(ctx context.Context, idBranch string) (entity.Branch, error)
+		ListBranches(ctx context.Context) ([]entity.Branch, error)
+		RequestTransfer(ctx context.Context, transfer entity.Transfer) (entity.Transfer, error)
+		ShipTransfer(ctx context.Context, idTransfer string, shippedAt time.Time) (entity.Transfer, error)
+		ReceiveTransfer(ctx context.Context, idTransfer string, receivedAt, pickupUntil time.Time) (entity.Transfer, error)
+		CancelTransfer(ctx context.Context, idTransfer string) (entity.Transfer, error)
+		ListTransfers(ctx context.Context, filter entity.TransferFilter) ([]entity.Transfer, error)
+	}
+
+	ReviewRepository interface {
+		CreateReview(ctx context.Context, review entity.Review) (entity.Review, error)
+		UpdateReview(ctx context.Context, review entity.Review) (entity.Review, error)
+		DeleteReview(ctx context.Context, idReview, idMember string) error
+		GetBookReviews(ctx context.Context, idBook string) ([]entity.Review, error)
+		GetBookRating(ctx context.Context, idBook string) (entity.Rating, error)
+	}
+
+	ReadingListRepository interface {
+		CreateReadingList(ctx context.Context, list entity.ReadingList) (entity.ReadingList, error)
+		GetReadingList(ctx context.Context, idList string) (entity.ReadingList, error)
+		GetOwnerReadingLists(ctx context.Context, idOwner string, withPrivate bool) ([]entity.ReadingList, error)
+		AddReadingListBook(ctx context.Context, idList, idOwner, idBook string) error
+		RemoveReadingListBook(ctx context.Context, idList, idOwner, idBook string) error
+		ReorderReadingListBook(ctx context.Context, idList, idOwner, idBook string, position int64) error
+		ShareReadingList(ctx context.Context, idList, idOwner string, public bool) (entity.ReadingList, error)
+		GetReadingListBooks(ctx context.Context, idList string) (<-chan entity.Book, func() error, error)
+	}
+
+	RecommendationRepository interface {
+		RefreshRecommendations(ctx context.Context, weights entity.RecommendationWeights, limit int64) (int64, error)
+		GetRecommendations(ctx context.Context, idBook string, limit int64) ([]entity.Recommendation, error)
+	}
+
+	StatsRepository interface {
+		RefreshLibraryStats(ctx context.Context) error
+		GetLibraryStats(ctx context.Context) (entity.LibraryStats, error)
+		GetBooksAdded(ctx context.Context, from, to time.Time, interval entity.StatsInterval) ([]entity.BooksAdded, error)
+		GetTopAuthors(ctx context.Context, limit int64) ([]entity.AuthorBookCount, error)
 	}
 )
@@ -5,20 +5,41 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
-	"maps"
-
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/project/library/internal/entity"
 	"go.uber.org/zap"
+	"golang.org/x/text/unicode/norm"
 )
 
-const ErrForeignKeyViolation = "23503"
+const (
+	ErrForeignKeyViolation = "23503"
+	ErrUniqueViolation     = "23505"
+	ErrCheckViolation      = "23514"
+)
 
 var _ AuthorRepository = (*postgresRepository)(nil)
 var _ BooksRepository = (*postgresRepository)(nil)
+var _ PublisherRepository = (*postgresRepository)(nil)
+var _ WorkRepository = (*postgresRepository)(nil)
+var _ CoverRepository = (*postgresRepository)(nil)
+var _ RelationRepository = (*postgresRepository)(nil)
+var _ CopyRepository = (*postgresRepository)(nil)
+var _ MemberRepository = (*postgresRepository)(nil)
+var _ LoanRepository = (*postgresRepository)(nil)
+var _ HoldRepository = (*postgresRepository)(nil)
+var _ BranchRepository = (*postgresRepository)(nil)
+var _ ReviewRepository = (*postgresRepository)(nil)
+var _ ReadingListRepository = (*postgresRepository)(nil)
+var _ RecommendationRepository = (*postgresRepository)(nil)
+var _ StatsRepository = (*postgresRepository)(nil)
 
 type postgresRepository struct {
 	logger *zap.Logger
@@ -61,21 +82,178 @@ func errAuthorConvert(err error) error {
 	return err
 }
 
-func (p *postgresRepository) addBookAuthors(ctx context.Context, tx pgx.Tx, bookID string, authors []string) error {
-	newAuthorRows := make([][]any, len(authors))
-	for i := 0; i < len(newAuthorRows); i++ {
-		newAuthorRows[i] = []any{authors[i], bookID}
+func errPublisherConvert(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == ErrForeignKeyViolation {
+		return fmt.Errorf("Unknown publisher was: %w", entity.ErrPublisherNotFound)
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.ErrPublisherNotFound
+	}
+
+	return err
+}
+
+func errBookConvert(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == ErrForeignKeyViolation {
+		return fmt.Errorf("Unknown book was: %w", entity.ErrBookNotFound)
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.ErrBookNotFound
+	}
+
+	return err
+}
+
+func errWorkConvert(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == ErrForeignKeyViolation {
+		return fmt.Errorf("Unknown work was: %w", entity.ErrWorkNotFound)
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.ErrWorkNotFound
+	}
+
+	return err
+}
+
+func errCopyConvert(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == ErrForeignKeyViolation {
+		if pgErr.ConstraintName == "book_copy_home_branch_id_fkey" {
+			return entity.ErrBranchNotFound
+		}
+		return fmt.Errorf("Unknown book was: %w", entity.ErrBookNotFound)
+	}
+
+	if errors.As(err, &pgErr) && pgErr.Code == ErrUniqueViolation {
+		return entity.ErrCopyAlreadyExists
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.ErrCopyNotFound
+	}
+
+	return err
+}
+
+func errMemberConvert(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == ErrUniqueViolation {
+		return entity.ErrMemberAlreadyExists
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.ErrMemberNotFound
+	}
+
+	return err
+}
+
+func errEditionConvert(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == ErrForeignKeyViolation && pgErr.ConstraintName == "book_work_id_fkey" {
+		return fmt.Errorf("Unknown work was: %w", entity.ErrWorkNotFound)
+	}
+
+	return errPublisherConvert(err)
+}
+
+// addEditionContributors adds contributors of the edition, authors are skipped, they are the authors of the work.
+func (p *postgresRepository) addEditionContributors(ctx context.Context, tx pgx.Tx, bookID string, contributors []entity.Contributor) error {
+	newContributorRows := make([][]any, 0, len(contributors))
+	for _, contributor := range contributors {
+		if contributor.Role != entity.RoleAuthor {
+			newContributorRows = append(newContributorRows,
+				[]any{contributor.AuthorID, bookID, string(contributor.Role), len(newContributorRows)})
+		}
 	}
 
 	_, err := tx.CopyFrom(
 		ctx,
 		pgx.Identifier{"author_book"},
-		[]string{"author_id", "book_id"},
+		[]string{"author_id", "book_id", "role", "position"},
+		pgx.CopyFromRows(newContributorRows))
+
+	return errAuthorConvert(err)
+}
+
+// setWorkAuthors replaces authors of the work keeping their order.
+func (p *postgresRepository) setWorkAuthors(ctx context.Context, tx pgx.Tx, workID string, authorIDs []string) error {
+	if _, err := tx.Exec(ctx, `DELETE FROM author_work WHERE work_id = $1`, workID); err != nil {
+		return err
+	}
+
+	newAuthorRows := make([][]any, len(authorIDs))
+	for i := 0; i < len(newAuthorRows); i++ {
+		newAuthorRows[i] = []any{authorIDs[i], workID, i}
+	}
+
+	_, err := tx.CopyFrom(
+		ctx,
+		pgx.Identifier{"author_work"},
+		[]string{"author_id", "work_id", "position"},
 		pgx.CopyFromRows(newAuthorRows))
 
 	return errAuthorConvert(err)
 }
 
+// bookColumns are the columns of book b joined with its book_contributor ab grouped by b.id,
+// they are read by scanBook. Authors of the work come first, then contributors of the edition,
+// both keep the order they were given in.
+const bookColumns = `
+b.id, b.name, COALESCE(b.publisher_id::text, ''), COALESCE(b.work_id::text, ''), COALESCE(b.isbn, ''), COALESCE(b.publication_year, 0),
+b.created_at, b.updated_at,
+array_agg(ab.author_id ORDER BY ab.position) FILTER (WHERE ab.role = 'AUTHOR') AS authors,
+array_agg(ab.author_id ORDER BY ab.position) FILTER (WHERE ab.author_id IS NOT NULL) AS contributor_ids,
+array_agg(ab.role::text ORDER BY ab.position) FILTER (WHERE ab.author_id IS NOT NULL) AS contributor_roles,
+ARRAY(SELECT bl.language FROM book_localization bl WHERE bl.book_id = b.id ORDER BY bl.language) AS languages,
+ARRAY(SELECT bl.title FROM book_localization bl WHERE bl.book_id = b.id ORDER BY bl.language) AS titles,
+ARRAY(SELECT COALESCE(bl.description, '') FROM book_localization bl WHERE bl.book_id = b.id ORDER BY bl.language) AS descriptions,
+ARRAY(SELECT bs.subject FROM book_subject bs WHERE bs.book_id = b.id ORDER BY bs.subject) AS subjects
+`
+
+// scanBook reads bookColumns and then extra columns selected after them.
+func scanBook(row pgx.Row, extra ...any) (entity.Book, error) {
+	var (
+		book             entity.Book
+		contributorIDs   []string
+		contributorRoles []string
+		languages        []string
+		titles           []string
+		descriptions     []string
+	)
+
+	dest := []any{&book.ID, &book.Name, &book.PublisherID, &book.WorkID, &book.ISBN, &book.PublicationYear, &book.CreatedAt, &book.UpdatedAt,
+		&book.AuthorIDs, &contributorIDs, &contributorRoles, &languages, &titles, &descriptions, &book.Subjects}
+	err := row.Scan(append(dest, extra...)...)
+	if err != nil {
+		return entity.Book{}, err
+	}
+
+	for i := range contributorIDs {
+		book.Contributors = append(book.Contributors, entity.Contributor{
+			AuthorID: contributorIDs[i],
+			Role:     entity.ContributorRole(contributorRoles[i]),
+		})
+	}
+
+	for i := range languages {
+		book.Localizations = append(book.Localizations, entity.Localization{
+			BookID:      book.ID,
+			Language:    languages[i],
+			Title:       titles[i],
+			Description: descriptions[i],
+		})
+	}
+
+	return book, nil
+}
+
 func (p *postgresRepository) AddBook(ctx context.Context, book entity.Book) (resBook entity.Book, txErr error) {
 	var (
 		tx  pgx.Tx
@@ -86,87 +264,290 @@ func (p *postgresRepository) AddBook(ctx context.Context, book entity.Book) (res
 	if err != nil {
 		return entity.Book{}, err
 	}
-	defer p.makeCommit(ctx, tx, txErr)
+	defer func() { p.makeCommit(ctx, tx, txErr) }()
+
+	// the book without a work becomes the only edition of its own work
+	workID := book.WorkID
+	if workID == "" {
+		if err = tx.QueryRow(ctx, `INSERT INTO work (name) VALUES ($1) RETURNING id`, book.Name).Scan(&workID); err != nil {
+			return entity.Book{}, err
+		}
+		if err = p.setWorkAuthors(ctx, tx, workID, book.AuthorIDs); err != nil {
+			return entity.Book{}, err
+		}
+	}
 
 	const queryBook = `
-INSERT INTO book (name)
-VALUES ($1)
-RETURNING id, created_at, updated_at
+INSERT INTO book (name, publisher_id, work_id)
+VALUES ($1, NULLIF($2, '')::uuid, $3)
+RETURNING id
 `
-	result := entity.Book{
-		Name:      book.Name,
-		AuthorIDs: book.AuthorIDs,
+	var id string
+	err = tx.QueryRow(ctx, queryBook, book.Name, book.PublisherID, workID).Scan(&id)
+	if err != nil {
+		return entity.Book{}, errEditionConvert(err)
 	}
 
-	err = tx.QueryRow(ctx, queryBook, book.Name).Scan(&result.ID, &result.CreatedAt, &result.UpdatedAt)
+	err = p.addEditionContributors(ctx, tx, id, book.Contributors)
 	if err != nil {
 		return entity.Book{}, err
 	}
 
-	err = p.addBookAuthors(ctx, tx, result.ID, book.AuthorIDs)
+	// authors of the book are read from its work
+	return scanBook(tx.QueryRow(ctx, bookByIDQuery, id))
+}
+
+// ImportBooks adds books of the rows in one transaction resolving authors by their names, missing authors
+// are created. Each book is the only edition of its own work with its title and authors, works, their authors
+// and books are inserted with COPY. Rows with ISBN of a book in the catalog are skipped.
+// If the batch can not be copied, its rows are inserted one by one and rows which can not be added are failed.
+func (p *postgresRepository) ImportBooks(
+	ctx context.Context,
+	rows []entity.ImportRow,
+) (results []entity.ImportResult, authorsCreated int64, txErr error) {
+	var (
+		tx  pgx.Tx
+		err error
+	)
+
+	tx, err = p.db.Begin(ctx)
 	if err != nil {
-		return entity.Book{}, err
+		return nil, 0, err
 	}
+	defer func() { p.makeCommit(ctx, tx, txErr) }()
 
-	return result, nil
+	authorIDs, authorsCreated, err := p.resolveAuthors(ctx, tx, rows)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	isbns := make([]string, 0, len(rows))
+	for _, row := range rows {
+		if row.ISBN != "" {
+			isbns = append(isbns, row.ISBN)
+		}
+	}
+
+	existingISBNs := make(map[string]bool)
+	if len(isbns) > 0 {
+		var found []string
+		if err = tx.QueryRow(ctx, `SELECT array_agg(isbn) FROM book WHERE isbn = ANY($1)`, isbns).Scan(&found); err != nil {
+			return nil, 0, err
+		}
+		for _, isbn := range found {
+			existingISBNs[isbn] = true
+		}
+	}
+
+	results = make([]entity.ImportResult, len(rows))
+	books := make([]importedBook, 0, len(rows))
+	for i, row := range rows {
+		results[i].Line = row.Line
+		if existingISBNs[row.ISBN] {
+			results[i].Err = entity.ErrBookAlreadyExists
+			continue
+		}
+
+		results[i].BookID = uuid.NewString()
+		workID := uuid.NewString()
+		var (
+			isbn *string
+			year *int16
+		)
+		if row.ISBN != "" {
+			isbn = &row.ISBN
+		}
+		if row.PublicationYear != 0 {
+			year = &row.PublicationYear
+		}
+		book := importedBook{
+			result: i,
+			work:   []any{workID, norm.NFC.String(row.Title)},
+			book:   []any{results[i].BookID, norm.NFC.String(row.Title), isbn, year, workID},
+		}
+		for position, name := range row.AuthorNames {
+			book.authors = append(book.authors, []any{authorIDs[norm.NFC.String(name)], workID, position})
+		}
+		books = append(books, book)
+	}
+
+	err = p.copyImportedBooks(ctx, tx, books)
+	if err == nil {
+		return results, authorsCreated, nil
+	}
+	if p.logger != nil {
+		p.logger.Warn("Failed copying imported books, inserting them one by one", zap.Error(err))
+	}
+
+	// the row is failed alone only if the database rejected it, other errors break the transaction
+	var pgErr *pgconn.PgError
+	for _, book := range books {
+		err = p.copyImportedBooks(ctx, tx, []importedBook{book})
+		if errors.As(err, &pgErr) {
+			results[book.result].BookID = ""
+			results[book.result].Err = errImportConvert(err)
+		} else if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return results, authorsCreated, nil
 }
 
-func (p *postgresRepository) UpdateBook(ctx context.Context, updBook entity.Book) error {
-	tx, err := p.db.Begin(ctx)
+// importedBook is the row of the work table, rows of the author_work table and the row of the book table
+// of the imported row with index result in the results of the batch.
+type importedBook struct {
+	result  int
+	work    []any
+	authors [][]any
+	book    []any
+}
 
+// copyImportedBooks copies the books with their works and authors in a savepoint, which is rolled back
+// if they can not be copied, so the transaction can be still used.
+func (p *postgresRepository) copyImportedBooks(ctx context.Context, tx pgx.Tx, books []importedBook) (txErr error) {
+	savepoint, err := tx.Begin(ctx)
 	if err != nil {
 		return err
 	}
-	defer p.makeRollBack(ctx, tx)
+	defer func() { p.makeCommit(ctx, savepoint, txErr) }()
 
-	const queryBookUpdate = `
-UPDATE book SET name=$1 where id=$2
-`
-	_, err = tx.Exec(ctx, queryBookUpdate, updBook.Name, updBook.ID)
+	var workRows, authorWorkRows, bookRows [][]any
+	for _, book := range books {
+		workRows = append(workRows, book.work)
+		authorWorkRows = append(authorWorkRows, book.authors...)
+		bookRows = append(bookRows, book.book)
+	}
+
+	if _, err = savepoint.CopyFrom(ctx, pgx.Identifier{"work"}, []string{"id", "name"}, pgx.CopyFromRows(workRows)); err != nil {
+		return err
+	}
+
+	_, err = savepoint.CopyFrom(ctx, pgx.Identifier{"author_work"}, []string{"author_id", "work_id", "position"},
+		pgx.CopyFromRows(authorWorkRows))
 	if err != nil {
 		return err
 	}
 
-	const queryGetCurrentAuthor = `
-SELECT (author_id) FROM author_book WHERE book_id=$1
+	_, err = savepoint.CopyFrom(ctx, pgx.Identifier{"book"}, []string{"id", "name", "isbn", "publication_year", "work_id"},
+		pgx.CopyFromRows(bookRows))
+	return err
+}
+
+func errImportConvert(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == ErrUniqueViolation && pgErr.ConstraintName == "book_isbn" {
+		return entity.ErrBookAlreadyExists
+	}
+
+	return err
+}
+
+// resolveAuthors returns ids of authors of the rows by their NFC names, the oldest author is taken
+// if several authors have the same name. Authors which are not found are created.
+func (p *postgresRepository) resolveAuthors(ctx context.Context, tx pgx.Tx, rows []entity.ImportRow) (map[string]string, int64, error) {
+	ids := make(map[string]string)
+	for _, row := range rows {
+		for _, name := range row.AuthorNames {
+			ids[norm.NFC.String(name)] = ""
+		}
+	}
+	if len(ids) == 0 {
+		return ids, 0, nil
+	}
+
+	names := make([]string, 0, len(ids))
+	for name := range ids {
+		names = append(names, name)
+	}
+
+	const queryFind = `
+SELECT DISTINCT ON (name) id, name
+FROM author
+WHERE name = ANY ($1)
+ORDER BY name, created_at, id
 `
-	rows, err := tx.Query(ctx, queryGetCurrentAuthor, updBook.ID)
+	found, err := tx.Query(ctx, queryFind, names)
 	if err != nil {
-		return err
+		return nil, 0, err
 	}
+	if err = scanAuthorIDs(found, ids); err != nil {
+		return nil, 0, err
+	}
+
+	missing := make([]string, 0)
+	for _, name := range names {
+		if ids[name] == "" {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) == 0 {
+		return ids, 0, nil
+	}
+
+	const queryCreate = `
+INSERT INTO author (name)
+SELECT unnest($1::text[])
+RETURNING id, name
+`
+	created, err := tx.Query(ctx, queryCreate, missing)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err = scanAuthorIDs(created, ids); err != nil {
+		return nil, 0, err
+	}
+
+	return ids, int64(len(missing)), nil
+}
 
-	curAuthors := make(map[string]struct{}, 0)
+func scanAuthorIDs(rows pgx.Rows, ids map[string]string) error {
+	defer rows.Close()
 	for rows.Next() {
-		var id string
-		if err = rows.Scan(&id); err != nil {
+		var id, name string
+		if err := rows.Scan(&id, &name); err != nil {
 			return err
 		}
-		curAuthors[id] = struct{}{}
+		ids[name] = id
 	}
-	newAuthors := make([]string, 0)
-	for _, id := range updBook.AuthorIDs {
-		if _, ok := curAuthors[id]; !ok {
-			newAuthors = append(newAuthors, id)
-			continue
-		}
-		delete(curAuthors, id)
+	return rows.Err()
+}
+
+func (p *postgresRepository) UpdateBook(ctx context.Context, updBook entity.Book) error {
+	tx, err := p.db.Begin(ctx)
+
+	if err != nil {
+		return err
 	}
+	defer p.makeRollBack(ctx, tx)
 
-	const queryDeleteExcessAuthors = `
-DELETE FROM author_book where author_id = ANY($1)
+	const queryBookUpdate = `
+UPDATE book SET name=$1, publisher_id=NULLIF($3, '')::uuid where id=$2
+RETURNING work_id
 `
-	excessAuthors := make([]string, 0)
-	maps.Keys(curAuthors)(func(id string) bool {
-		excessAuthors = append(excessAuthors, id)
-		return true
-	})
+	var workID string
+	err = tx.QueryRow(ctx, queryBookUpdate, updBook.Name, updBook.ID, updBook.PublisherID).Scan(&workID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.ErrBookNotFound
+	}
+	if err != nil {
+		return errPublisherConvert(err)
+	}
 
-	_, err = tx.Exec(ctx, queryDeleteExcessAuthors, excessAuthors)
+	// authors of the book are the authors of its work, so they are changed for all its editions
+	if err = p.setWorkAuthors(ctx, tx, workID, updBook.AuthorIDs); err != nil {
+		return err
+	}
+
+	const queryDeleteContributors = `
+DELETE FROM author_book WHERE book_id=$1
+`
+	_, err = tx.Exec(ctx, queryDeleteContributors, updBook.ID)
 	if err != nil {
 		return err
 	}
 
-	err = p.addBookAuthors(ctx, tx, updBook.ID, newAuthors)
+	err = p.addEditionContributors(ctx, tx, updBook.ID, updBook.Contributors)
 	if err != nil {
 		return err
 	}
@@ -178,18 +559,18 @@ DELETE FROM author_book where author_id = ANY($1)
 	return nil
 }
 
-func (p *postgresRepository) GetBook(ctx context.Context, idBook string) (entity.Book, error) {
-	const query = `
-SELECT b.id, b.name, b.created_at, b.updated_at, NULLIF(array_agg(ab.author_id), '{NULL}') AS authors
+// bookByIDQuery selects bookColumns of the book with id $1.
+const bookByIDQuery = `
+SELECT ` + bookColumns + `
 FROM book b
          LEFT JOIN
-     author_book ab ON b.id = ab.book_id
+     book_contributor ab ON b.id = ab.book_id
 WHERE b.id = $1
 GROUP BY b.id
 `
-	var book entity.Book
-	err := p.db.QueryRow(ctx, query, idBook).
-		Scan(&book.ID, &book.Name, &book.CreatedAt, &book.UpdatedAt, &book.AuthorIDs)
+
+func (p *postgresRepository) GetBook(ctx context.Context, idBook string) (entity.Book, error) {
+	book, err := scanBook(p.db.QueryRow(ctx, bookByIDQuery, idBook))
 
 	if errors.Is(err, sql.ErrNoRows) {
 		return entity.Book{}, entity.ErrBookNotFound
@@ -202,110 +583,444 @@ GROUP BY b.id
 	return book, nil
 }
 
-func (p *postgresRepository) GetAuthorBooks(ctx context.Context, idAuthor string) (<-chan entity.Book, error) {
-	tx, err := p.db.Begin(ctx)
+func (p *postgresRepository) SetBookLocalization(ctx context.Context, localization entity.Localization) error {
+	const query = `
+INSERT INTO book_localization (book_id, language, title, description)
+VALUES ($1, $2, $3, NULLIF($4, ''))
+ON CONFLICT (book_id, language) DO UPDATE SET title=EXCLUDED.title, description=EXCLUDED.description
+`
+	_, err := p.db.Exec(ctx, query, localization.BookID, localization.Language, localization.Title, localization.Description)
 
-	if err != nil {
-		return nil, err
-	}
+	return errBookConvert(err)
+}
 
-	const queryBook = `
-DECLARE booksCursor CURSOR FOR
-    SELECT b.id, b.name, b.created_at, b.updated_at, array_agg(subquery.author_id) AS authors
-    FROM book b
-             INNER JOIN
-         (SELECT ab.book_id, ab.author_id
-          FROM author_book ab
-                   INNER JOIN (SELECT author_id, book_id FROM author_book WHERE author_id = $1) sub
-                              ON ab.book_id = sub.book_id) subquery ON b.id = subquery.book_id
-    GROUP BY b.id
+func (p *postgresRepository) RemoveBookLocalization(ctx context.Context, idBook, language string) error {
+	const query = `
+DELETE FROM book_localization WHERE book_id=$1 AND language=$2
 `
-	_, err = tx.Exec(ctx, queryBook, idAuthor)
+	tag, err := p.db.Exec(ctx, query, idBook, language)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	const n = 10
-	queryGetBook := fmt.Sprintf("FETCH %d FROM booksCursor", n)
-	ans := make(chan entity.Book, n)
-	go func() {
-		defer p.makeRollBack(ctx, tx)
-		defer close(ans)
-		for {
-			rows, err := tx.Query(ctx, queryGetBook)
-			if err != nil && p.logger != nil {
-				p.logger.Error("error getting books by cursor", zap.Error(err))
-				return
-			}
-			var rowsRead int
-			for rows.Next() {
-				rowsRead++
-				var book entity.Book
-				if err = rows.Scan(&book.ID, &book.Name, &book.CreatedAt, &book.UpdatedAt, &book.AuthorIDs); err != nil {
-					rows.Close()
-					if p.logger != nil {
-						p.logger.Error("error getting books by cursor", zap.Error(err))
-					}
-					return
-				}
-				select {
-				case <-ctx.Done():
-					return
-				case ans <- book:
-				}
-			}
-			rows.Close()
-
-			if rowsRead == 0 {
-				if err = tx.Commit(ctx); err != nil && p.logger != nil {
-					p.logger.Error("error making commit", zap.Error(err))
-				}
-				return
-			}
-		}
-	}()
+	if tag.RowsAffected() == 0 {
+		return entity.ErrLocalizationNotFound
+	}
 
-	return ans, nil
+	return nil
 }
 
-func (p *postgresRepository) RegisterAuthor(ctx context.Context, author entity.Author) (entity.Author, error) {
-	const queryBook = `
-INSERT INTO author (name)
-VALUES ($1)
-RETURNING id, created_at, updated_at
-`
-	result := entity.Author{
-		Name: author.Name,
+// SetBookSubjects replaces subjects of the book.
+func (p *postgresRepository) SetBookSubjects(ctx context.Context, idBook string, subjects []string) (txErr error) {
+	var (
+		tx  pgx.Tx
+		err error
+	)
+
+	tx, err = p.db.Begin(ctx)
+	if err != nil {
+		return err
 	}
+	defer func() { p.makeCommit(ctx, tx, txErr) }()
 
-	err := p.db.QueryRow(ctx, queryBook, author.Name).Scan(&result.ID, &result.CreatedAt, &result.UpdatedAt)
+	// the book is locked, so concurrent requests don't mix their subjects
+	if err = tx.QueryRow(ctx, `SELECT id FROM book WHERE id = $1 FOR UPDATE`, idBook).Scan(&idBook); err != nil {
+		return errBookConvert(err)
+	}
 
-	if err != nil {
-		return entity.Author{}, err
+	if _, err = tx.Exec(ctx, `DELETE FROM book_subject WHERE book_id = $1`, idBook); err != nil {
+		return err
 	}
 
-	return result, nil
+	const queryInsert = `
+INSERT INTO book_subject (book_id, subject)
+SELECT $1, unnest($2::text[])
+`
+	_, err = tx.Exec(ctx, queryInsert, idBook, subjects)
+	return err
 }
 
-func (p *postgresRepository) ChangeAuthorInfo(ctx context.Context, updAuthor entity.Author) error {
+func (p *postgresRepository) GetAuthorBooks(ctx context.Context, idAuthor string, role entity.ContributorRole, idBranch string) (<-chan entity.Book, func() error, error) {
 	const queryBook = `
-UPDATE author SET name=$1 WHERE id=$2
+DECLARE booksCursor CURSOR FOR
+    SELECT ` + bookColumns + `
+    FROM book b
+             LEFT JOIN
+         book_contributor ab ON b.id = ab.book_id
+    WHERE b.id IN (SELECT book_id FROM book_contributor WHERE author_id = $1 AND ($2 = '' OR role::text = $2))
+      AND ($3 = '' OR b.id IN (SELECT book_id FROM book_copy WHERE current_branch_id = NULLIF($3, '')::uuid))
+    GROUP BY b.id
 `
-	_, err := p.db.Exec(ctx, queryBook, updAuthor.Name, updAuthor.ID)
+	return p.getBooksByCursor(ctx, queryBook, idAuthor, string(role), idBranch)
+}
 
-	return errAuthorConvert(err)
+func (p *postgresRepository) GetPublisherBooks(ctx context.Context, idPublisher, idBranch string) (<-chan entity.Book, func() error, error) {
+	const queryBook = `
+DECLARE booksCursor CURSOR FOR
+    SELECT ` + bookColumns + `
+    FROM book b
+             LEFT JOIN
+         book_contributor ab ON b.id = ab.book_id
+    WHERE b.publisher_id = $1
+      AND ($2 = '' OR b.id IN (SELECT book_id FROM book_copy WHERE current_branch_id = NULLIF($2, '')::uuid))
+    GROUP BY b.id
+`
+	return p.getBooksByCursor(ctx, queryBook, idPublisher, idBranch)
 }
 
-func (p *postgresRepository) GetAuthorInfo(ctx context.Context, idAuthor string) (entity.Author, error) {
+func (p *postgresRepository) GetWorkEditions(ctx context.Context, idWork, idBranch string) (<-chan entity.Book, func() error, error) {
+	const queryBook = `
+DECLARE booksCursor CURSOR FOR
+    SELECT ` + bookColumns + `
+    FROM book b
+             LEFT JOIN
+         book_contributor ab ON b.id = ab.book_id
+    WHERE b.work_id = $1
+      AND ($2 = '' OR b.id IN (SELECT book_id FROM book_copy WHERE current_branch_id = NULLIF($2, '')::uuid))
+    GROUP BY b.id
+`
+	return p.getBooksByCursor(ctx, queryBook, idWork, idBranch)
+}
+
+// getBooksByCursor declares booksCursor with queryCursor in a new transaction and
+// streams the fetched books to the returned channel. The cursor must select bookColumns.
+func (p *postgresRepository) getBooksByCursor(
+	ctx context.Context,
+	queryCursor string,
+	args ...any,
+) (<-chan entity.Book, func() error, error) {
+	return streamCursor(ctx, p, pgx.TxOptions{}, queryCursor, func(rows pgx.Rows) (entity.Book, error) {
+		return scanBook(rows)
+	}, args...)
+}
+
+// streamCursor declares booksCursor with queryCursor in a new transaction with txOptions and
+// streams the rows read by scan to the returned channel. The returned function reports the error
+// the stream was broken by, it must be called after the channel is closed.
+func streamCursor[T any](
+	ctx context.Context,
+	p *postgresRepository,
+	txOptions pgx.TxOptions,
+	queryCursor string,
+	scan func(pgx.Rows) (T, error),
+	args ...any,
+) (<-chan T, func() error, error) {
+	tx, err := p.db.BeginTx(ctx, txOptions)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, err = tx.Exec(ctx, queryCursor, args...)
+	if err != nil {
+		p.makeRollBack(ctx, tx)
+		return nil, nil, err
+	}
+
+	const n = 10
+	queryGetBook := fmt.Sprintf("FETCH %d FROM booksCursor", n)
+	ans := make(chan T, n)
+	var streamErr error
+	go func() {
+		defer close(ans)
+		defer p.makeRollBack(ctx, tx)
+		streamErr = fetchCursor(ctx, tx, queryGetBook, scan, ans)
+		if streamErr != nil && p.logger != nil {
+			p.logger.Error("error getting books by cursor", zap.Error(streamErr))
+		}
+	}()
+
+	return ans, func() error { return streamErr }, nil
+}
+
+// fetchCursor sends the rows fetched by queryFetch to ans till the cursor is exhausted and commits tx.
+func fetchCursor[T any](ctx context.Context, tx pgx.Tx, queryFetch string, scan func(pgx.Rows) (T, error), ans chan<- T) error {
+	for {
+		rows, err := tx.Query(ctx, queryFetch)
+		if err != nil {
+			return err
+		}
+		var rowsRead int
+		for rows.Next() {
+			rowsRead++
+			var row T
+			if row, err = scan(rows); err != nil {
+				rows.Close()
+				return err
+			}
+			select {
+			case <-ctx.Done():
+				rows.Close()
+				return ctx.Err()
+			case ans <- row:
+			}
+		}
+		rows.Close()
+		if err = rows.Err(); err != nil {
+			return err
+		}
+
+		if rowsRead == 0 {
+			return tx.Commit(ctx)
+		}
+	}
+}
+
+// exportedBookQuery selects bookColumns with names of authors and the name of the publisher,
+// the query must be grouped by b.id and p.name.
+const exportedBookQuery = `
+SELECT ` + bookColumns + `,
+    array_agg(a.name ORDER BY ab.position) FILTER (WHERE ab.role = 'AUTHOR') AS author_names,
+    COALESCE(p.name, '')
+FROM book b
+         LEFT JOIN
+     book_contributor ab ON b.id = ab.book_id
+         LEFT JOIN
+     author a ON a.id = ab.author_id
+         LEFT JOIN
+     publisher p ON p.id = b.publisher_id
+`
+
+func scanExportedBook(row pgx.Row) (entity.ExportedBook, error) {
+	var (
+		book entity.ExportedBook
+		err  error
+	)
+	book.Book, err = scanBook(row, &book.AuthorNames, &book.PublisherName)
+	return book, err
+}
+
+// ExportBooks streams books updated in [updatedFrom, updatedTo) ordered by updated_at with names of their authors,
+// zero time doesn't bound the range. All books are read from one snapshot, so the export is consistent.
+func (p *postgresRepository) ExportBooks(
+	ctx context.Context,
+	updatedFrom, updatedTo time.Time,
+) (<-chan entity.ExportedBook, func() error, error) {
+	const queryBook = `
+DECLARE booksCursor CURSOR FOR
+` + exportedBookQuery + `
+WHERE ($1::timestamptz IS NULL OR b.updated_at >= $1)
+  AND ($2::timestamptz IS NULL OR b.updated_at < $2)
+GROUP BY b.id, p.name
+ORDER BY b.updated_at, b.id
+`
+	txOptions := pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly}
+	return streamCursor(ctx, p, txOptions, queryBook, func(rows pgx.Rows) (entity.ExportedBook, error) {
+		return scanExportedBook(rows)
+	}, pgtype.Timestamptz{Time: updatedFrom, Valid: !updatedFrom.IsZero()},
+		pgtype.Timestamptz{Time: updatedTo, Valid: !updatedTo.IsZero()})
+}
+
+func (p *postgresRepository) GetExportedBook(ctx context.Context, idBook string) (entity.ExportedBook, error) {
+	const queryBook = exportedBookQuery + `
+WHERE b.id = $1
+GROUP BY b.id, p.name
+`
+	book, err := scanExportedBook(p.db.QueryRow(ctx, queryBook, idBook))
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.ExportedBook{}, entity.ErrBookNotFound
+	}
+	return book, err
+}
+
+// GetExportedBooks returns found books with ids in the order of ids.
+func (p *postgresRepository) GetExportedBooks(ctx context.Context, ids []string) ([]entity.ExportedBook, error) {
+	const queryBooks = exportedBookQuery + `
+WHERE b.id = ANY ($1::uuid[])
+GROUP BY b.id, p.name
+ORDER BY array_position($1::uuid[], b.id)
+`
+	rows, err := p.db.Query(ctx, queryBooks, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	books := make([]entity.ExportedBook, 0, len(ids))
+	for rows.Next() {
+		book, err := scanExportedBook(rows)
+		if err != nil {
+			return nil, err
+		}
+		books = append(books, book)
+	}
+	return books, rows.Err()
+}
+
+// SaveCiteKeys stores keys of books by their ids for books which have no cite key yet
+// and returns the stored keys of all the books.
+func (p *postgresRepository) SaveCiteKeys(ctx context.Context, keys map[string]string) (map[string]string, error) {
+	ids := make([]string, 0, len(keys))
+	for id := range keys {
+		ids = append(ids, id)
+	}
+	// rows are locked in the same order by concurrent requests
+	slices.Sort(ids)
+	values := make([]string, len(ids))
+	for i, id := range ids {
+		values[i] = keys[id]
+	}
+
+	// the update keeps the stored key, but returns it also when it is inserted by a concurrent request
 	const query = `
-SELECT id, name, created_at, updated_at
-FROM author
-WHERE id = $1
+INSERT INTO book_cite_key (book_id, cite_key)
+SELECT * FROM unnest($1::uuid[], $2::text[])
+ON CONFLICT (book_id) DO UPDATE SET cite_key = book_cite_key.cite_key
+RETURNING book_id::text, cite_key
+`
+	rows, err := p.db.Query(ctx, query, ids, values)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stored := make(map[string]string, len(keys))
+	for rows.Next() {
+		var id, key string
+		if err = rows.Scan(&id, &key); err != nil {
+			return nil, err
+		}
+		stored[id] = key
+	}
+	return stored, rows.Err()
+}
+
+func (p *postgresRepository) RegisterAuthor(ctx context.Context, author entity.Author) (entity.Author, error) {
+	const queryBook = `
+INSERT INTO author (name)
+VALUES ($1)
+RETURNING id, created_at, updated_at
+`
+	result := entity.Author{
+		Name: norm.NFC.String(author.Name),
+	}
+
+	err := p.db.QueryRow(ctx, queryBook, result.Name).Scan(&result.ID, &result.CreatedAt, &result.UpdatedAt)
+
+	if err != nil {
+		return entity.Author{}, err
+	}
+
+	return result, nil
+}
+
+// authorColumns are the columns of author a left joined with author_alias al,
+// they are read by scanAuthor.
+const authorColumns = `
+a.id, a.name, a.birth_date, a.death_date, COALESCE(a.nationality, ''), COALESCE(a.biography, ''),
+a.created_at, a.updated_at,
+array_agg(al.name ORDER BY al.position) FILTER (WHERE al.name IS NOT NULL) AS aliases
 `
 
+func scanAuthor(row pgx.Row) (entity.Author, error) {
 	var author entity.Author
-	err := p.db.QueryRow(ctx, query, idAuthor).
-		Scan(&author.ID, &author.Name, &author.CreatedAt, &author.UpdatedAt)
+	err := row.Scan(&author.ID, &author.Name, &author.BirthDate, &author.DeathDate, &author.Nationality,
+		&author.Biography, &author.CreatedAt, &author.UpdatedAt, &author.Aliases)
+
+	return author, err
+}
+
+// authorFieldColumns maps the scalar fields of entity.Author to their columns.
+var authorFieldColumns = map[string]string{
+	entity.AuthorFieldName:        "name",
+	entity.AuthorFieldBirthDate:   "birth_date",
+	entity.AuthorFieldDeathDate:   "death_date",
+	entity.AuthorFieldNationality: "nationality",
+	entity.AuthorFieldBiography:   "biography",
+}
+
+func authorFieldValue(author entity.Author, field string) any {
+	switch field {
+	case entity.AuthorFieldName:
+		return norm.NFC.String(author.Name)
+	case entity.AuthorFieldBirthDate:
+		return author.BirthDate
+	case entity.AuthorFieldDeathDate:
+		return author.DeathDate
+	case entity.AuthorFieldNationality:
+		return pgtype.Text{String: author.Nationality, Valid: author.Nationality != ""}
+	case entity.AuthorFieldBiography:
+		return pgtype.Text{String: author.Biography, Valid: author.Biography != ""}
+	}
+	return nil
+}
+
+func (p *postgresRepository) ChangeAuthorInfo(ctx context.Context, updAuthor entity.Author, fields []string) error {
+	tx, err := p.db.Begin(ctx)
+
+	if err != nil {
+		return err
+	}
+	defer p.makeRollBack(ctx, tx)
+
+	// updating id to itself still checks that the author exists if only aliases are changed
+	sets := []string{"id=$1"}
+	args := []any{updAuthor.ID}
+	changeAliases := false
+	for _, field := range fields {
+		if field == entity.AuthorFieldAliases {
+			changeAliases = true
+			continue
+		}
+		column, ok := authorFieldColumns[field]
+		if !ok {
+			continue
+		}
+		args = append(args, authorFieldValue(updAuthor, field))
+		sets = append(sets, fmt.Sprintf("%s=$%d", column, len(args)))
+	}
+
+	queryAuthor := `UPDATE author SET ` + strings.Join(sets, ", ") + ` WHERE id=$1`
+	tag, err := tx.Exec(ctx, queryAuthor, args...)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == ErrCheckViolation && pgErr.ConstraintName == "author_life_dates" {
+		return entity.ErrInvalidLifeDates
+	}
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entity.ErrAuthorNotFound
+	}
+
+	if changeAliases {
+		const queryDeleteAliases = `
+DELETE FROM author_alias WHERE author_id=$1
+`
+		if _, err = tx.Exec(ctx, queryDeleteAliases, updAuthor.ID); err != nil {
+			return err
+		}
+
+		aliasRows := make([][]any, len(updAuthor.Aliases))
+		for i := 0; i < len(aliasRows); i++ {
+			aliasRows[i] = []any{updAuthor.ID, norm.NFC.String(updAuthor.Aliases[i]), i}
+		}
+
+		_, err = tx.CopyFrom(
+			ctx,
+			pgx.Identifier{"author_alias"},
+			[]string{"author_id", "name", "position"},
+			pgx.CopyFromRows(aliasRows))
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (p *postgresRepository) GetAuthorInfo(ctx context.Context, idAuthor string) (entity.Author, error) {
+	const query = `
+SELECT ` + authorColumns + `
+FROM author a
+         LEFT JOIN
+     author_alias al ON a.id = al.author_id
+WHERE a.id = $1
+GROUP BY a.id
+`
+
+	author, err := scanAuthor(p.db.QueryRow(ctx, query, idAuthor))
 
 	if err != nil {
 		return entity.Author{}, errAuthorConvert(err)
@@ -313,3 +1028,2551 @@ WHERE id = $1
 
 	return author, nil
 }
+
+func (p *postgresRepository) FindAuthors(ctx context.Context, name string) ([]entity.Author, error) {
+	const query = `
+SELECT ` + authorColumns + `
+FROM author a
+         LEFT JOIN
+     author_alias al ON a.id = al.author_id
+WHERE fold_author_name(a.name) = fold_author_name($1)
+   OR a.id IN (SELECT author_id FROM author_alias WHERE fold_author_name(name) = fold_author_name($1))
+GROUP BY a.id
+ORDER BY a.name COLLATE author_name_sort, a.id
+`
+
+	rows, err := p.db.Query(ctx, query, norm.NFC.String(name))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	authors := make([]entity.Author, 0)
+	for rows.Next() {
+		var author entity.Author
+		if author, err = scanAuthor(rows); err != nil {
+			return nil, err
+		}
+		authors = append(authors, author)
+	}
+
+	return authors, rows.Err()
+}
+
+// GetCollaborators walks the co-author graph of book_contributor from the author up to maxDepth breadth first.
+// The walk keeps only distinct pairs of author and depth, so each author is visited at most once
+// per level and cycles end on the depth bound; the least depth of each author is taken.
+func (p *postgresRepository) GetCollaborators(ctx context.Context, idAuthor string, maxDepth int64) ([]entity.Collaborator, error) {
+	const query = `
+WITH RECURSIVE walk AS (SELECT $1::uuid AS author_id, 0 AS depth
+                        UNION
+                        SELECT o.author_id, w.depth + 1
+                        FROM walk w
+                                 JOIN book_contributor a ON a.author_id = w.author_id
+                                 JOIN book_contributor o ON o.book_id = a.book_id AND o.author_id <> a.author_id
+                        WHERE w.depth < $2),
+               nearest AS (SELECT author_id, min(depth) AS depth
+                           FROM walk
+                           GROUP BY author_id)
+SELECT n.author_id, au.name, n.depth, count(DISTINCT a.book_id) AS shared_books
+FROM nearest n
+         JOIN author au ON au.id = n.author_id
+         JOIN book_contributor a ON a.author_id = n.author_id
+         JOIN book_contributor o ON o.book_id = a.book_id
+         JOIN nearest prev ON prev.author_id = o.author_id AND prev.depth = n.depth - 1
+WHERE n.depth > 0
+GROUP BY n.author_id, au.name, n.depth
+ORDER BY n.depth, shared_books DESC, n.author_id
+`
+	rows, err := p.db.Query(ctx, query, idAuthor, maxDepth)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	collaborators := make([]entity.Collaborator, 0)
+	for rows.Next() {
+		var collaborator entity.Collaborator
+		if err = rows.Scan(&collaborator.AuthorID, &collaborator.Name, &collaborator.Depth, &collaborator.SharedBooks); err != nil {
+			return nil, err
+		}
+		collaborators = append(collaborators, collaborator)
+	}
+
+	return collaborators, rows.Err()
+}
+
+// GetCollaborationPath finds the shortest path of co-authors from one author to another of at most maxDepth books.
+// The co-author graph is walked breadth first by distinct pairs of author and depth like in GetCollaborators,
+// then the path is rebuilt from the last author taking the predecessor with the least id on each previous level
+// and the shared book with the least id for each step, so the result is deterministic.
+func (p *postgresRepository) GetCollaborationPath(
+	ctx context.Context,
+	idFrom, idTo string,
+	maxDepth int64,
+) ([]entity.CollaborationStep, error) {
+	const query = `
+WITH RECURSIVE walk AS (SELECT $1::uuid AS author_id, 0 AS depth
+                        UNION
+                        SELECT o.author_id, w.depth + 1
+                        FROM walk w
+                                 JOIN book_contributor a ON a.author_id = w.author_id
+                                 JOIN book_contributor o ON o.book_id = a.book_id AND o.author_id <> a.author_id
+                        WHERE w.depth < $3
+                          AND w.author_id <> $2::uuid),
+               nearest AS (SELECT author_id, min(depth) AS depth
+                           FROM walk
+                           GROUP BY author_id),
+               back AS (SELECT n.author_id, n.depth
+                        FROM nearest n
+                        WHERE n.author_id = $2::uuid
+                        UNION ALL
+                        SELECT (SELECT prev.author_id
+                                FROM nearest prev
+                                         JOIN book_contributor a ON a.author_id = prev.author_id
+                                         JOIN book_contributor o ON o.book_id = a.book_id
+                                WHERE o.author_id = b.author_id
+                                  AND prev.depth = b.depth - 1
+                                ORDER BY prev.author_id
+                                LIMIT 1),
+                               b.depth - 1
+                        FROM back b
+                        WHERE b.depth > 0),
+               steps AS (SELECT author_id, depth AS n, lag(author_id) OVER (ORDER BY depth) AS prev_id
+                         FROM back)
+SELECT st.author_id, au.name, COALESCE(min(o.book_id::text), '')
+FROM steps st
+         JOIN author au ON au.id = st.author_id
+         LEFT JOIN book_contributor o
+                   ON o.author_id = st.author_id AND o.book_id IN (SELECT book_id FROM book_contributor WHERE author_id = st.prev_id)
+GROUP BY st.author_id, au.name, st.n
+ORDER BY st.n
+`
+	rows, err := p.db.Query(ctx, query, idFrom, idTo, maxDepth)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var steps []entity.CollaborationStep
+	for rows.Next() {
+		var step entity.CollaborationStep
+		if err = rows.Scan(&step.AuthorID, &step.Name, &step.BookID); err != nil {
+			return nil, err
+		}
+		steps = append(steps, step)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(steps) == 0 {
+		return nil, entity.ErrCollaborationPathNotFound
+	}
+	return steps, nil
+}
+
+func (p *postgresRepository) RegisterPublisher(ctx context.Context, publisher entity.Publisher) (entity.Publisher, error) {
+	const queryPublisher = `
+INSERT INTO publisher (name, country, parent_id)
+VALUES ($1, NULLIF($2, ''), NULLIF($3, '')::uuid)
+RETURNING id, created_at, updated_at
+`
+	result := entity.Publisher{
+		Name:     publisher.Name,
+		Country:  publisher.Country,
+		ParentID: publisher.ParentID,
+	}
+
+	err := p.db.QueryRow(ctx, queryPublisher, publisher.Name, publisher.Country, publisher.ParentID).
+		Scan(&result.ID, &result.CreatedAt, &result.UpdatedAt)
+
+	if err != nil {
+		return entity.Publisher{}, errPublisherConvert(err)
+	}
+
+	return result, nil
+}
+
+func (p *postgresRepository) ChangePublisherInfo(ctx context.Context, updPublisher entity.Publisher) error {
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer p.makeRollBack(ctx, tx)
+
+	if updPublisher.ParentID != "" {
+		// concurrent changes of parents could make a cycle together, so they are serialized
+		const queryLock = `
+SELECT pg_advisory_xact_lock(hashtext('publisher'))
+`
+		if _, err = tx.Exec(ctx, queryLock); err != nil {
+			return err
+		}
+
+		const queryCycle = `
+WITH RECURSIVE ancestor(id) AS (
+    SELECT $2::uuid
+    UNION
+    SELECT pb.parent_id
+    FROM publisher pb
+             JOIN ancestor ON pb.id = ancestor.id
+    WHERE pb.parent_id IS NOT NULL
+)
+SELECT EXISTS (SELECT 1 FROM ancestor WHERE id = $1::uuid)
+`
+		var cycle bool
+		if err = tx.QueryRow(ctx, queryCycle, updPublisher.ID, updPublisher.ParentID).Scan(&cycle); err != nil {
+			return err
+		}
+
+		if cycle {
+			return fmt.Errorf("publisher %s can not be an imprint of %s: %w",
+				updPublisher.ID, updPublisher.ParentID, entity.ErrPublisherCycle)
+		}
+	}
+
+	const queryPublisher = `
+UPDATE publisher SET name=$1, country=NULLIF($2, ''), parent_id=NULLIF($3, '')::uuid WHERE id=$4
+`
+	tag, err := tx.Exec(ctx, queryPublisher, updPublisher.Name, updPublisher.Country, updPublisher.ParentID, updPublisher.ID)
+	if err != nil {
+		return errPublisherConvert(err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entity.ErrPublisherNotFound
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (p *postgresRepository) GetPublisherInfo(ctx context.Context, idPublisher string) (entity.Publisher, error) {
+	const query = `
+SELECT id, name, COALESCE(country, ''), COALESCE(parent_id::text, ''), created_at, updated_at
+FROM publisher
+WHERE id = $1
+`
+
+	var publisher entity.Publisher
+	err := p.db.QueryRow(ctx, query, idPublisher).
+		Scan(&publisher.ID, &publisher.Name, &publisher.Country, &publisher.ParentID, &publisher.CreatedAt, &publisher.UpdatedAt)
+
+	if err != nil {
+		return entity.Publisher{}, errPublisherConvert(err)
+	}
+
+	return publisher, nil
+}
+
+func (p *postgresRepository) CreateWork(ctx context.Context, work entity.Work) (resWork entity.Work, txErr error) {
+	var (
+		tx  pgx.Tx
+		err error
+	)
+
+	tx, err = p.db.Begin(ctx)
+	if err != nil {
+		return entity.Work{}, err
+	}
+	defer func() { p.makeCommit(ctx, tx, txErr) }()
+
+	const queryWork = `
+INSERT INTO work (name)
+VALUES ($1)
+RETURNING id, created_at, updated_at
+`
+	result := entity.Work{
+		Name:      work.Name,
+		AuthorIDs: work.AuthorIDs,
+	}
+
+	err = tx.QueryRow(ctx, queryWork, work.Name).Scan(&result.ID, &result.CreatedAt, &result.UpdatedAt)
+	if err != nil {
+		return entity.Work{}, err
+	}
+
+	if err = p.setWorkAuthors(ctx, tx, result.ID, work.AuthorIDs); err != nil {
+		return entity.Work{}, err
+	}
+
+	return result, nil
+}
+
+// ChangeWorkInfo changes the name and authors of the work, they are the authors of all its editions.
+func (p *postgresRepository) ChangeWorkInfo(ctx context.Context, updWork entity.Work) (txErr error) {
+	var (
+		tx  pgx.Tx
+		err error
+	)
+
+	tx, err = p.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { p.makeCommit(ctx, tx, txErr) }()
+
+	tag, err := tx.Exec(ctx, `UPDATE work SET name=$2 WHERE id=$1`, updWork.ID, updWork.Name)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entity.ErrWorkNotFound
+	}
+
+	return p.setWorkAuthors(ctx, tx, updWork.ID, updWork.AuthorIDs)
+}
+
+func (p *postgresRepository) GetWork(ctx context.Context, idWork string) (entity.Work, error) {
+	const query = `
+SELECT w.id, w.name, w.created_at, w.updated_at, NULLIF(array_agg(aw.author_id ORDER BY aw.position), '{NULL}') AS authors
+FROM work w
+         LEFT JOIN
+     author_work aw ON w.id = aw.work_id
+WHERE w.id = $1
+GROUP BY w.id
+`
+	var work entity.Work
+	err := p.db.QueryRow(ctx, query, idWork).
+		Scan(&work.ID, &work.Name, &work.CreatedAt, &work.UpdatedAt, &work.AuthorIDs)
+
+	if err != nil {
+		return entity.Work{}, errWorkConvert(err)
+	}
+
+	return work, nil
+}
+
+func (p *postgresRepository) AttachEdition(ctx context.Context, idWork, idBook string) error {
+	const query = `
+UPDATE book SET work_id=$1 WHERE id=$2
+`
+	tag, err := p.db.Exec(ctx, query, idWork, idBook)
+	if err != nil {
+		return errWorkConvert(err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entity.ErrBookNotFound
+	}
+
+	return nil
+}
+
+// DetachEdition moves the book into a new work with the name of the book and the authors of the work,
+// so the book keeps its authors as the only edition of the new work.
+func (p *postgresRepository) DetachEdition(ctx context.Context, idWork, idBook string) (txErr error) {
+	var (
+		tx  pgx.Tx
+		err error
+	)
+
+	tx, err = p.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { p.makeCommit(ctx, tx, txErr) }()
+
+	var name string
+	err = tx.QueryRow(ctx, `SELECT name FROM book WHERE id=$2 AND work_id=$1 FOR UPDATE`, idWork, idBook).Scan(&name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("Book is not an edition of the work: %w", entity.ErrBookNotFound)
+	}
+	if err != nil {
+		return err
+	}
+
+	var newWorkID string
+	if err = tx.QueryRow(ctx, `INSERT INTO work (name) VALUES ($1) RETURNING id`, name).Scan(&newWorkID); err != nil {
+		return err
+	}
+
+	const queryAuthors = `
+INSERT INTO author_work (author_id, work_id, position)
+SELECT author_id, $2, position
+FROM author_work
+WHERE work_id = $1
+`
+	if _, err = tx.Exec(ctx, queryAuthors, idWork, newWorkID); err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE book SET work_id=$1 WHERE id=$2`, newWorkID, idBook)
+	return err
+}
+
+func (p *postgresRepository) SetBookCover(ctx context.Context, idBook string, covers []entity.Cover) error {
+	tx, err := p.db.Begin(ctx)
+
+	if err != nil {
+		return err
+	}
+	defer p.makeRollBack(ctx, tx)
+
+	const queryDeleteCovers = `
+DELETE FROM book_cover WHERE book_id=$1
+`
+	if _, err = tx.Exec(ctx, queryDeleteCovers, idBook); err != nil {
+		return err
+	}
+
+	coverRows := make([][]any, len(covers))
+	for i := 0; i < len(coverRows); i++ {
+		coverRows[i] = []any{idBook, string(covers[i].Size), covers[i].Hash, covers[i].ContentType, covers[i].Width, covers[i].Height}
+	}
+
+	_, err = tx.CopyFrom(
+		ctx,
+		pgx.Identifier{"book_cover"},
+		[]string{"book_id", "size", "hash", "content_type", "width", "height"},
+		pgx.CopyFromRows(coverRows))
+	if err != nil {
+		return errBookConvert(err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (p *postgresRepository) GetBookCover(ctx context.Context, idBook string, size entity.CoverSize) (entity.Cover, error) {
+	const query = `
+SELECT book_id, size::text, hash, content_type, width, height, created_at
+FROM book_cover
+WHERE book_id = $1 AND size::text = $2
+`
+
+	var cover entity.Cover
+	err := p.db.QueryRow(ctx, query, idBook, string(size)).
+		Scan(&cover.BookID, &cover.Size, &cover.Hash, &cover.ContentType, &cover.Width, &cover.Height, &cover.CreatedAt)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Cover{}, entity.ErrCoverNotFound
+	}
+
+	if err != nil {
+		return entity.Cover{}, err
+	}
+
+	return cover, nil
+}
+
+func (p *postgresRepository) LinkBooks(ctx context.Context, relation entity.Relation) error {
+	relation = relation.Forward()
+	tx, err := p.db.Begin(ctx)
+
+	if err != nil {
+		return err
+	}
+	defer p.makeRollBack(ctx, tx)
+
+	if relation.Type.IsHierarchical() {
+		// concurrent links of the same type could make a cycle together, so they are serialized
+		const queryLock = `
+SELECT pg_advisory_xact_lock(hashtext('book_relation'), hashtext($1))
+`
+		if _, err = tx.Exec(ctx, queryLock, string(relation.Type)); err != nil {
+			return err
+		}
+
+		const queryCycle = `
+WITH RECURSIVE reachable(id) AS (
+    SELECT $2::uuid
+    UNION
+    SELECT r.related_book_id
+    FROM book_relation r
+             JOIN reachable ON r.book_id = reachable.id
+    WHERE r.type::text = $3
+)
+SELECT EXISTS (SELECT 1 FROM reachable WHERE id = $1::uuid)
+`
+		var cycle bool
+		err = tx.QueryRow(ctx, queryCycle, relation.BookID, relation.RelatedBookID, string(relation.Type)).Scan(&cycle)
+		if err != nil {
+			return err
+		}
+
+		if cycle {
+			return fmt.Errorf("book %s can not be %s of book %s: %w",
+				relation.BookID, relation.Type, relation.RelatedBookID, entity.ErrRelationCycle)
+		}
+	}
+
+	const queryRelation = `
+INSERT INTO book_relation (book_id, related_book_id, type)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING
+`
+	_, err = tx.Exec(ctx, queryRelation, relation.BookID, relation.RelatedBookID, string(relation.Type))
+	if err != nil {
+		return errBookConvert(err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (p *postgresRepository) UnlinkBooks(ctx context.Context, relation entity.Relation) error {
+	relation = relation.Forward()
+
+	const query = `
+DELETE FROM book_relation WHERE book_id=$1 AND related_book_id=$2 AND type::text=$3
+`
+	tag, err := p.db.Exec(ctx, query, relation.BookID, relation.RelatedBookID, string(relation.Type))
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entity.ErrRelationNotFound
+	}
+
+	return nil
+}
+
+func (p *postgresRepository) GetRelatedBooks(ctx context.Context, idBook string, depth int) ([]entity.RelatedBook, error) {
+	tx, err := p.db.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+
+	if err != nil {
+		return nil, err
+	}
+	defer p.makeRollBack(ctx, tx)
+
+	// relations are walked in both directions, reversed relations are found from the side of the related book,
+	// books are walked in breadth first order keeping only their depth, so every book is reached at most once
+	// per depth, then every book is returned once with the shortest depth and the relation from a book one level above
+	const queryRelations = `
+WITH RECURSIVE edges(from_id, to_id, type, reversed) AS NOT MATERIALIZED (
+    SELECT book_id, related_book_id, type::text, false FROM book_relation
+    UNION ALL
+    SELECT related_book_id, book_id, type::text, true FROM book_relation
+), walk(id, depth) AS (
+    SELECT $1::uuid, 0
+    UNION
+    SELECT e.to_id, w.depth + 1
+    FROM walk w
+             JOIN edges e ON e.from_id = w.id
+    WHERE w.depth < $2
+), shortest(id, depth) AS (
+    SELECT id, min(depth) FROM walk GROUP BY id
+)
+SELECT id::text, via::text, type, reversed, depth
+FROM (SELECT DISTINCT ON (s.id) s.id, e.from_id AS via, e.type, e.reversed, s.depth
+      FROM shortest s
+               JOIN edges e ON e.to_id = s.id
+               JOIN shortest v ON v.id = e.from_id AND v.depth = s.depth - 1
+      WHERE s.depth > 0
+      ORDER BY s.id, e.from_id, e.type) related
+ORDER BY depth, id
+`
+	rows, err := tx.Query(ctx, queryRelations, idBook, depth)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		related []entity.RelatedBook
+		ids     []string
+	)
+	for rows.Next() {
+		var (
+			r        entity.RelatedBook
+			reversed bool
+		)
+		if err = rows.Scan(&r.Book.ID, &r.ViaBookID, &r.Type, &reversed, &r.Depth); err != nil {
+			rows.Close()
+			return nil, err
+		}
+
+		// not reversed relation is stored as "via is type of book", so the book is inverse type of via
+		if !reversed {
+			r.Type = r.Type.Inverse()
+		}
+		related = append(related, r)
+		ids = append(ids, r.Book.ID)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	const queryBooks = `
+SELECT ` + bookColumns + `
+FROM book b
+         LEFT JOIN
+     book_contributor ab ON b.id = ab.book_id
+WHERE b.id = ANY ($1::uuid[])
+GROUP BY b.id
+`
+	rows, err = tx.Query(ctx, queryBooks, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	books := make(map[string]entity.Book, len(ids))
+	for rows.Next() {
+		var book entity.Book
+		if book, err = scanBook(rows); err != nil {
+			return nil, err
+		}
+		books[book.ID] = book
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for i := range related {
+		related[i].Book = books[related[i].Book.ID]
+	}
+
+	return related, nil
+}
+
+const copyColumns = `
+c.id, c.book_id, c.barcode, COALESCE(c.location, ''), c.acquisition_date, c.condition::text, c.status::text,
+COALESCE(c.home_branch_id::text, ''), COALESCE(c.current_branch_id::text, ''), c.created_at, c.updated_at
+`
+
+func scanCopy(row pgx.Row) (entity.Copy, error) {
+	var (
+		c               entity.Copy
+		acquisitionDate pgtype.Date
+	)
+
+	err := row.Scan(&c.ID, &c.BookID, &c.Barcode, &c.Location, &acquisitionDate, &c.Condition, &c.Status,
+		&c.HomeBranchID, &c.CurrentBranchID, &c.CreatedAt, &c.UpdatedAt)
+	if err != nil {
+		return entity.Copy{}, err
+	}
+
+	if acquisitionDate.Valid {
+		c.AcquisitionDate = &acquisitionDate.Time
+	}
+
+	return c, nil
+}
+
+func (p *postgresRepository) AddCopy(ctx context.Context, newCopy entity.Copy) (entity.Copy, error) {
+	const query = `
+INSERT INTO book_copy (book_id, barcode, location, acquisition_date, condition, home_branch_id, current_branch_id)
+VALUES ($1, $2, NULLIF($3, ''), $4, $5, NULLIF($6, '')::uuid, NULLIF($6, '')::uuid)
+RETURNING id, status::text, created_at, updated_at
+`
+	result := newCopy
+	result.CurrentBranchID = newCopy.HomeBranchID
+
+	err := p.db.QueryRow(ctx, query, newCopy.BookID, newCopy.Barcode, newCopy.Location, newCopy.AcquisitionDate,
+		string(newCopy.Condition), newCopy.HomeBranchID).Scan(&result.ID, &result.Status, &result.CreatedAt, &result.UpdatedAt)
+
+	if err != nil {
+		return entity.Copy{}, errCopyConvert(err)
+	}
+
+	return result, nil
+}
+
+func (p *postgresRepository) UpdateCopy(ctx context.Context, updCopy entity.Copy) error {
+	// status is changed only between AVAILABLE, LOST and WITHDRAWN, other statuses are set by loans, holds and transfers
+	const query = `
+UPDATE book_copy
+SET barcode=$2, location=NULLIF($3, ''), acquisition_date=$4, condition=$5,
+    status=COALESCE(NULLIF($6::text, '')::copy_status, status),
+    home_branch_id=NULLIF($7, '')::uuid, current_branch_id=COALESCE(current_branch_id, NULLIF($7, '')::uuid)
+WHERE id = $1
+  AND ($6::text = '' OR status IN ('AVAILABLE', 'LOST', 'WITHDRAWN'))
+`
+	tag, err := p.db.Exec(ctx, query, updCopy.ID, updCopy.Barcode, updCopy.Location, updCopy.AcquisitionDate,
+		string(updCopy.Condition), string(updCopy.Status), updCopy.HomeBranchID)
+	if err != nil {
+		return errCopyConvert(err)
+	}
+
+	if tag.RowsAffected() > 0 {
+		return nil
+	}
+
+	const queryExists = `
+SELECT EXISTS (SELECT 1 FROM book_copy WHERE id = $1)
+`
+	var exists bool
+	if err = p.db.QueryRow(ctx, queryExists, updCopy.ID).Scan(&exists); err != nil {
+		return err
+	}
+
+	if !exists {
+		return entity.ErrCopyNotFound
+	}
+	return fmt.Errorf("status of copy %s is set by its loan, hold or transfer: %w", updCopy.ID, entity.ErrCopyNotAvailable)
+}
+
+func (p *postgresRepository) GetCopy(ctx context.Context, idCopy string) (entity.Copy, error) {
+	const query = `
+SELECT ` + copyColumns + `
+FROM book_copy c
+WHERE c.id = $1
+`
+	result, err := scanCopy(p.db.QueryRow(ctx, query, idCopy))
+	if err != nil {
+		return entity.Copy{}, errCopyConvert(err)
+	}
+
+	return result, nil
+}
+
+func (p *postgresRepository) GetCopyByBarcode(ctx context.Context, barcode string) (entity.Copy, error) {
+	const query = `
+SELECT ` + copyColumns + `
+FROM book_copy c
+WHERE c.barcode = $1
+`
+	result, err := scanCopy(p.db.QueryRow(ctx, query, barcode))
+	if err != nil {
+		return entity.Copy{}, errCopyConvert(err)
+	}
+
+	return result, nil
+}
+
+func (p *postgresRepository) DeleteCopy(ctx context.Context, idCopy string) error {
+	const query = `
+DELETE FROM book_copy WHERE id = $1
+`
+	tag, err := p.db.Exec(ctx, query, idCopy)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == ErrForeignKeyViolation {
+		return entity.ErrCopyHasLoans
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entity.ErrCopyNotFound
+	}
+
+	return nil
+}
+
+func (p *postgresRepository) GetBookCopies(ctx context.Context, idBook, idBranch string) ([]entity.Copy, error) {
+	const query = `
+SELECT ` + copyColumns + `
+FROM book_copy c
+WHERE c.book_id = $1
+  AND ($2 = '' OR c.current_branch_id = NULLIF($2, '')::uuid)
+ORDER BY c.barcode
+`
+	rows, err := p.db.Query(ctx, query, idBook, idBranch)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var copies []entity.Copy
+	for rows.Next() {
+		var c entity.Copy
+		if c, err = scanCopy(rows); err != nil {
+			return nil, err
+		}
+		copies = append(copies, c)
+	}
+
+	return copies, rows.Err()
+}
+
+func (p *postgresRepository) GetBookAvailability(ctx context.Context, idBook, idBranch string) (entity.Availability, error) {
+	const query = `
+SELECT count(*) FILTER (WHERE status <> 'WITHDRAWN'), count(*) FILTER (WHERE status = 'AVAILABLE')
+FROM book_copy
+WHERE book_id = $1
+  AND ($2 = '' OR current_branch_id = NULLIF($2, '')::uuid)
+`
+	var availability entity.Availability
+	err := p.db.QueryRow(ctx, query, idBook, idBranch).Scan(&availability.Total, &availability.Available)
+
+	if err != nil {
+		return entity.Availability{}, err
+	}
+
+	return availability, nil
+}
+
+const memberColumns = `
+m.id, m.card_number, m.name, COALESCE(m.email, ''), COALESCE(m.phone, ''), COALESCE(m.address, ''),
+m.membership_type::text, m.expiry_date, m.status::text, m.created_at, m.updated_at
+`
+
+func scanMember(row pgx.Row) (entity.Member, error) {
+	var member entity.Member
+
+	err := row.Scan(&member.ID, &member.CardNumber, &member.Name, &member.Email, &member.Phone, &member.Address,
+		&member.Type, &member.ExpiryDate, &member.Status, &member.CreatedAt, &member.UpdatedAt)
+	if err != nil {
+		return entity.Member{}, err
+	}
+
+	return member, nil
+}
+
+func (p *postgresRepository) RegisterMember(ctx context.Context, member entity.Member) (entity.Member, error) {
+	const query = `
+INSERT INTO member (card_number, name, email, phone, address, membership_type, expiry_date)
+VALUES ($1, $2, NULLIF($3, ''), NULLIF($4, ''), NULLIF($5, ''), $6, $7)
+RETURNING id, status::text, created_at, updated_at
+`
+	result := member
+
+	err := p.db.QueryRow(ctx, query, member.CardNumber, member.Name, member.Email, member.Phone, member.Address,
+		string(member.Type), member.ExpiryDate).Scan(&result.ID, &result.Status, &result.CreatedAt, &result.UpdatedAt)
+
+	if err != nil {
+		return entity.Member{}, errMemberConvert(err)
+	}
+
+	return result, nil
+}
+
+func (p *postgresRepository) UpdateMember(ctx context.Context, updMember entity.Member) error {
+	const query = `
+UPDATE member
+SET card_number=$2, name=$3, email=NULLIF($4, ''), phone=NULLIF($5, ''), address=NULLIF($6, ''),
+    membership_type=$7, expiry_date=$8, status=$9
+WHERE id = $1
+`
+	tag, err := p.db.Exec(ctx, query, updMember.ID, updMember.CardNumber, updMember.Name, updMember.Email,
+		updMember.Phone, updMember.Address, string(updMember.Type), updMember.ExpiryDate, string(updMember.Status))
+	if err != nil {
+		return errMemberConvert(err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entity.ErrMemberNotFound
+	}
+
+	return nil
+}
+
+func (p *postgresRepository) GetMember(ctx context.Context, idMember string) (entity.Member, error) {
+	const query = `
+SELECT ` + memberColumns + `
+FROM member m
+WHERE m.id = $1
+`
+	member, err := scanMember(p.db.QueryRow(ctx, query, idMember))
+	if err != nil {
+		return entity.Member{}, errMemberConvert(err)
+	}
+
+	return member, nil
+}
+
+func (p *postgresRepository) ListMembers(ctx context.Context, filter entity.MemberFilter) ([]entity.Member, error) {
+	const query = `
+SELECT ` + memberColumns + `
+FROM member m
+WHERE ($1 = '' OR m.status::text = $1)
+  AND ($2 = '' OR m.membership_type::text = $2)
+  AND m.card_number > $3
+ORDER BY m.card_number
+LIMIT $4
+`
+	rows, err := p.db.Query(ctx, query, string(filter.Status), string(filter.Type), filter.AfterCardNumber, filter.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var members []entity.Member
+	for rows.Next() {
+		var member entity.Member
+		if member, err = scanMember(rows); err != nil {
+			return nil, err
+		}
+		members = append(members, member)
+	}
+
+	return members, rows.Err()
+}
+
+const loanColumns = `
+l.id, l.copy_id, c.book_id, l.member_id, l.checked_out_at, l.due_at, l.returned_at, l.overdue_at, l.fine, l.fine_paid, l.renewals
+`
+
+func scanLoan(row pgx.Row) (entity.Loan, error) {
+	var loan entity.Loan
+
+	err := row.Scan(&loan.ID, &loan.CopyID, &loan.BookID, &loan.MemberID, &loan.CheckedOutAt, &loan.DueAt,
+		&loan.ReturnedAt, &loan.OverdueAt, &loan.Fine, &loan.FinePaid, &loan.Renewals)
+	if err != nil {
+		return entity.Loan{}, err
+	}
+
+	return loan, nil
+}
+
+func (p *postgresRepository) CheckoutBook(ctx context.Context, loan entity.Loan, pickupUntil time.Time) (resLoan entity.Loan, txErr error) {
+	var (
+		tx  pgx.Tx
+		err error
+	)
+
+	tx, err = p.db.Begin(ctx)
+	if err != nil {
+		return entity.Loan{}, err
+	}
+	defer func() { p.makeCommit(ctx, tx, txErr) }()
+
+	const queryCopy = `
+SELECT book_id, status::text FROM book_copy WHERE id = $1 FOR UPDATE
+`
+	var copyStatus entity.CopyStatus
+	err = tx.QueryRow(ctx, queryCopy, loan.CopyID).Scan(&loan.BookID, &copyStatus)
+	if err != nil {
+		return entity.Loan{}, errCopyConvert(err)
+	}
+
+	if copyStatus == entity.CopyOnHold {
+		const queryKept = `
+SELECT EXISTS (SELECT 1 FROM hold WHERE copy_id = $1 AND member_id = $2 AND status = 'READY_FOR_PICKUP')
+`
+		var keptForMember bool
+		if err = tx.QueryRow(ctx, queryKept, loan.CopyID, loan.MemberID).Scan(&keptForMember); err != nil {
+			return entity.Loan{}, err
+		}
+		if keptForMember {
+			copyStatus = entity.CopyAvailable
+		}
+	}
+
+	if copyStatus != entity.CopyAvailable {
+		return entity.Loan{}, fmt.Errorf("copy %s is %s: %w", loan.CopyID, copyStatus, entity.ErrCopyNotAvailable)
+	}
+
+	const queryMember = `
+SELECT status = 'ACTIVE' AND expiry_date >= $2::timestamptz::date FROM member WHERE id = $1 FOR SHARE
+`
+	var canBorrow bool
+	err = tx.QueryRow(ctx, queryMember, loan.MemberID, loan.CheckedOutAt).Scan(&canBorrow)
+	if err != nil {
+		return entity.Loan{}, errMemberConvert(err)
+	}
+
+	if !canBorrow {
+		return entity.Loan{}, entity.ErrMemberCannotBorrow
+	}
+
+	const queryLoan = `
+INSERT INTO loan (copy_id, member_id, checked_out_at, due_at)
+VALUES ($1, $2, $3, $4)
+RETURNING id
+`
+	err = tx.QueryRow(ctx, queryLoan, loan.CopyID, loan.MemberID, loan.CheckedOutAt, loan.DueAt).Scan(&loan.ID)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == ErrUniqueViolation {
+		return entity.Loan{}, entity.ErrCopyNotAvailable
+	}
+
+	if err != nil {
+		return entity.Loan{}, err
+	}
+
+	const queryStatus = `
+UPDATE book_copy SET status = 'ON_LOAN' WHERE id = $1
+`
+	if _, err = tx.Exec(ctx, queryStatus, loan.CopyID); err != nil {
+		return entity.Loan{}, err
+	}
+
+	// the member leaves the queue of the book, the copy kept for the member is passed on
+	const queryHold = `
+UPDATE hold
+SET status = 'FULFILLED', closed_at = $3
+WHERE book_id = $1
+  AND member_id = $2
+  AND status IN ('WAITING', 'READY_FOR_PICKUP')
+RETURNING copy_id
+`
+	var idKeptCopy *string
+	err = tx.QueryRow(ctx, queryHold, loan.BookID, loan.MemberID, loan.CheckedOutAt).Scan(&idKeptCopy)
+	if errors.Is(err, sql.ErrNoRows) {
+		return loan, nil
+	}
+
+	if err != nil {
+		return entity.Loan{}, err
+	}
+
+	if idKeptCopy != nil && *idKeptCopy != loan.CopyID {
+		if err = p.releaseCopy(ctx, tx, *idKeptCopy, loan.CheckedOutAt, pickupUntil); err != nil {
+			return entity.Loan{}, err
+		}
+	}
+
+	return loan, nil
+}
+
+func (p *postgresRepository) ReturnBook(ctx context.Context, idCopy string, returnedAt, pickupUntil time.Time) (resLoan entity.Loan, txErr error) {
+	var (
+		tx  pgx.Tx
+		err error
+	)
+
+	tx, err = p.db.Begin(ctx)
+	if err != nil {
+		return entity.Loan{}, err
+	}
+	defer func() { p.makeCommit(ctx, tx, txErr) }()
+
+	// the copy is returned to the shelf even if it was marked lost
+	const queryCopy = `
+UPDATE book_copy c
+SET status = CASE WHEN c.status IN ('ON_LOAN', 'LOST') THEN 'AVAILABLE' ELSE c.status END
+WHERE c.id = $1
+RETURNING c.book_id
+`
+	var idBook string
+	if err = tx.QueryRow(ctx, queryCopy, idCopy).Scan(&idBook); err != nil {
+		return entity.Loan{}, errCopyConvert(err)
+	}
+
+	const queryLoan = `
+UPDATE loan l
+SET returned_at = GREATEST($2, l.checked_out_at)
+FROM book_copy c
+WHERE c.id = l.copy_id
+  AND l.copy_id = $1
+  AND l.returned_at IS NULL
+RETURNING ` + loanColumns
+
+	loan, err := scanLoan(tx.QueryRow(ctx, queryLoan, idCopy, returnedAt))
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Loan{}, entity.ErrLoanNotFound
+	}
+
+	if err != nil {
+		return entity.Loan{}, err
+	}
+
+	if err = p.releaseCopy(ctx, tx, idCopy, returnedAt, pickupUntil); err != nil {
+		return entity.Loan{}, err
+	}
+
+	return loan, nil
+}
+
+func (p *postgresRepository) getLoans(ctx context.Context, query string, args ...any) ([]entity.Loan, error) {
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var loans []entity.Loan
+	for rows.Next() {
+		var loan entity.Loan
+		if loan, err = scanLoan(rows); err != nil {
+			return nil, err
+		}
+		loans = append(loans, loan)
+	}
+
+	return loans, rows.Err()
+}
+
+func (p *postgresRepository) GetMemberLoans(ctx context.Context, idMember string) ([]entity.Loan, error) {
+	const query = `
+SELECT ` + loanColumns + `
+FROM loan l
+         JOIN book_copy c ON c.id = l.copy_id
+WHERE l.member_id = $1
+  AND l.returned_at IS NULL
+ORDER BY l.due_at, l.id
+`
+	return p.getLoans(ctx, query, idMember)
+}
+
+func (p *postgresRepository) GetBookLoans(ctx context.Context, idBook string) ([]entity.Loan, error) {
+	const query = `
+SELECT ` + loanColumns + `
+FROM loan l
+         JOIN book_copy c ON c.id = l.copy_id
+WHERE c.book_id = $1
+  AND l.returned_at IS NULL
+ORDER BY l.due_at, l.id
+`
+	return p.getLoans(ctx, query, idBook)
+}
+
+// RenewLoan extends the due date of the active loan by the renewal policy of the member's type
+// and records the renewal in the loan history.
+func (p *postgresRepository) RenewLoan(ctx context.Context, idLoan string, renewedAt time.Time,
+	policy entity.RenewalPolicy) (resLoan entity.Loan, txErr error) {
+	var (
+		tx  pgx.Tx
+		err error
+	)
+
+	tx, err = p.db.Begin(ctx)
+	if err != nil {
+		return entity.Loan{}, err
+	}
+	defer func() { p.makeCommit(ctx, tx, txErr) }()
+
+	const queryLoan = `
+SELECT l.member_id,
+       c.book_id,
+       l.due_at,
+       l.renewals,
+       m.membership_type::text,
+       m.status = 'ACTIVE' AND m.expiry_date >= $2::timestamptz::date
+FROM loan l
+         JOIN book_copy c ON c.id = l.copy_id
+         JOIN member m ON m.id = l.member_id
+WHERE l.id = $1
+  AND l.returned_at IS NULL
+    FOR UPDATE OF l
+`
+	var (
+		idMember, idBook string
+		dueAt            time.Time
+		renewals         int64
+		membershipType   entity.MembershipType
+		canBorrow        bool
+	)
+	err = tx.QueryRow(ctx, queryLoan, idLoan, renewedAt).Scan(&idMember, &idBook, &dueAt, &renewals, &membershipType, &canBorrow)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Loan{}, entity.ErrLoanNotFound
+	}
+
+	if err != nil {
+		return entity.Loan{}, err
+	}
+
+	if !canBorrow {
+		return entity.Loan{}, entity.ErrMemberCannotBorrow
+	}
+
+	if limit := policy.Limit(membershipType); renewals >= limit {
+		return entity.Loan{}, fmt.Errorf("loan %s was renewed %d of %d times: %w", idLoan, renewals, limit, entity.ErrRenewalNotAllowed)
+	}
+
+	const queryHolds = `
+SELECT EXISTS (SELECT 1 FROM hold WHERE book_id = $1 AND status = 'WAITING')
+`
+	var hasHolds bool
+	if err = tx.QueryRow(ctx, queryHolds, idBook).Scan(&hasHolds); err != nil {
+		return entity.Loan{}, err
+	}
+
+	if hasHolds {
+		return entity.Loan{}, fmt.Errorf("book %s has pending holds: %w", idBook, entity.ErrRenewalNotAllowed)
+	}
+
+	const queryMember = `
+SELECT COALESCE(sum(GREATEST(fine - fine_paid, 0)), 0)::BIGINT,
+       count(*) FILTER (WHERE returned_at IS NULL AND due_at < $2)
+FROM loan
+WHERE member_id = $1
+`
+	var fines, overdue int64
+	if err = tx.QueryRow(ctx, queryMember, idMember, renewedAt).Scan(&fines, &overdue); err != nil {
+		return entity.Loan{}, err
+	}
+
+	if overdue > 0 {
+		return entity.Loan{}, fmt.Errorf("member %s has %d overdue loans: %w", idMember, overdue, entity.ErrRenewalNotAllowed)
+	}
+
+	if fines > policy.MaxFines {
+		return entity.Loan{}, fmt.Errorf("member %s owes %d: %w", idMember, fines, entity.ErrRenewalNotAllowed)
+	}
+
+	const queryRenew = `
+UPDATE loan l
+SET due_at   = $2,
+    renewals = l.renewals + 1
+FROM book_copy c
+WHERE c.id = l.copy_id
+  AND l.id = $1
+RETURNING ` + loanColumns
+
+	loan, err := scanLoan(tx.QueryRow(ctx, queryRenew, idLoan, dueAt.Add(policy.RenewalPeriod(membershipType))))
+	if err != nil {
+		return entity.Loan{}, err
+	}
+
+	const queryHistory = `
+INSERT INTO loan_renewal (loan_id, renewed_at, previous_due_at, due_at)
+VALUES ($1, $2, $3, $4)
+`
+	if _, err = tx.Exec(ctx, queryHistory, idLoan, renewedAt, dueAt, loan.DueAt); err != nil {
+		return entity.Loan{}, err
+	}
+
+	return loan, nil
+}
+
+func (p *postgresRepository) GetLoanRenewals(ctx context.Context, idLoan string) ([]entity.LoanRenewal, error) {
+	const query = `
+SELECT id, loan_id, renewed_at, previous_due_at, due_at
+FROM loan_renewal
+WHERE loan_id = $1
+ORDER BY renewed_at, id
+`
+	rows, err := p.db.Query(ctx, query, idLoan)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var renewals []entity.LoanRenewal
+	for rows.Next() {
+		var renewal entity.LoanRenewal
+		if err = rows.Scan(&renewal.ID, &renewal.LoanID, &renewal.RenewedAt, &renewal.PreviousDueAt, &renewal.DueAt); err != nil {
+			return nil, err
+		}
+		renewals = append(renewals, renewal)
+	}
+
+	return renewals, rows.Err()
+}
+
+// AccrueFines marks active loans past due as overdue and recalculates their fines.
+// Loans returned late since the previous run get their final fine.
+func (p *postgresRepository) AccrueFines(ctx context.Context, now time.Time, policy entity.FinePolicy) (int64, error) {
+	const query = `
+UPDATE loan
+SET overdue_at       = COALESCE(overdue_at, $1),
+    fine             = LEAST(GREATEST(floor(extract(EPOCH FROM COALESCE(returned_at, $1) - due_at) / 86400)::BIGINT - $2, 0) * $3, $4),
+    fines_accrued_at = $1
+WHERE due_at < COALESCE(returned_at, $1)
+  AND (returned_at IS NULL OR fines_accrued_at IS NULL OR fines_accrued_at < returned_at)
+`
+	tag, err := p.db.Exec(ctx, query, now, policy.GraceDays, policy.PerDay, policy.MaxPerItem)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func (p *postgresRepository) GetMemberBalance(ctx context.Context, idMember string, now time.Time) (entity.Balance, error) {
+	// only fines which are not paid or waived are owed
+	const queryFines = `
+SELECT (SELECT COALESCE(sum(GREATEST(l.fine - l.fine_paid, 0)), 0)::BIGINT FROM loan l WHERE l.member_id = m.id)
+FROM member m
+WHERE m.id = $1
+`
+	var balance entity.Balance
+	if err := p.db.QueryRow(ctx, queryFines, idMember).Scan(&balance.OutstandingFines); err != nil {
+		return entity.Balance{}, errMemberConvert(err)
+	}
+
+	const queryOverdue = `
+SELECT ` + loanColumns + `
+FROM loan l
+         JOIN book_copy c ON c.id = l.copy_id
+WHERE l.member_id = $1
+  AND l.returned_at IS NULL
+  AND l.due_at < $2
+ORDER BY l.due_at, l.id
+`
+	var err error
+	if balance.OverdueLoans, err = p.getLoans(ctx, queryOverdue, idMember, now); err != nil {
+		return entity.Balance{}, err
+	}
+
+	return balance, nil
+}
+
+func (p *postgresRepository) PayFine(ctx context.Context, payment entity.FinePayment) (resLoan entity.Loan, txErr error) {
+	var (
+		tx  pgx.Tx
+		err error
+	)
+
+	tx, err = p.db.Begin(ctx)
+	if err != nil {
+		return entity.Loan{}, err
+	}
+	defer func() { p.makeCommit(ctx, tx, txErr) }()
+
+	const queryLoan = `
+SELECT GREATEST(fine - fine_paid, 0) FROM loan WHERE id = $1 FOR UPDATE
+`
+	var outstanding int64
+	if err = tx.QueryRow(ctx, queryLoan, payment.LoanID).Scan(&outstanding); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.Loan{}, fmt.Errorf("loan %s: %w", payment.LoanID, entity.ErrLoanNotFound)
+		}
+		return entity.Loan{}, err
+	}
+
+	if payment.Amount > outstanding {
+		return entity.Loan{}, fmt.Errorf("loan %s owes %d, got %d: %w", payment.LoanID, outstanding, payment.Amount, entity.ErrFineOverpaid)
+	}
+
+	const queryPayment = `
+INSERT INTO fine_payment (loan_id, type, amount, paid_at)
+VALUES ($1, $2, $3, $4)
+`
+	if _, err = tx.Exec(ctx, queryPayment, payment.LoanID, string(payment.Type), payment.Amount, payment.PaidAt); err != nil {
+		return entity.Loan{}, err
+	}
+
+	const queryPaid = `
+UPDATE loan l
+SET fine_paid = l.fine_paid + $2
+FROM book_copy c
+WHERE c.id = l.copy_id
+  AND l.id = $1
+RETURNING ` + loanColumns
+
+	return scanLoan(tx.QueryRow(ctx, queryPaid, payment.LoanID, payment.Amount))
+}
+
+func errHoldConvert(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == ErrUniqueViolation {
+		return entity.ErrHoldAlreadyExists
+	}
+
+	if errors.As(err, &pgErr) && pgErr.Code == ErrForeignKeyViolation {
+		if pgErr.ConstraintName == "hold_member_id_fkey" {
+			return entity.ErrMemberNotFound
+		}
+		return entity.ErrBookNotFound
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.ErrHoldNotFound
+	}
+
+	return err
+}
+
+// holdColumns computes the position of waiting holds in the queue of the book
+const holdColumns = `
+h.id, h.book_id, h.member_id, h.copy_id, h.status::text, h.placed_at, h.ready_at, h.expires_at,
+CASE
+    WHEN h.status = 'WAITING' THEN (SELECT count(*)
+                                    FROM hold w
+                                    WHERE w.book_id = h.book_id
+                                      AND w.status = 'WAITING'
+                                      AND (w.placed_at, w.id) <= (h.placed_at, h.id))
+    ELSE 0 END
+`
+
+func scanHold(row pgx.Row) (entity.Hold, error) {
+	var (
+		hold   entity.Hold
+		idCopy *string
+	)
+
+	err := row.Scan(&hold.ID, &hold.BookID, &hold.MemberID, &idCopy, &hold.Status,
+		&hold.PlacedAt, &hold.ReadyAt, &hold.ExpiresAt, &hold.Position)
+	if err != nil {
+		return entity.Hold{}, err
+	}
+
+	if idCopy != nil {
+		hold.CopyID = *idCopy
+	}
+
+	return hold, nil
+}
+
+// releaseCopy keeps the copy on the shelf for the first waiting hold of its book,
+// if there is no one in the queue the copy becomes available.
+func (p *postgresRepository) releaseCopy(ctx context.Context, tx pgx.Tx, idCopy string, readyAt, pickupUntil time.Time) error {
+	const queryCopy = `
+SELECT book_id, status::text FROM book_copy WHERE id = $1 FOR UPDATE
+`
+	var (
+		idBook     string
+		copyStatus entity.CopyStatus
+	)
+	if err := tx.QueryRow(ctx, queryCopy, idCopy).Scan(&idBook, &copyStatus); err != nil {
+		return errCopyConvert(err)
+	}
+
+	// lost and withdrawn copies are not passed on
+	if copyStatus != entity.CopyAvailable && copyStatus != entity.CopyOnHold {
+		return nil
+	}
+
+	const queryHold = `
+UPDATE hold
+SET status = 'READY_FOR_PICKUP', copy_id = $2, ready_at = $3, expires_at = $4
+WHERE id = (SELECT id
+            FROM hold
+            WHERE book_id = $1
+              AND status = 'WAITING'
+            ORDER BY placed_at, id
+            LIMIT 1 FOR UPDATE SKIP LOCKED)
+`
+	tag, err := tx.Exec(ctx, queryHold, idBook, idCopy, readyAt, pickupUntil)
+	if err != nil {
+		return err
+	}
+
+	newStatus := entity.CopyAvailable
+	if tag.RowsAffected() > 0 {
+		newStatus = entity.CopyOnHold
+	}
+
+	const queryStatus = `
+UPDATE book_copy SET status = $2 WHERE id = $1
+`
+	_, err = tx.Exec(ctx, queryStatus, idCopy, string(newStatus))
+	return err
+}
+
+func (p *postgresRepository) PlaceHold(ctx context.Context, hold entity.Hold) (resHold entity.Hold, txErr error) {
+	var (
+		tx  pgx.Tx
+		err error
+	)
+
+	tx, err = p.db.Begin(ctx)
+	if err != nil {
+		return entity.Hold{}, err
+	}
+	defer func() { p.makeCommit(ctx, tx, txErr) }()
+
+	const queryInsert = `
+INSERT INTO hold (book_id, member_id, placed_at)
+VALUES ($1, $2, $3)
+RETURNING id
+`
+	var idHold string
+	err = tx.QueryRow(ctx, queryInsert, hold.BookID, hold.MemberID, hold.PlacedAt).Scan(&idHold)
+	if err != nil {
+		return entity.Hold{}, errHoldConvert(err)
+	}
+
+	// the inserted hold is not visible to RETURNING, so the position is selected separately
+	const querySelect = `
+SELECT ` + holdColumns + `
+FROM hold h
+WHERE h.id = $1
+`
+	return scanHold(tx.QueryRow(ctx, querySelect, idHold))
+}
+
+func (p *postgresRepository) CancelHold(ctx context.Context, idHold string, cancelledAt, pickupUntil time.Time) (resHold entity.Hold, txErr error) {
+	var (
+		tx  pgx.Tx
+		err error
+	)
+
+	tx, err = p.db.Begin(ctx)
+	if err != nil {
+		return entity.Hold{}, err
+	}
+	defer func() { p.makeCommit(ctx, tx, txErr) }()
+
+	const query = `
+UPDATE hold h
+SET status = 'CANCELLED', closed_at = $2
+WHERE h.id = $1
+  AND h.status IN ('WAITING', 'READY_FOR_PICKUP')
+RETURNING ` + holdColumns
+
+	hold, err := scanHold(tx.QueryRow(ctx, query, idHold, cancelledAt))
+	if err != nil {
+		return entity.Hold{}, errHoldConvert(err)
+	}
+
+	if hold.CopyID != "" {
+		if err = p.releaseCopy(ctx, tx, hold.CopyID, cancelledAt, pickupUntil); err != nil {
+			return entity.Hold{}, err
+		}
+	}
+
+	return hold, nil
+}
+
+func (p *postgresRepository) ExpireHolds(ctx context.Context, now, pickupUntil time.Time) (expired []entity.Hold, txErr error) {
+	var (
+		tx  pgx.Tx
+		err error
+	)
+
+	tx, err = p.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { p.makeCommit(ctx, tx, txErr) }()
+
+	const query = `
+UPDATE hold h
+SET status = 'EXPIRED', closed_at = $1
+WHERE h.status = 'READY_FOR_PICKUP'
+  AND h.expires_at <= $1
+RETURNING ` + holdColumns
+
+	expired, err = p.getHolds(ctx, tx, query, now)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range expired {
+		if err = p.releaseCopy(ctx, tx, expired[i].CopyID, now, pickupUntil); err != nil {
+			return nil, err
+		}
+	}
+
+	return expired, nil
+}
+
+func (p *postgresRepository) ListHolds(ctx context.Context, filter entity.HoldFilter) ([]entity.Hold, error) {
+	const query = `
+SELECT ` + holdColumns + `
+FROM hold h
+WHERE h.status IN ('WAITING', 'READY_FOR_PICKUP')
+  AND ($1 = '' OR h.member_id::text = $1)
+  AND ($2 = '' OR h.book_id::text = $2)
+ORDER BY h.status <> 'READY_FOR_PICKUP', h.placed_at, h.id
+`
+	return p.getHolds(ctx, p.db, query, filter.MemberID, filter.BookID)
+}
+
+// querier is implemented by both the pool and transactions
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+func (p *postgresRepository) getHolds(ctx context.Context, db querier, query string, args ...any) ([]entity.Hold, error) {
+	rows, err := db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var holds []entity.Hold
+	for rows.Next() {
+		var hold entity.Hold
+		if hold, err = scanHold(rows); err != nil {
+			return nil, err
+		}
+		holds = append(holds, hold)
+	}
+
+	return holds, rows.Err()
+}
+
+func errBranchConvert(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == ErrUniqueViolation {
+		return entity.ErrBranchAlreadyExists
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.ErrBranchNotFound
+	}
+
+	return err
+}
+
+const branchColumns = `
+br.id, br.name, COALESCE(br.address, ''), br.created_at, br.updated_at
+`
+
+func scanBranch(row pgx.Row) (entity.Branch, error) {
+	var branch entity.Branch
+
+	err := row.Scan(&branch.ID, &branch.Name, &branch.Address, &branch.CreatedAt, &branch.UpdatedAt)
+	if err != nil {
+		return entity.Branch{}, err
+	}
+
+	return branch, nil
+}
+
+func (p *postgresRepository) CreateBranch(ctx context.Context, branch entity.Branch) (entity.Branch, error) {
+	const query = `
+INSERT INTO branch (name, address)
+VALUES ($1, NULLIF($2, ''))
+RETURNING id, created_at, updated_at
+`
+	result := branch
+
+	err := p.db.QueryRow(ctx, query, branch.Name, branch.Address).Scan(&result.ID, &result.CreatedAt, &result.UpdatedAt)
+	if err != nil {
+		return entity.Branch{}, errBranchConvert(err)
+	}
+
+	return result, nil
+}
+
+func (p *postgresRepository) GetBranch(ctx context.Context, idBranch string) (entity.Branch, error) {
+	const query = `
+SELECT ` + branchColumns + `
+FROM branch br
+WHERE br.id = $1
+`
+	branch, err := scanBranch(p.db.QueryRow(ctx, query, idBranch))
+	if err != nil {
+		return entity.Branch{}, errBranchConvert(err)
+	}
+
+	return branch, nil
+}
+
+func (p *postgresRepository) ListBranches(ctx context.Context) ([]entity.Branch, error) {
+	const query = `
+SELECT ` + branchColumns + `
+FROM branch br
+ORDER BY br.name
+`
+	rows, err := p.db.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var branches []entity.Branch
+	for rows.Next() {
+		var branch entity.Branch
+		if branch, err = scanBranch(rows); err != nil {
+			return nil, err
+		}
+		branches = append(branches, branch)
+	}
+
+	return branches, rows.Err()
+}
+
+func errTransferConvert(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == ErrUniqueViolation {
+		return entity.ErrTransferAlreadyExists
+	}
+
+	if errors.As(err, &pgErr) && pgErr.Code == ErrForeignKeyViolation {
+		return entity.ErrBranchNotFound
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.ErrTransferNotFound
+	}
+
+	return err
+}
+
+const transferColumns = `
+t.id, t.copy_id, COALESCE(t.from_branch_id::text, ''), t.to_branch_id, t.status::text,
+t.requested_at, t.shipped_at, t.received_at
+`
+
+func scanTransfer(row pgx.Row) (entity.Transfer, error) {
+	var transfer entity.Transfer
+
+	err := row.Scan(&transfer.ID, &transfer.CopyID, &transfer.FromBranchID, &transfer.ToBranchID, &transfer.Status,
+		&transfer.RequestedAt, &transfer.ShippedAt, &transfer.ReceivedAt)
+	if err != nil {
+		return entity.Transfer{}, err
+	}
+
+	return transfer, nil
+}
+
+func (p *postgresRepository) RequestTransfer(ctx context.Context, transfer entity.Transfer) (resTransfer entity.Transfer, txErr error) {
+	var (
+		tx  pgx.Tx
+		err error
+	)
+
+	tx, err = p.db.Begin(ctx)
+	if err != nil {
+		return entity.Transfer{}, err
+	}
+	defer func() { p.makeCommit(ctx, tx, txErr) }()
+
+	const queryCopy = `
+SELECT status::text, COALESCE(current_branch_id::text, '') FROM book_copy WHERE id = $1 FOR UPDATE
+`
+	var copyStatus entity.CopyStatus
+	err = tx.QueryRow(ctx, queryCopy, transfer.CopyID).Scan(&copyStatus, &transfer.FromBranchID)
+	if err != nil {
+		return entity.Transfer{}, errCopyConvert(err)
+	}
+
+	if transfer.FromBranchID == transfer.ToBranchID {
+		return entity.Transfer{}, fmt.Errorf("copy %s is already in branch %s: %w",
+			transfer.CopyID, transfer.ToBranchID, entity.ErrTransferNotAllowed)
+	}
+
+	if copyStatus != entity.CopyAvailable {
+		return entity.Transfer{}, fmt.Errorf("copy %s is %s: %w", transfer.CopyID, copyStatus, entity.ErrCopyNotAvailable)
+	}
+
+	const queryTransfer = `
+INSERT INTO transfer (copy_id, from_branch_id, to_branch_id, requested_at)
+VALUES ($1, NULLIF($2, '')::uuid, $3, $4)
+RETURNING id, status::text
+`
+	err = tx.QueryRow(ctx, queryTransfer, transfer.CopyID, transfer.FromBranchID, transfer.ToBranchID,
+		transfer.RequestedAt).Scan(&transfer.ID, &transfer.Status)
+	if err != nil {
+		return entity.Transfer{}, errTransferConvert(err)
+	}
+
+	return transfer, nil
+}
+
+// lockTransfer selects the transfer for update and checks that it is in the given status.
+func (p *postgresRepository) lockTransfer(ctx context.Context, tx pgx.Tx, idTransfer string, status entity.TransferStatus) (entity.Transfer, error) {
+	const query = `
+SELECT ` + transferColumns + `
+FROM transfer t
+WHERE t.id = $1
+    FOR UPDATE
+`
+	transfer, err := scanTransfer(tx.QueryRow(ctx, query, idTransfer))
+	if err != nil {
+		return entity.Transfer{}, errTransferConvert(err)
+	}
+
+	if transfer.Status != status {
+		return entity.Transfer{}, fmt.Errorf("transfer %s is %s: %w", idTransfer, transfer.Status, entity.ErrTransferNotAllowed)
+	}
+
+	return transfer, nil
+}
+
+func (p *postgresRepository) ShipTransfer(ctx context.Context, idTransfer string, shippedAt time.Time) (resTransfer entity.Transfer, txErr error) {
+	var (
+		tx  pgx.Tx
+		err error
+	)
+
+	tx, err = p.db.Begin(ctx)
+	if err != nil {
+		return entity.Transfer{}, err
+	}
+	defer func() { p.makeCommit(ctx, tx, txErr) }()
+
+	transfer, err := p.lockTransfer(ctx, tx, idTransfer, entity.TransferRequested)
+	if err != nil {
+		return entity.Transfer{}, err
+	}
+
+	// the copy could be lent after the transfer was requested
+	const queryCopy = `
+UPDATE book_copy SET status = 'IN_TRANSIT' WHERE id = $1 AND status = 'AVAILABLE'
+`
+	tag, err := tx.Exec(ctx, queryCopy, transfer.CopyID)
+	if err != nil {
+		return entity.Transfer{}, err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entity.Transfer{}, fmt.Errorf("copy %s of transfer %s: %w", transfer.CopyID, idTransfer, entity.ErrCopyNotAvailable)
+	}
+
+	const queryTransfer = `
+UPDATE transfer t
+SET status = 'IN_TRANSIT', shipped_at = $2
+WHERE t.id = $1
+RETURNING ` + transferColumns
+
+	return scanTransfer(tx.QueryRow(ctx, queryTransfer, idTransfer, shippedAt))
+}
+
+func (p *postgresRepository) ReceiveTransfer(ctx context.Context, idTransfer string, receivedAt, pickupUntil time.Time) (resTransfer entity.Transfer, txErr error) {
+	var (
+		tx  pgx.Tx
+		err error
+	)
+
+	tx, err = p.db.Begin(ctx)
+	if err != nil {
+		return entity.Transfer{}, err
+	}
+	defer func() { p.makeCommit(ctx, tx, txErr) }()
+
+	transfer, err := p.lockTransfer(ctx, tx, idTransfer, entity.TransferInTransit)
+	if err != nil {
+		return entity.Transfer{}, err
+	}
+
+	const queryCopy = `
+UPDATE book_copy SET status = 'AVAILABLE', current_branch_id = $2 WHERE id = $1 AND status = 'IN_TRANSIT'
+`
+	tag, err := tx.Exec(ctx, queryCopy, transfer.CopyID, transfer.ToBranchID)
+	if err != nil {
+		return entity.Transfer{}, err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entity.Transfer{}, fmt.Errorf("copy %s of transfer %s is not in transit: %w", transfer.CopyID, idTransfer, entity.ErrTransferNotAllowed)
+	}
+
+	// the received copy goes to the queue of the book like a returned one
+	if err = p.releaseCopy(ctx, tx, transfer.CopyID, receivedAt, pickupUntil); err != nil {
+		return entity.Transfer{}, err
+	}
+
+	const queryTransfer = `
+UPDATE transfer t
+SET status = 'RECEIVED', received_at = $2
+WHERE t.id = $1
+RETURNING ` + transferColumns
+
+	return scanTransfer(tx.QueryRow(ctx, queryTransfer, idTransfer, receivedAt))
+}
+
+func (p *postgresRepository) CancelTransfer(ctx context.Context, idTransfer string) (resTransfer entity.Transfer, txErr error) {
+	var (
+		tx  pgx.Tx
+		err error
+	)
+
+	tx, err = p.db.Begin(ctx)
+	if err != nil {
+		return entity.Transfer{}, err
+	}
+	defer func() { p.makeCommit(ctx, tx, txErr) }()
+
+	if _, err = p.lockTransfer(ctx, tx, idTransfer, entity.TransferRequested); err != nil {
+		return entity.Transfer{}, err
+	}
+
+	const queryTransfer = `
+UPDATE transfer t
+SET status = 'CANCELLED'
+WHERE t.id = $1
+RETURNING ` + transferColumns
+
+	return scanTransfer(tx.QueryRow(ctx, queryTransfer, idTransfer))
+}
+
+func (p *postgresRepository) ListTransfers(ctx context.Context, filter entity.TransferFilter) ([]entity.Transfer, error) {
+	const query = `
+SELECT ` + transferColumns + `
+FROM transfer t
+WHERE ($1 = '' OR t.from_branch_id::text = $1 OR t.to_branch_id::text = $1)
+  AND ($2 = '' OR t.status::text = $2)
+ORDER BY t.requested_at, t.id
+`
+	rows, err := p.db.Query(ctx, query, filter.BranchID, string(filter.Status))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var transfers []entity.Transfer
+	for rows.Next() {
+		var transfer entity.Transfer
+		if transfer, err = scanTransfer(rows); err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, transfer)
+	}
+
+	return transfers, rows.Err()
+}
+
+func errReviewConvert(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == ErrUniqueViolation {
+		return entity.ErrReviewAlreadyExists
+	}
+
+	if errors.As(err, &pgErr) && pgErr.Code == ErrForeignKeyViolation {
+		if pgErr.ConstraintName == "review_member_id_fkey" {
+			return entity.ErrMemberNotFound
+		}
+		return entity.ErrBookNotFound
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.ErrReviewNotFound
+	}
+
+	return err
+}
+
+const reviewColumns = `
+r.id, r.book_id, r.member_id, r.rating, r.text, r.created_at, r.updated_at
+`
+
+func scanReview(row pgx.Row) (entity.Review, error) {
+	var review entity.Review
+
+	err := row.Scan(&review.ID, &review.BookID, &review.MemberID, &review.Rating, &review.Text, &review.CreatedAt, &review.UpdatedAt)
+	if err != nil {
+		return entity.Review{}, errReviewConvert(err)
+	}
+
+	return review, nil
+}
+
+// updateBookRating adds the difference of count and total of ratings to the aggregate of the book.
+func (p *postgresRepository) updateBookRating(ctx context.Context, tx pgx.Tx, idBook string, count, total int64) error {
+	const query = `
+INSERT INTO book_rating (book_id, review_count, rating_total)
+VALUES ($1, $2, $3)
+ON CONFLICT (book_id) DO UPDATE
+    SET review_count = book_rating.review_count + EXCLUDED.review_count,
+        rating_total = book_rating.rating_total + EXCLUDED.rating_total
+`
+	_, err := tx.Exec(ctx, query, idBook, count, total)
+	return err
+}
+
+func (p *postgresRepository) CreateReview(ctx context.Context, review entity.Review) (resReview entity.Review, txErr error) {
+	var (
+		tx  pgx.Tx
+		err error
+	)
+
+	tx, err = p.db.Begin(ctx)
+	if err != nil {
+		return entity.Review{}, err
+	}
+	defer func() { p.makeCommit(ctx, tx, txErr) }()
+
+	const query = `
+INSERT INTO review AS r (book_id, member_id, rating, text)
+VALUES ($1, $2, $3, $4)
+RETURNING ` + reviewColumns
+
+	review, err = scanReview(tx.QueryRow(ctx, query, review.BookID, review.MemberID, review.Rating, review.Text))
+	if err != nil {
+		return entity.Review{}, err
+	}
+
+	if err = p.updateBookRating(ctx, tx, review.BookID, 1, review.Rating); err != nil {
+		return entity.Review{}, err
+	}
+
+	return review, nil
+}
+
+func (p *postgresRepository) UpdateReview(ctx context.Context, review entity.Review) (resReview entity.Review, txErr error) {
+	var (
+		tx  pgx.Tx
+		err error
+	)
+
+	tx, err = p.db.Begin(ctx)
+	if err != nil {
+		return entity.Review{}, err
+	}
+	defer func() { p.makeCommit(ctx, tx, txErr) }()
+
+	// reviews of other members are not found
+	const queryOld = `
+SELECT rating FROM review WHERE id = $1 AND member_id = $2 FOR UPDATE
+`
+	var oldRating int64
+	if err = tx.QueryRow(ctx, queryOld, review.ID, review.MemberID).Scan(&oldRating); err != nil {
+		return entity.Review{}, errReviewConvert(err)
+	}
+
+	const query = `
+UPDATE review r
+SET rating = $2,
+    text   = $3
+WHERE r.id = $1
+RETURNING ` + reviewColumns
+
+	review, err = scanReview(tx.QueryRow(ctx, query, review.ID, review.Rating, review.Text))
+	if err != nil {
+		return entity.Review{}, err
+	}
+
+	if review.Rating != oldRating {
+		if err = p.updateBookRating(ctx, tx, review.BookID, 0, review.Rating-oldRating); err != nil {
+			return entity.Review{}, err
+		}
+	}
+
+	return review, nil
+}
+
+func (p *postgresRepository) DeleteReview(ctx context.Context, idReview, idMember string) (txErr error) {
+	var (
+		tx  pgx.Tx
+		err error
+	)
+
+	tx, err = p.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { p.makeCommit(ctx, tx, txErr) }()
+
+	const query = `
+DELETE FROM review WHERE id = $1 AND member_id = $2 RETURNING book_id, rating
+`
+	var (
+		idBook string
+		rating int64
+	)
+	if err = tx.QueryRow(ctx, query, idReview, idMember).Scan(&idBook, &rating); err != nil {
+		return errReviewConvert(err)
+	}
+
+	return p.updateBookRating(ctx, tx, idBook, -1, -rating)
+}
+
+func (p *postgresRepository) GetBookReviews(ctx context.Context, idBook string) ([]entity.Review, error) {
+	const query = `
+SELECT ` + reviewColumns + `
+FROM review r
+WHERE r.book_id = $1
+ORDER BY r.created_at DESC, r.id
+`
+	rows, err := p.db.Query(ctx, query, idBook)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reviews []entity.Review
+	for rows.Next() {
+		var review entity.Review
+		if review, err = scanReview(rows); err != nil {
+			return nil, err
+		}
+		reviews = append(reviews, review)
+	}
+
+	return reviews, rows.Err()
+}
+
+func (p *postgresRepository) GetBookRating(ctx context.Context, idBook string) (entity.Rating, error) {
+	const query = `
+SELECT review_count, rating_total FROM book_rating WHERE book_id = $1
+`
+	var rating entity.Rating
+	err := p.db.QueryRow(ctx, query, idBook).Scan(&rating.Count, &rating.Total)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Rating{}, nil
+	}
+
+	return rating, err
+}
+
+func errReadingListConvert(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == ErrUniqueViolation {
+		return entity.ErrReadingListAlreadyExists
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.ErrReadingListNotFound
+	}
+
+	return err
+}
+
+func errReadingListBookConvert(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == ErrUniqueViolation {
+		return entity.ErrReadingListBookExists
+	}
+
+	if errors.As(err, &pgErr) && pgErr.Code == ErrForeignKeyViolation {
+		if pgErr.ConstraintName == "reading_list_book_list_id_fkey" {
+			return entity.ErrReadingListNotFound
+		}
+		return entity.ErrBookNotFound
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.ErrReadingListBookNotFound
+	}
+
+	return err
+}
+
+// readingListColumns are the columns of reading_list rl with the number of its books,
+// they are read by scanReadingList.
+const readingListColumns = `
+rl.id, rl.owner_id, rl.name, rl.kind::text, rl.public,
+(SELECT count(*) FROM reading_list_book rb WHERE rb.list_id = rl.id) AS book_count,
+rl.created_at, rl.updated_at
+`
+
+func scanReadingList(row pgx.Row) (entity.ReadingList, error) {
+	var list entity.ReadingList
+
+	err := row.Scan(&list.ID, &list.OwnerID, &list.Name, &list.Kind, &list.Public, &list.BookCount,
+		&list.CreatedAt, &list.UpdatedAt)
+	if err != nil {
+		return entity.ReadingList{}, errReadingListConvert(err)
+	}
+
+	return list, nil
+}
+
+func (p *postgresRepository) CreateReadingList(ctx context.Context, list entity.ReadingList) (entity.ReadingList, error) {
+	const query = `
+INSERT INTO reading_list AS rl (owner_id, name, kind, public)
+VALUES ($1, $2, $3, $4)
+RETURNING ` + readingListColumns
+
+	return scanReadingList(p.db.QueryRow(ctx, query, list.OwnerID, norm.NFC.String(list.Name), string(list.Kind), list.Public))
+}
+
+func (p *postgresRepository) GetReadingList(ctx context.Context, idList string) (entity.ReadingList, error) {
+	const query = `
+SELECT ` + readingListColumns + `
+FROM reading_list rl
+WHERE rl.id = $1
+`
+	return scanReadingList(p.db.QueryRow(ctx, query, idList))
+}
+
+func (p *postgresRepository) GetOwnerReadingLists(ctx context.Context, idOwner string, withPrivate bool) ([]entity.ReadingList, error) {
+	const query = `
+SELECT ` + readingListColumns + `
+FROM reading_list rl
+WHERE rl.owner_id = $1
+  AND ($2 OR rl.public)
+ORDER BY rl.created_at, rl.id
+`
+	rows, err := p.db.Query(ctx, query, idOwner, withPrivate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var lists []entity.ReadingList
+	for rows.Next() {
+		var list entity.ReadingList
+		if list, err = scanReadingList(rows); err != nil {
+			return nil, err
+		}
+		lists = append(lists, list)
+	}
+
+	return lists, rows.Err()
+}
+
+// lockReadingList locks the list of the owner till the end of tx and marks it updated,
+// so concurrent changes of the order of books are serialized. Lists of other owners are not found.
+func (p *postgresRepository) lockReadingList(ctx context.Context, tx pgx.Tx, idList, idOwner string) error {
+	const query = `
+UPDATE reading_list SET updated_at = now() WHERE id = $1 AND owner_id = $2 RETURNING id
+`
+	var id string
+	return errReadingListConvert(tx.QueryRow(ctx, query, idList, idOwner).Scan(&id))
+}
+
+func (p *postgresRepository) AddReadingListBook(ctx context.Context, idList, idOwner, idBook string) (txErr error) {
+	var (
+		tx  pgx.Tx
+		err error
+	)
+
+	tx, err = p.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { p.makeCommit(ctx, tx, txErr) }()
+
+	if err = p.lockReadingList(ctx, tx, idList, idOwner); err != nil {
+		return err
+	}
+
+	const query = `
+INSERT INTO reading_list_book (list_id, book_id, position)
+SELECT $1, $2, COALESCE(MAX(position), 0) + 1
+FROM reading_list_book
+WHERE list_id = $1
+`
+	_, err = tx.Exec(ctx, query, idList, idBook)
+	return errReadingListBookConvert(err)
+}
+
+func (p *postgresRepository) RemoveReadingListBook(ctx context.Context, idList, idOwner, idBook string) (txErr error) {
+	var (
+		tx  pgx.Tx
+		err error
+	)
+
+	tx, err = p.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { p.makeCommit(ctx, tx, txErr) }()
+
+	if err = p.lockReadingList(ctx, tx, idList, idOwner); err != nil {
+		return err
+	}
+
+	const queryDelete = `
+DELETE FROM reading_list_book WHERE list_id = $1 AND book_id = $2 RETURNING position
+`
+	var position int64
+	if err = tx.QueryRow(ctx, queryDelete, idList, idBook).Scan(&position); err != nil {
+		return errReadingListBookConvert(err)
+	}
+
+	const queryShift = `
+UPDATE reading_list_book SET position = position - 1 WHERE list_id = $1 AND position > $2
+`
+	_, err = tx.Exec(ctx, queryShift, idList, position)
+	return err
+}
+
+func (p *postgresRepository) ReorderReadingListBook(ctx context.Context, idList, idOwner, idBook string, position int64) (txErr error) {
+	var (
+		tx  pgx.Tx
+		err error
+	)
+
+	tx, err = p.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { p.makeCommit(ctx, tx, txErr) }()
+
+	if err = p.lockReadingList(ctx, tx, idList, idOwner); err != nil {
+		return err
+	}
+
+	const queryPosition = `
+SELECT position, (SELECT count(*) FROM reading_list_book WHERE list_id = $1)
+FROM reading_list_book
+WHERE list_id = $1
+  AND book_id = $2
+`
+	var oldPosition, count int64
+	if err = tx.QueryRow(ctx, queryPosition, idList, idBook).Scan(&oldPosition, &count); err != nil {
+		return errReadingListBookConvert(err)
+	}
+
+	position = min(position, count)
+	if position == oldPosition {
+		return nil
+	}
+
+	// books between the old and the new position are shifted by one towards the old position
+	const queryShift = `
+UPDATE reading_list_book
+SET position = CASE WHEN book_id = $2 THEN $4::int WHEN $3::int < $4::int THEN position - 1 ELSE position + 1 END
+WHERE list_id = $1
+  AND position BETWEEN LEAST($3::int, $4::int) AND GREATEST($3::int, $4::int)
+`
+	_, err = tx.Exec(ctx, queryShift, idList, idBook, oldPosition, position)
+	return err
+}
+
+func (p *postgresRepository) ShareReadingList(ctx context.Context, idList, idOwner string, public bool) (entity.ReadingList, error) {
+	const query = `
+UPDATE reading_list rl
+SET public = $3
+WHERE rl.id = $1
+  AND rl.owner_id = $2
+RETURNING ` + readingListColumns
+
+	list, err := scanReadingList(p.db.QueryRow(ctx, query, idList, idOwner, public))
+	if err != nil {
+		return entity.ReadingList{}, errReadingListConvert(err)
+	}
+
+	return list, nil
+}
+
+func (p *postgresRepository) GetReadingListBooks(ctx context.Context, idList string) (<-chan entity.Book, func() error, error) {
+	const queryBook = `
+DECLARE booksCursor CURSOR FOR
+    SELECT ` + bookColumns + `
+    FROM reading_list_book rb
+             JOIN
+         book b ON b.id = rb.book_id
+             LEFT JOIN
+         book_contributor ab ON b.id = ab.book_id
+    WHERE rb.list_id = $1
+    GROUP BY b.id, rb.position
+    ORDER BY rb.position
+`
+	return p.getBooksByCursor(ctx, queryBook, idList)
+}
+
+// RefreshRecommendations rebuilds book_recommendation in batches of entity.RecommendationBatchSize books keeping
+// at most limit books with the best score for each book. Each batch is replaced in its own transaction, so readers
+// get either old or new recommendations of a book and the table is never locked as a whole.
+func (p *postgresRepository) RefreshRecommendations(
+	ctx context.Context,
+	weights entity.RecommendationWeights,
+	limit int64,
+) (int64, error) {
+	var (
+		inserted int64
+		lastID   = uuid.Nil.String()
+	)
+	for {
+		var ids []string
+		err := p.db.QueryRow(ctx, `SELECT array(SELECT id::text FROM book WHERE id > $1 ORDER BY id LIMIT $2)`,
+			lastID, entity.RecommendationBatchSize).Scan(&ids)
+		if err != nil {
+			return inserted, err
+		}
+		if len(ids) == 0 {
+			return inserted, nil
+		}
+
+		n, err := p.refreshRecommendationsBatch(ctx, ids, weights, limit)
+		if err != nil {
+			return inserted, err
+		}
+		inserted += n
+		lastID = ids[len(ids)-1]
+	}
+}
+
+// refreshRecommendationsBatch replaces recommendations of the books. Each author, subject, reading list and borrower
+// of a book gives at most entity.MaxRecommendationCandidates candidates and at most as many reading lists and borrowers
+// of a book are taken, so the joins stay bounded for popular authors, lists and members.
+// Other editions of the same work are not recommended, they share the authors anyway.
+func (p *postgresRepository) refreshRecommendationsBatch(
+	ctx context.Context,
+	ids []string,
+	weights entity.RecommendationWeights,
+	limit int64,
+) (inserted int64, txErr error) {
+	var (
+		tx  pgx.Tx
+		err error
+	)
+
+	tx, err = p.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { p.makeCommit(ctx, tx, txErr) }()
+
+	if _, err = tx.Exec(ctx, `DELETE FROM book_recommendation WHERE book_id = ANY ($1::uuid[])`, ids); err != nil {
+		return 0, err
+	}
+
+	const query = `
+WITH authors AS (SELECT a.book_id, o.book_id AS recommended_book_id, count(*) AS n
+                 FROM (SELECT DISTINCT book_id, author_id FROM book_contributor WHERE book_id = ANY ($1::uuid[])) a
+                          CROSS JOIN LATERAL (SELECT DISTINCT o.book_id
+                                              FROM book_contributor o
+                                              WHERE o.author_id = a.author_id
+                                                AND o.book_id <> a.book_id
+                                              ORDER BY o.book_id
+                                              LIMIT $8) o
+                 GROUP BY a.book_id, o.book_id),
+     subjects AS (SELECT a.book_id, o.book_id AS recommended_book_id, count(*) AS n
+                  FROM book_subject a
+                           CROSS JOIN LATERAL (SELECT o.book_id
+                                               FROM book_subject o
+                                               WHERE o.subject = a.subject
+                                                 AND o.book_id <> a.book_id
+                                               ORDER BY o.book_id
+                                               LIMIT $8) o
+                  WHERE a.book_id = ANY ($1::uuid[])
+                  GROUP BY a.book_id, o.book_id),
+     relations AS (SELECT book_id, recommended_book_id, count(*) AS n
+                   FROM (SELECT book_id, related_book_id AS recommended_book_id
+                         FROM book_relation
+                         WHERE book_id = ANY ($1::uuid[])
+                         UNION ALL
+                         SELECT related_book_id, book_id
+                         FROM book_relation
+                         WHERE related_book_id = ANY ($1::uuid[])) r
+                   GROUP BY book_id, recommended_book_id),
+     book_lists AS (SELECT book_id, list_id
+                    FROM (SELECT book_id,
+                                 list_id,
+                                 row_number() OVER (PARTITION BY book_id ORDER BY added_at DESC, list_id) AS rank
+                          FROM reading_list_book
+                          WHERE book_id = ANY ($1::uuid[])) l
+                    WHERE rank <= $8),
+     lists AS (SELECT a.book_id, o.book_id AS recommended_book_id, count(*) AS n
+               FROM book_lists a
+                        CROSS JOIN LATERAL (SELECT o.book_id
+                                            FROM reading_list_book o
+                                            WHERE o.list_id = a.list_id
+                                              AND o.book_id <> a.book_id
+                                            ORDER BY o.position
+                                            LIMIT $8) o
+               GROUP BY a.book_id, o.book_id),
+     borrowed AS (SELECT book_id, member_id
+                  FROM (SELECT c.book_id,
+                               l.member_id,
+                               row_number() OVER (PARTITION BY c.book_id ORDER BY max(l.checked_out_at) DESC, l.member_id) AS rank
+                        FROM loan l
+                                 JOIN book_copy c ON c.id = l.copy_id
+                        WHERE c.book_id = ANY ($1::uuid[])
+                        GROUP BY c.book_id, l.member_id) m
+                  WHERE rank <= $8),
+     borrowers AS (SELECT a.book_id, o.book_id AS recommended_book_id, count(*) AS n
+                   FROM borrowed a
+                            CROSS JOIN LATERAL (SELECT c.book_id
+                                                FROM loan l
+                                                         JOIN book_copy c ON c.id = l.copy_id
+                                                WHERE l.member_id = a.member_id
+                                                  AND c.book_id <> a.book_id
+                                                GROUP BY c.book_id
+                                                ORDER BY max(l.checked_out_at) DESC, c.book_id
+                                                LIMIT $8) o
+                   GROUP BY a.book_id, o.book_id),
+     signals AS (SELECT book_id, recommended_book_id, n AS shared_authors, 0 AS shared_subjects, 0 AS relations,
+                        0 AS reading_lists, 0 AS co_borrowers
+                 FROM authors
+                 UNION ALL
+                 SELECT book_id, recommended_book_id, 0, n, 0, 0, 0 FROM subjects
+                 UNION ALL
+                 SELECT book_id, recommended_book_id, 0, 0, n, 0, 0 FROM relations
+                 UNION ALL
+                 SELECT book_id, recommended_book_id, 0, 0, 0, n, 0 FROM lists
+                 UNION ALL
+                 SELECT book_id, recommended_book_id, 0, 0, 0, 0, n FROM borrowers),
+     scored AS (SELECT s.book_id,
+                       s.recommended_book_id,
+                       sum(s.shared_authors)  AS shared_authors,
+                       sum(s.shared_subjects) AS shared_subjects,
+                       sum(s.relations)       AS relations,
+                       sum(s.reading_lists)   AS reading_lists,
+                       sum(s.co_borrowers)    AS co_borrowers
+                FROM signals s
+                         JOIN book b ON b.id = s.book_id
+                         JOIN book r ON r.id = s.recommended_book_id
+                WHERE r.work_id <> b.work_id
+                GROUP BY s.book_id, s.recommended_book_id),
+     ranked AS (SELECT *, row_number() OVER (PARTITION BY book_id ORDER BY score DESC, recommended_book_id) AS rank
+                FROM (SELECT *,
+                             shared_authors * $2::bigint + shared_subjects * $3::bigint + relations * $4::bigint +
+                             reading_lists * $5::bigint + co_borrowers * $6::bigint AS score
+                      FROM scored) sc)
+INSERT
+INTO book_recommendation (book_id, recommended_book_id, shared_authors, shared_subjects, relations, reading_lists,
+                          co_borrowers, score)
+SELECT book_id, recommended_book_id, shared_authors, shared_subjects, relations, reading_lists, co_borrowers, score
+FROM ranked
+WHERE rank <= $7
+  AND score > 0
+`
+	tag, err := tx.Exec(ctx, query, ids, weights.SharedAuthor, weights.SharedSubject, weights.Relation,
+		weights.ReadingList, weights.CoBorrower, limit, entity.MaxRecommendationCandidates)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func (p *postgresRepository) GetRecommendations(ctx context.Context, idBook string, limit int64) ([]entity.Recommendation, error) {
+	const query = `
+SELECT ` + bookColumns + `, br.shared_authors, br.shared_subjects, br.relations, br.reading_lists, br.co_borrowers, br.score
+FROM book_recommendation br
+         JOIN
+     book b ON b.id = br.recommended_book_id
+         LEFT JOIN
+     book_contributor ab ON b.id = ab.book_id
+WHERE br.book_id = $1
+GROUP BY b.id, br.book_id, br.recommended_book_id
+ORDER BY br.score DESC, br.recommended_book_id
+LIMIT $2
+`
+	rows, err := p.db.Query(ctx, query, idBook, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var recommendations []entity.Recommendation
+	for rows.Next() {
+		var r entity.Recommendation
+		if r.Book, err = scanBook(rows, &r.SharedAuthors, &r.SharedSubjects, &r.Relations, &r.ReadingLists, &r.CoBorrowers, &r.Score); err != nil {
+			return nil, err
+		}
+		recommendations = append(recommendations, r)
+	}
+
+	return recommendations, rows.Err()
+}
+
+// RefreshLibraryStats recomputes the materialized views of statistics, readers are not blocked meanwhile.
+func (p *postgresRepository) RefreshLibraryStats(ctx context.Context) error {
+	for _, view := range []string{"library_stats", "library_stats_books_per_day", "library_stats_author_books"} {
+		if _, err := p.db.Exec(ctx, "REFRESH MATERIALIZED VIEW CONCURRENTLY "+view); err != nil {
+			return fmt.Errorf("refresh %s: %w", view, err)
+		}
+	}
+	return nil
+}
+
+func (p *postgresRepository) GetLibraryStats(ctx context.Context) (entity.LibraryStats, error) {
+	const query = `
+SELECT book_count, author_count, books_without_authors, average_authors_per_book, refreshed_at
+FROM library_stats
+`
+	var stats entity.LibraryStats
+	err := p.db.QueryRow(ctx, query).Scan(&stats.BookCount, &stats.AuthorCount, &stats.BooksWithoutAuthors,
+		&stats.AverageAuthorsPerBook, &stats.RefreshedAt)
+
+	return stats, err
+}
+
+// GetBooksAdded counts books added in each bucket of the interval starting in [from; to),
+// the first bucket starts at the beginning of the interval containing from. Empty buckets are returned too.
+func (p *postgresRepository) GetBooksAdded(
+	ctx context.Context,
+	from, to time.Time,
+	interval entity.StatsInterval,
+) ([]entity.BooksAdded, error) {
+	const query = `
+SELECT g.start, COALESCE(sum(d.book_count), 0)::bigint
+FROM generate_series(date_trunc($3::text, $1::timestamp), $2::timestamp, ('1 ' || $3::text)::interval) AS g(start)
+         LEFT JOIN library_stats_books_per_day d
+                   ON d.day >= g.start AND d.day < g.start + ('1 ' || $3::text)::interval
+WHERE g.start < $2::timestamp
+GROUP BY g.start
+ORDER BY g.start
+`
+	rows, err := p.db.Query(ctx, query, from, to, string(interval))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var buckets []entity.BooksAdded
+	for rows.Next() {
+		var bucket entity.BooksAdded
+		if err = rows.Scan(&bucket.Start, &bucket.Count); err != nil {
+			return nil, err
+		}
+		buckets = append(buckets, bucket)
+	}
+
+	return buckets, rows.Err()
+}
+
+func (p *postgresRepository) GetTopAuthors(ctx context.Context, limit int64) ([]entity.AuthorBookCount, error) {
+	const query = `
+SELECT author_id, name, book_count
+FROM library_stats_author_books
+ORDER BY book_count DESC, author_id
+LIMIT $1
+`
+	rows, err := p.db.Query(ctx, query, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var authors []entity.AuthorBookCount
+	for rows.Next() {
+		var author entity.AuthorBookCount
+		if err = rows.Scan(&author.AuthorID, &author.Name, &author.BookCount); err != nil {
+			return nil, err
+		}
+		authors = append(authors, author)
+	}
+
+	return authors, rows.Err()
+}
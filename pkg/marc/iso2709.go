@@ -0,0 +1,222 @@
+package marc
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"unicode/utf8"
+)
+
+const (
+	recordTerminator  = 0x1D
+	fieldTerminator   = 0x1E
+	subfieldDelimiter = 0x1F
+
+	leaderLength         = 24
+	directoryEntryLength = 12
+	maxFieldLength       = 9999
+	maxRecordLength      = 99999
+)
+
+// Reader reads MARC 21 records in ISO 2709 encoded in UTF-8.
+type Reader struct {
+	r *bufio.Reader
+}
+
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: bufio.NewReader(r)}
+}
+
+// Read returns the next record or io.EOF after the last one. Records are split by the record terminator,
+// so the reader continues with the next record after ErrInvalidRecord. At most maxRecordLength bytes
+// of a record are kept, the rest of a longer record is skipped.
+func (r *Reader) Read() (Record, error) {
+	var (
+		data    []byte
+		tooLong bool
+		err     error
+	)
+	for {
+		var chunk []byte
+		chunk, err = r.r.ReadSlice(recordTerminator)
+		if !tooLong {
+			// line breaks between records are written by some systems
+			data = bytes.TrimLeft(append(data, chunk...), "\r\n")
+			tooLong = len(data) > maxRecordLength
+		}
+		if !errors.Is(err, bufio.ErrBufferFull) {
+			break
+		}
+	}
+
+	if errors.Is(err, io.EOF) {
+		if len(bytes.TrimSpace(data)) == 0 {
+			return Record{}, io.EOF
+		}
+		return Record{}, fmt.Errorf("record is not terminated: %w", ErrInvalidRecord)
+	}
+	if err != nil {
+		return Record{}, err
+	}
+	if tooLong {
+		return Record{}, fmt.Errorf("record is longer than %d bytes: %w", maxRecordLength, ErrInvalidRecord)
+	}
+
+	return parseRecord(data)
+}
+
+// parseDigits parses the number of the leader or of the directory. Unlike strconv.Atoi it accepts
+// only digits, so the number is never negative.
+func parseDigits(s string) (int, bool) {
+	if s == "" {
+		return 0, false
+	}
+	n := 0
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return 0, false
+		}
+		n = n*10 + int(s[i]-'0')
+	}
+	return n, true
+}
+
+func parseRecord(data []byte) (Record, error) {
+	if len(data) < leaderLength+2 {
+		return Record{}, fmt.Errorf("record is too short: %w", ErrInvalidRecord)
+	}
+	if !utf8.Valid(data) {
+		return Record{}, fmt.Errorf("record is not in UTF-8: %w", ErrInvalidRecord)
+	}
+
+	leader := string(data[:leaderLength])
+	length, ok := parseDigits(leader[0:5])
+	if !ok || length != len(data) {
+		return Record{}, fmt.Errorf("record length %q doesn't match %d: %w", leader[0:5], len(data), ErrInvalidRecord)
+	}
+	base, ok := parseDigits(leader[12:17])
+	if !ok || base <= leaderLength || base > len(data) || data[base-1] != fieldTerminator ||
+		(base-1-leaderLength)%directoryEntryLength != 0 {
+		return Record{}, fmt.Errorf("invalid base address %q: %w", leader[12:17], ErrInvalidRecord)
+	}
+
+	record := Record{Leader: leader}
+	for entry := data[leaderLength : base-1]; len(entry) > 0; entry = entry[directoryEntryLength:] {
+		tag := string(entry[0:3])
+		fieldLength, okLength := parseDigits(string(entry[3:7]))
+		start, okStart := parseDigits(string(entry[7:12]))
+		if !validTag(tag) || !okLength || !okStart || fieldLength < 1 || base+start+fieldLength > len(data) {
+			return Record{}, fmt.Errorf("invalid directory entry %q: %w", entry[:directoryEntryLength], ErrInvalidRecord)
+		}
+
+		value := data[base+start : base+start+fieldLength]
+		if value[len(value)-1] != fieldTerminator {
+			return Record{}, fmt.Errorf("field %s is not terminated: %w", tag, ErrInvalidRecord)
+		}
+
+		field, err := parseField(tag, value[:len(value)-1])
+		if err != nil {
+			return Record{}, err
+		}
+		record.Fields = append(record.Fields, field)
+	}
+
+	return record, nil
+}
+
+func parseField(tag string, value []byte) (Field, error) {
+	field := Field{Tag: tag}
+	if field.IsControl() {
+		field.Value = string(value)
+		return field, nil
+	}
+
+	parts := bytes.Split(value, []byte{subfieldDelimiter})
+	if len(parts[0]) != 2 {
+		return Field{}, fmt.Errorf("field %s has invalid indicators: %w", tag, ErrInvalidRecord)
+	}
+	field.Indicators = [2]byte{parts[0][0], parts[0][1]}
+
+	for _, part := range parts[1:] {
+		if len(part) == 0 {
+			return Field{}, fmt.Errorf("field %s has subfield without code: %w", tag, ErrInvalidRecord)
+		}
+		field.Subfields = append(field.Subfields, Subfield{Code: part[0], Value: string(part[1:])})
+	}
+
+	return field, nil
+}
+
+// Writer writes MARC 21 records in ISO 2709 encoded in UTF-8.
+type Writer struct {
+	w io.Writer
+}
+
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+func (w *Writer) Write(record Record) error {
+	data, err := encodeRecord(record)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.w.Write(data)
+	return err
+}
+
+func encodeRecord(record Record) ([]byte, error) {
+	var directory, fields bytes.Buffer
+	for _, field := range record.Fields {
+		if !validTag(field.Tag) {
+			return nil, fmt.Errorf("invalid tag %q: %w", field.Tag, ErrInvalidRecord)
+		}
+
+		start := fields.Len()
+		if field.IsControl() {
+			fields.WriteString(field.Value)
+		} else {
+			fields.WriteByte(indicator(field, 0))
+			fields.WriteByte(indicator(field, 1))
+			for _, s := range field.Subfields {
+				fields.WriteByte(subfieldDelimiter)
+				fields.WriteByte(s.Code)
+				fields.WriteString(s.Value)
+			}
+		}
+		fields.WriteByte(fieldTerminator)
+
+		length := fields.Len() - start
+		if length > maxFieldLength {
+			return nil, fmt.Errorf("field %s is longer than %d bytes: %w", field.Tag, maxFieldLength, ErrInvalidRecord)
+		}
+		fmt.Fprintf(&directory, "%s%04d%05d", field.Tag, length, start)
+	}
+	fields.WriteByte(recordTerminator)
+
+	base := leaderLength + directory.Len() + 1
+	length := base + fields.Len()
+	if length > maxRecordLength {
+		return nil, fmt.Errorf("record is longer than %d bytes: %w", maxRecordLength, ErrInvalidRecord)
+	}
+
+	leader := []byte(DefaultLeader)
+	if len(record.Leader) == leaderLength {
+		leader = []byte(record.Leader)
+	}
+	copy(leader[0:5], fmt.Sprintf("%05d", length))
+	// records are always written in Unicode
+	leader[9] = 'a'
+	copy(leader[10:12], "22")
+	copy(leader[12:17], fmt.Sprintf("%05d", base))
+	copy(leader[20:24], "4500")
+
+	data := make([]byte, 0, length)
+	data = append(data, leader...)
+	data = append(data, directory.Bytes()...)
+	data = append(data, fieldTerminator)
+	return append(data, fields.Bytes()...), nil
+}
@@ -0,0 +1,75 @@
+// Package marc reads and writes bibliographic records in MARC 21 (ISO 2709) and MARCXML formats.
+package marc
+
+import "errors"
+
+// ErrInvalidRecord is returned for a malformed record, the next records may still be read.
+var ErrInvalidRecord = errors.New("invalid MARC record")
+
+// DefaultLeader is the leader of a new bibliographic record of a monograph in Unicode,
+// lengths and the base address are set by writers.
+const DefaultLeader = "00000nam a2200000 i 4500"
+
+type Subfield struct {
+	Code  byte
+	Value string
+}
+
+// Field is a control field (tags 001-009) with Value or a data field with Indicators and Subfields.
+type Field struct {
+	Tag        string
+	Value      string
+	Indicators [2]byte
+	Subfields  []Subfield
+}
+
+// IsControl reports whether the field is a control field.
+func (f Field) IsControl() bool {
+	return len(f.Tag) == 3 && f.Tag[0] == '0' && f.Tag[1] == '0'
+}
+
+// Subfield returns the value of the first subfield with the code, empty if there is no such subfield.
+func (f Field) Subfield(code byte) string {
+	for _, s := range f.Subfields {
+		if s.Code == code {
+			return s.Value
+		}
+	}
+	return ""
+}
+
+// indicator returns the indicator of the field, undefined indicators are blank.
+func indicator(f Field, i int) byte {
+	if f.Indicators[i] == 0 {
+		return ' '
+	}
+	return f.Indicators[i]
+}
+
+type Record struct {
+	Leader string
+	Fields []Field
+}
+
+// FieldsByTag returns fields of the record with the tag in their order.
+func (r Record) FieldsByTag(tag string) []Field {
+	var fields []Field
+	for _, f := range r.Fields {
+		if f.Tag == tag {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+func validTag(tag string) bool {
+	if len(tag) != 3 {
+		return false
+	}
+	for i := 0; i < len(tag); i++ {
+		if c := tag[i]; (c < '0' || c > '9') && (c < 'A' || c > 'Z') && (c < 'a' || c > 'z') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,191 @@
+package marc
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testRecord() Record {
+	return Record{
+		Leader: DefaultLeader,
+		Fields: []Field{
+			{Tag: "001", Value: "id-1"},
+			{Tag: "008", Value: "240501s1965    xx            000 0 und d"},
+			{Tag: "020", Indicators: [2]byte{' ', ' '}, Subfields: []Subfield{{Code: 'a', Value: "9780306406157"}}},
+			{Tag: "100", Indicators: [2]byte{'1', ' '}, Subfields: []Subfield{{Code: 'a', Value: "Herbert, Frank,"}, {Code: '4', Value: "aut"}}},
+			{Tag: "245", Indicators: [2]byte{'1', '0'}, Subfields: []Subfield{{Code: 'a', Value: "Dune <ёлка> & co /"}}},
+		},
+	}
+}
+
+func readAll(t *testing.T, read func() (Record, error)) ([]Record, []error) {
+	t.Helper()
+	var (
+		records []Record
+		errs    []error
+	)
+	for {
+		record, err := read()
+		if errors.Is(err, io.EOF) {
+			return records, errs
+		}
+		if err != nil {
+			require.ErrorIs(t, err, ErrInvalidRecord)
+			errs = append(errs, err)
+			continue
+		}
+		records = append(records, record)
+	}
+}
+
+func TestBinary(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	require.NoError(t, w.Write(testRecord()))
+	require.NoError(t, w.Write(Record{Fields: []Field{{Tag: "245", Subfields: []Subfield{{Code: 'a', Value: "Emma"}}}}}))
+
+	data := buf.String()
+	require.Equal(t, "22", data[10:12])
+	require.Equal(t, byte(recordTerminator), data[len(data)-1])
+
+	records, errs := readAll(t, NewReader(&buf).Read)
+	require.Empty(t, errs)
+	require.Len(t, records, 2)
+
+	expected := testRecord()
+	expected.Leader = records[0].Leader
+	require.Equal(t, expected, records[0])
+	require.Equal(t, "a", records[0].Leader[9:10])
+	require.Equal(t, [2]byte{' ', ' '}, records[1].Fields[0].Indicators)
+	require.Equal(t, "Emma", records[1].FieldsByTag("245")[0].Subfield('a'))
+}
+
+func TestBinaryInvalidRecord(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	require.NoError(t, NewWriter(&buf).Write(testRecord()))
+	valid := buf.String()
+
+	broken := []byte(valid)
+	copy(broken[0:5], "00010")
+
+	input := string(broken) + "\n" + valid + "\r\n" + "not a record" + string(rune(recordTerminator)) + valid[:30]
+	records, errs := readAll(t, NewReader(strings.NewReader(input)).Read)
+	require.Len(t, records, 1)
+	require.Len(t, errs, 3)
+}
+
+func TestBinaryMalformedDirectory(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	require.NoError(t, NewWriter(&buf).Write(testRecord()))
+	valid := buf.String()
+
+	tests := []struct {
+		name   string
+		offset int
+		value  string
+	}{
+		{name: "negative start", offset: leaderLength + 7, value: "-9999"},
+		{name: "signed start", offset: leaderLength + 7, value: "+0000"},
+		{name: "signed length", offset: leaderLength + 3, value: "+005"},
+		{name: "start with space", offset: leaderLength + 7, value: " 0000"},
+		{name: "signed record length", offset: 0, value: "+" + valid[1:5]},
+		{name: "negative base address", offset: 12, value: "-0001"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			broken := []byte(valid)
+			copy(broken[test.offset:], test.value)
+
+			records, errs := readAll(t, NewReader(strings.NewReader(string(broken)+valid)).Read)
+			require.Len(t, records, 1)
+			require.Len(t, errs, 1)
+		})
+	}
+}
+
+func TestBinaryTooLongRecord(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	require.NoError(t, NewWriter(&buf).Write(testRecord()))
+
+	input := strings.Repeat("x", maxRecordLength+1) + string(rune(recordTerminator)) + buf.String()
+	records, errs := readAll(t, NewReader(strings.NewReader(input)).Read)
+	require.Len(t, records, 1)
+	require.Len(t, errs, 1)
+	require.ErrorContains(t, errs[0], "longer")
+}
+
+func TestXML(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	w := NewXMLWriter(&buf)
+	require.NoError(t, w.Write(testRecord()))
+	require.NoError(t, w.Close())
+	require.Contains(t, buf.String(), `<collection xmlns="`+XMLNamespace+`">`)
+	require.Contains(t, buf.String(), "Dune &lt;ёлка&gt; &amp; co /")
+
+	records, errs := readAll(t, NewXMLReader(&buf).Read)
+	require.Empty(t, errs)
+	require.Equal(t, []Record{testRecord()}, records)
+}
+
+func TestXMLEmptyCollection(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	require.NoError(t, NewXMLWriter(&buf).Close())
+
+	records, errs := readAll(t, NewXMLReader(&buf).Read)
+	require.Empty(t, errs)
+	require.Empty(t, records)
+}
+
+func TestXMLReader(t *testing.T) {
+	t.Parallel()
+
+	const input = `<?xml version="1.0"?>
+<marc:collection xmlns:marc="http://www.loc.gov/MARC21/slim">
+  <marc:record>
+    <marc:leader>00000nam a2200000 i 4500</marc:leader>
+    <marc:controlfield tag="001">1</marc:controlfield>
+    <marc:datafield tag="245" ind1="0" ind2="0"><marc:subfield code="a">Emma</marc:subfield></marc:datafield>
+  </marc:record>
+  <marc:record>
+    <marc:leader>short</marc:leader>
+  </marc:record>
+  <marc:record>
+    <marc:leader>00000nam a2200000 i 4500</marc:leader>
+    <marc:datafield tag="245" ind1="0" ind2="0"><marc:subfield code="ab">Emma</marc:subfield></marc:datafield>
+  </marc:record>
+</marc:collection>`
+
+	records, errs := readAll(t, NewXMLReader(strings.NewReader(input)).Read)
+	require.Len(t, errs, 2)
+	require.Equal(t, []Record{{
+		Leader: "00000nam a2200000 i 4500",
+		Fields: []Field{
+			{Tag: "001", Value: "1"},
+			{Tag: "245", Indicators: [2]byte{'0', '0'}, Subfields: []Subfield{{Code: 'a', Value: "Emma"}}},
+		},
+	}}, records)
+
+	_, err := NewXMLReader(strings.NewReader("<collection><record>")).Read()
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrInvalidRecord)
+}
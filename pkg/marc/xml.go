@@ -0,0 +1,174 @@
+package marc
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+)
+
+// XMLNamespace is the namespace of MARCXML elements.
+const XMLNamespace = "http://www.loc.gov/MARC21/slim"
+
+type xmlSubfield struct {
+	Code  string `xml:"code,attr"`
+	Value string `xml:",chardata"`
+}
+
+type xmlControlField struct {
+	Tag   string `xml:"tag,attr"`
+	Value string `xml:",chardata"`
+}
+
+type xmlDataField struct {
+	Tag       string        `xml:"tag,attr"`
+	Ind1      string        `xml:"ind1,attr"`
+	Ind2      string        `xml:"ind2,attr"`
+	Subfields []xmlSubfield `xml:"subfield"`
+}
+
+type xmlRecord struct {
+	XMLName       xml.Name          `xml:"record"`
+	Leader        string            `xml:"leader"`
+	ControlFields []xmlControlField `xml:"controlfield"`
+	DataFields    []xmlDataField    `xml:"datafield"`
+}
+
+// XMLReader reads records of MARCXML, either a collection or a single record.
+type XMLReader struct {
+	decoder *xml.Decoder
+}
+
+func NewXMLReader(r io.Reader) *XMLReader {
+	return &XMLReader{decoder: xml.NewDecoder(r)}
+}
+
+// Read returns the next record or io.EOF after the last one. The reader continues with
+// the next record after ErrInvalidRecord, other errors mean the document is malformed.
+func (r *XMLReader) Read() (Record, error) {
+	for {
+		token, err := r.decoder.Token()
+		if err != nil {
+			return Record{}, err
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "record" {
+			continue
+		}
+
+		var xr xmlRecord
+		if err = r.decoder.DecodeElement(&xr, &start); err != nil {
+			return Record{}, err
+		}
+		return xr.record()
+	}
+}
+
+func (xr xmlRecord) record() (Record, error) {
+	record := Record{Leader: xr.Leader}
+	if len(record.Leader) != leaderLength {
+		return Record{}, fmt.Errorf("leader must have %d characters: %w", leaderLength, ErrInvalidRecord)
+	}
+
+	for _, f := range xr.ControlFields {
+		field := Field{Tag: f.Tag, Value: f.Value}
+		if !validTag(f.Tag) || !field.IsControl() {
+			return Record{}, fmt.Errorf("invalid tag of control field %q: %w", f.Tag, ErrInvalidRecord)
+		}
+		record.Fields = append(record.Fields, field)
+	}
+
+	for _, f := range xr.DataFields {
+		field := Field{Tag: f.Tag}
+		if !validTag(f.Tag) || field.IsControl() {
+			return Record{}, fmt.Errorf("invalid tag of data field %q: %w", f.Tag, ErrInvalidRecord)
+		}
+		for i, ind := range []string{f.Ind1, f.Ind2} {
+			switch len(ind) {
+			case 0:
+				field.Indicators[i] = ' '
+			case 1:
+				field.Indicators[i] = ind[0]
+			default:
+				return Record{}, fmt.Errorf("field %s has invalid indicators: %w", f.Tag, ErrInvalidRecord)
+			}
+		}
+		for _, s := range f.Subfields {
+			if len(s.Code) != 1 {
+				return Record{}, fmt.Errorf("field %s has invalid subfield code %q: %w", f.Tag, s.Code, ErrInvalidRecord)
+			}
+			field.Subfields = append(field.Subfields, Subfield{Code: s.Code[0], Value: s.Value})
+		}
+		record.Fields = append(record.Fields, field)
+	}
+
+	return record, nil
+}
+
+// XMLWriter writes records into a MARCXML collection, Close must be called to end the collection.
+type XMLWriter struct {
+	w       io.Writer
+	encoder *xml.Encoder
+	started bool
+}
+
+func NewXMLWriter(w io.Writer) *XMLWriter {
+	return &XMLWriter{w: w, encoder: xml.NewEncoder(w)}
+}
+
+var collectionStart = xml.StartElement{
+	Name: xml.Name{Local: "collection"},
+	Attr: []xml.Attr{{Name: xml.Name{Local: "xmlns"}, Value: XMLNamespace}},
+}
+
+func (w *XMLWriter) start() error {
+	if w.started {
+		return nil
+	}
+	w.started = true
+
+	if _, err := io.WriteString(w.w, xml.Header); err != nil {
+		return err
+	}
+	return w.encoder.EncodeToken(collectionStart)
+}
+
+func (w *XMLWriter) Write(record Record) error {
+	xr := xmlRecord{Leader: record.Leader}
+	if len(xr.Leader) != leaderLength {
+		xr.Leader = DefaultLeader
+	}
+
+	for _, field := range record.Fields {
+		if !validTag(field.Tag) {
+			return fmt.Errorf("invalid tag %q: %w", field.Tag, ErrInvalidRecord)
+		}
+		if field.IsControl() {
+			xr.ControlFields = append(xr.ControlFields, xmlControlField{Tag: field.Tag, Value: field.Value})
+			continue
+		}
+
+		f := xmlDataField{Tag: field.Tag, Ind1: string(indicator(field, 0)), Ind2: string(indicator(field, 1))}
+		for _, s := range field.Subfields {
+			f.Subfields = append(f.Subfields, xmlSubfield{Code: string(s.Code), Value: s.Value})
+		}
+		xr.DataFields = append(xr.DataFields, f)
+	}
+
+	if err := w.start(); err != nil {
+		return err
+	}
+	return w.encoder.Encode(xr)
+}
+
+// Close ends the collection, an empty collection is written if there were no records.
+func (w *XMLWriter) Close() error {
+	err := w.start()
+	if err == nil {
+		err = w.encoder.EncodeToken(collectionStart.End())
+	}
+	if err == nil {
+		err = w.encoder.Flush()
+	}
+	return err
+}